@@ -1,12 +1,22 @@
 package main
 
 import (
+	"encoding/base64"
+	"encoding/json"
 	"fmt"
 	"net/http"
+	"strconv"
+	"strings"
+	"time"
 
 	"github.com/gin-gonic/gin"
+	"github.com/pkg/errors"
 
 	"github.com/schollz/kiki/src/database"
+	"github.com/schollz/kiki/src/feed"
+	"github.com/schollz/kiki/src/keypair"
+	"github.com/schollz/kiki/src/letter"
+	"github.com/schollz/kiki/src/purpose"
 )
 
 var restApi HttpRestApi
@@ -14,7 +24,8 @@ var restApi HttpRestApi
 type HttpRestApi struct {
 	PrimaryUserId  string
 	RegionPublicId string
-	Db             database.DatabaseAPI
+	Db             database.Store
+	Feed           *feed.Feed
 }
 
 func (self HttpRestApi) AttachToRouter(router *gin.Engine) {
@@ -24,12 +35,73 @@ func (self HttpRestApi) AttachToRouter(router *gin.Engine) {
 	logger.Log.Debug("Attaching HTTP handler for route: GET /api/v1/post/:post_id/versions")
 	logger.Log.Debug("Attaching HTTP handler for route: GET /api/v1/user")
 	logger.Log.Debug("Attaching HTTP handler for route: GET /api/v1/user/:user_id")
+	logger.Log.Debug("Attaching HTTP handler for route: GET /api/v1/conversations")
+	logger.Log.Debug("Attaching HTTP handler for route: GET /api/v1/conversations/:conversation_id/messages")
 	router.GET("/api/v1/posts", self.GetPosts)
 	router.GET("/api/v1/post/:post_id", self.GetPost)
 	router.GET("/api/v1/post/:post_id/comments", self.GetPostComments)
 	router.GET("/api/v1/post/:post_id/versions", self.GetPostVersions)
 	router.GET("/api/v1/user", self.GetPrimaryUser)
 	router.GET("/api/v1/user/:user_id", self.GetUser)
+	router.GET("/api/v1/conversations", self.GetConversations)
+	router.GET("/api/v1/conversations/:conversation_id/messages", self.GetConversationMessages)
+
+	logger.Log.Debug("Attaching HTTP handler for route: POST /api/v1/posts")
+	logger.Log.Debug("Attaching HTTP handler for route: PUT /api/v1/post/:post_id")
+	logger.Log.Debug("Attaching HTTP handler for route: DELETE /api/v1/post/:post_id")
+	logger.Log.Debug("Attaching HTTP handler for route: POST /api/v1/post/:post_id/comments")
+	logger.Log.Debug("Attaching HTTP handler for route: POST /api/v1/post/:post_id/like")
+	logger.Log.Debug("Attaching HTTP handler for route: DELETE /api/v1/post/:post_id/like")
+	logger.Log.Debug("Attaching HTTP handler for route: PUT /api/v1/user")
+	logger.Log.Debug("Attaching HTTP handler for route: POST /api/v1/user/:user_id/follow")
+	logger.Log.Debug("Attaching HTTP handler for route: DELETE /api/v1/user/:user_id/follow")
+	logger.Log.Debug("Attaching HTTP handler for route: POST /api/v1/user/:user_id/block")
+	logger.Log.Debug("Attaching HTTP handler for route: DELETE /api/v1/user/:user_id/block")
+	logger.Log.Debug("Attaching HTTP handler for route: POST /api/v1/images")
+	logger.Log.Debug("Attaching HTTP handler for route: POST /api/v1/conversations/:conversation_id/read")
+	router.POST("/api/v1/posts", self.CreatePost)
+	router.PUT("/api/v1/post/:post_id", self.EditPost)
+	router.DELETE("/api/v1/post/:post_id", self.DeletePost)
+	router.POST("/api/v1/post/:post_id/comments", self.CreateComment)
+	router.POST("/api/v1/post/:post_id/like", self.LikePost)
+	router.DELETE("/api/v1/post/:post_id/like", self.UnlikePost)
+	router.PUT("/api/v1/user", self.UpdatePrimaryUser)
+	router.POST("/api/v1/user/:user_id/follow", self.FollowUser)
+	router.DELETE("/api/v1/user/:user_id/follow", self.UnfollowUser)
+	router.POST("/api/v1/user/:user_id/block", self.BlockUser)
+	router.DELETE("/api/v1/user/:user_id/block", self.UnblockUser)
+	router.POST("/api/v1/images", self.UploadImage)
+	router.POST("/api/v1/conversations/:conversation_id/read", self.ReadConversation)
+
+	logger.Log.Debug("Attaching HTTP handler for route: GET /api/v1/events")
+	router.GET("/api/v1/events", self.GetEvents)
+
+	logger.Log.Debug("Attaching HTTP handler for route: GET /api/v1/notifications")
+	logger.Log.Debug("Attaching HTTP handler for route: POST /api/v1/notifications/read")
+	logger.Log.Debug("Attaching HTTP handler for route: POST /api/v1/notification/:notification_id/read")
+	router.GET("/api/v1/notifications", self.GetNotifications)
+	router.POST("/api/v1/notifications/read", self.ReadNotifications)
+	router.POST("/api/v1/notification/:notification_id/read", self.ReadNotification)
+}
+
+// eventKeepAlive is how often a comment is sent on a quiet event stream, so that
+// proxies do not close it
+const eventKeepAlive = 30 * time.Second
+
+// ApiPostPayload is the body for writing a post or a comment. When there are no
+// recipients, a post is public and a comment goes to the recipients of its post.
+type ApiPostPayload struct {
+	Content    string   `json:"content"`
+	Recipients []string `json:"recipients"`
+	ExpiresIn  int64    `json:"expires_in"`
+}
+
+// ApiUserPayload is the body for changing your profile. Only the fields that are
+// given are changed.
+type ApiUserPayload struct {
+	Name    *string `json:"name"`
+	Profile *string `json:"profile"`
+	Image   *string `json:"image"`
 }
 
 func (self HttpRestApi) GetPosts(c *gin.Context) {
@@ -65,6 +137,480 @@ func (self HttpRestApi) GetUser(c *gin.Context) {
 	self.apiFetchUserHandler(c, user_id)
 }
 
+func (self HttpRestApi) GetConversations(c *gin.Context) {
+	conversations, err := self.Feed.GetConversations()
+	if err != nil {
+		self.apiErrorHandler(c, err)
+		return
+	}
+
+	self.apiSuccessHandler(c, gin.H{
+		"status": "ok",
+		"data": gin.H{
+			"conversations": conversations,
+		},
+	})
+}
+
+// GetConversationMessages returns the messages of a conversation.
+func (self HttpRestApi) GetConversationMessages(c *gin.Context) {
+	conversation_id := c.Param("conversation_id")
+	messages, err := self.Feed.GetMessages(conversation_id)
+	if err != nil {
+		self.apiErrorHandler(c, err)
+		return
+	}
+
+	self.apiSuccessHandler(c, gin.H{
+		"status": "ok",
+		"data": gin.H{
+			"messages": messages,
+		},
+	})
+}
+
+// ReadConversation marks the messages of a conversation as read and returns the
+// conversations.
+func (self HttpRestApi) ReadConversation(c *gin.Context) {
+	err := self.Feed.MarkConversationRead(c.Param("conversation_id"))
+	if err != nil {
+		self.apiNotFoundHandler(c, err)
+		return
+	}
+	self.GetConversations(c)
+}
+
+// CreatePost writes a new post.
+func (self HttpRestApi) CreatePost(c *gin.Context) {
+	var p ApiPostPayload
+	if err := self.bindPostPayload(c, &p); err != nil {
+		self.apiBadRequestHandler(c, err)
+		return
+	}
+	if len(p.Recipients) == 0 {
+		p.Recipients = []string{"public"}
+	}
+	self.apiWritePostHandler(c, letter.Letter{
+		Purpose:   purpose.ShareText,
+		To:        p.Recipients,
+		Content:   p.Content,
+		ExpiresIn: p.ExpiresIn,
+	})
+}
+
+// EditPost replaces the content of one of your posts, keeping its recipients
+// unless new ones are given.
+func (self HttpRestApi) EditPost(c *gin.Context) {
+	e, err := self.ownPost(c.Param("post_id"))
+	if err != nil {
+		self.apiNotFoundHandler(c, err)
+		return
+	}
+	var p ApiPostPayload
+	if err = self.bindPostPayload(c, &p); err != nil {
+		self.apiBadRequestHandler(c, err)
+		return
+	}
+	if len(p.Recipients) == 0 {
+		p.Recipients = self.recipientsOf(e)
+	}
+	self.apiWritePostHandler(c, letter.Letter{
+		Purpose:   purpose.ShareText,
+		To:        p.Recipients,
+		Content:   p.Content,
+		FirstID:   e.Letter.FirstID,
+		ReplyTo:   e.Letter.ReplyTo,
+		ExpiresIn: p.ExpiresIn,
+	})
+}
+
+// DeletePost replaces one of your posts with an empty one.
+func (self HttpRestApi) DeletePost(c *gin.Context) {
+	e, err := self.ownPost(c.Param("post_id"))
+	if err != nil {
+		self.apiNotFoundHandler(c, err)
+		return
+	}
+	_, err = self.Feed.ProcessLetter(letter.Letter{
+		Purpose: purpose.ShareText,
+		To:      self.recipientsOf(e),
+		FirstID: e.Letter.FirstID,
+		ReplyTo: e.Letter.ReplyTo,
+	})
+	if err != nil {
+		self.apiErrorHandler(c, err)
+		return
+	}
+	go self.Feed.UpdateEverythingAndSync()
+	self.apiSuccessHandler(c, gin.H{"status": "ok", "message": "deleted " + e.Letter.FirstID})
+}
+
+// CreateComment writes a reply to a post.
+func (self HttpRestApi) CreateComment(c *gin.Context) {
+	e, err := self.post(c.Param("post_id"))
+	if err != nil {
+		self.apiNotFoundHandler(c, err)
+		return
+	}
+	var p ApiPostPayload
+	if err = self.bindPostPayload(c, &p); err != nil {
+		self.apiBadRequestHandler(c, err)
+		return
+	}
+	if len(p.Recipients) == 0 {
+		p.Recipients = self.recipientsOf(e)
+	}
+	self.apiWritePostHandler(c, letter.Letter{
+		Purpose:   purpose.ShareText,
+		To:        p.Recipients,
+		Content:   p.Content,
+		ReplyTo:   e.Letter.FirstID,
+		ExpiresIn: p.ExpiresIn,
+	})
+}
+
+// LikePost likes a post and returns it.
+func (self HttpRestApi) LikePost(c *gin.Context) {
+	self.apiPostActionHandler(c, purpose.ActionLike)
+}
+
+// UnlikePost takes back a like of a post and returns it.
+func (self HttpRestApi) UnlikePost(c *gin.Context) {
+	self.apiPostActionHandler(c, purpose.ActionUnlike)
+}
+
+// FollowUser follows a user and returns you.
+func (self HttpRestApi) FollowUser(c *gin.Context) {
+	self.apiUserActionHandler(c, purpose.ActionFollow)
+}
+
+// UnfollowUser stops following a user and returns you.
+func (self HttpRestApi) UnfollowUser(c *gin.Context) {
+	self.apiUserActionHandler(c, purpose.ActionUnfollow)
+}
+
+// BlockUser blocks a user and returns you.
+func (self HttpRestApi) BlockUser(c *gin.Context) {
+	self.apiUserActionHandler(c, purpose.ActionBlock)
+}
+
+// UnblockUser unblocks a user and returns you.
+func (self HttpRestApi) UnblockUser(c *gin.Context) {
+	self.apiUserActionHandler(c, purpose.ActionUnblock)
+}
+
+// UpdatePrimaryUser changes your name, profile or image and returns you. The image
+// is the ID of an image from UploadImage.
+func (self HttpRestApi) UpdatePrimaryUser(c *gin.Context) {
+	var p ApiUserPayload
+	if err := c.BindJSON(&p); err != nil {
+		self.apiBadRequestHandler(c, err)
+		return
+	}
+	if p.Name == nil && p.Profile == nil && p.Image == nil {
+		self.apiBadRequestHandler(c, errors.New("nothing to change"))
+		return
+	}
+	if p.Name != nil && strings.TrimSpace(*p.Name) == "" {
+		self.apiBadRequestHandler(c, errors.New("name is empty"))
+		return
+	}
+	if p.Image != nil {
+		e, err := self.Feed.GetEnvelope(*p.Image)
+		if err != nil || (e.Letter.Purpose != purpose.SharePNG && e.Letter.Purpose != purpose.ShareJPG) {
+			self.apiBadRequestHandler(c, errors.New("image is not the ID of an image"))
+			return
+		}
+	}
+
+	for _, change := range []struct {
+		purpose string
+		content *string
+	}{
+		{purpose.ActionName, p.Name},
+		{purpose.ActionProfile, p.Profile},
+		{purpose.ActionImage, p.Image},
+	} {
+		if change.content == nil {
+			continue
+		}
+		_, err := self.Feed.ProcessLetter(letter.Letter{Purpose: change.purpose, Content: *change.content})
+		if err != nil {
+			self.apiErrorHandler(c, err)
+			return
+		}
+	}
+	self.apiWrittenUserHandler(c)
+}
+
+// UploadImage takes the PNG or JPEG in the "image" field of a multipart form and
+// sends it to the comma separated "recipients", or to everyone. It returns the ID of
+// the image, which can be shown in posts as ![](/img/ID).
+func (self HttpRestApi) UploadImage(c *gin.Context) {
+	file, err := c.FormFile("image")
+	if err != nil {
+		self.apiBadRequestHandler(c, err)
+		return
+	}
+	data, err := readFormFile(file)
+	if err != nil {
+		self.apiBadRequestHandler(c, err)
+		return
+	}
+	var imagePurpose string
+	switch http.DetectContentType(data) {
+	case "image/png":
+		imagePurpose = purpose.SharePNG
+	case "image/jpeg":
+		imagePurpose = purpose.ShareJPG
+	default:
+		self.apiBadRequestHandler(c, errors.New("image must be a PNG or a JPEG"))
+		return
+	}
+	recipients := []string{"public"}
+	if to := c.PostForm("recipients"); to != "" {
+		recipients = strings.Split(to, ",")
+	}
+	if err = validRecipients(recipients); err != nil {
+		self.apiBadRequestHandler(c, err)
+		return
+	}
+
+	e, err := self.Feed.ProcessLetter(letter.Letter{
+		Purpose: imagePurpose,
+		To:      recipients,
+		Content: base64.StdEncoding.EncodeToString(data),
+	})
+	if err != nil {
+		self.apiErrorHandler(c, err)
+		return
+	}
+	go self.Feed.UpdateEverythingAndSync()
+	self.apiSuccessHandler(c, gin.H{
+		"status": "ok",
+		"data": gin.H{
+			"id": e.ID,
+		},
+	})
+}
+
+// bindPostPayload reads and checks the payload of a post.
+func (self HttpRestApi) bindPostPayload(c *gin.Context, p *ApiPostPayload) (err error) {
+	err = c.BindJSON(p)
+	if err != nil {
+		return
+	}
+	if strings.TrimSpace(p.Content) == "" {
+		return errors.New("content is empty")
+	}
+	if p.ExpiresIn < 0 {
+		return errors.New("expiry can not be negative")
+	}
+	return validRecipients(p.Recipients)
+}
+
+// validRecipients checks that each recipient is a public key or one of the names that
+// letters can be sent to.
+func validRecipients(recipients []string) (err error) {
+	for _, to := range recipients {
+		switch {
+		case to == "public", to == "friends", to == "self", strings.HasPrefix(to, "group:"):
+		default:
+			if _, err = keypair.FromPublic(to); err != nil {
+				return errors.Errorf("'%s' is not a recipient", to)
+			}
+		}
+	}
+	return
+}
+
+// post returns the first version of a post.
+func (self HttpRestApi) post(post_id string) (e letter.Envelope, err error) {
+	e, err = self.Feed.GetEnvelope(post_id)
+	if err == nil && (!e.Opened || e.Letter.Purpose != purpose.ShareText) {
+		err = errors.New("not a post")
+	}
+	if err != nil {
+		err = errors.Wrap(err, "no such post")
+	}
+	return
+}
+
+// ownPost returns the first version of one of your posts.
+func (self HttpRestApi) ownPost(post_id string) (e letter.Envelope, err error) {
+	e, err = self.post(post_id)
+	if err == nil && e.Sender.Public != self.PrimaryUserId {
+		err = errors.New("not your post")
+	}
+	return
+}
+
+// recipientsOf returns the recipients of a letter the way that they are given when
+// writing one.
+func (self HttpRestApi) recipientsOf(e letter.Envelope) (recipients []string) {
+	recipients = []string{}
+	seen := make(map[string]bool)
+	for _, to := range e.Letter.To {
+		switch to {
+		case self.RegionPublicId:
+			to = "public"
+		case self.PrimaryUserId:
+			continue
+		default:
+			to = self.Feed.RecipientOf(to)
+		}
+		if !seen[to] {
+			seen[to] = true
+			recipients = append(recipients, to)
+		}
+	}
+	if len(recipients) == 0 {
+		recipients = []string{"self"}
+	}
+	return
+}
+
+// apiWritePostHandler writes the letter and returns the post that it is in.
+func (self HttpRestApi) apiWritePostHandler(c *gin.Context, l letter.Letter) {
+	e, err := self.Feed.ProcessLetter(l)
+	if err != nil {
+		self.apiErrorHandler(c, err)
+		return
+	}
+	self.apiWrittenPostHandler(c, e.Letter.FirstID)
+}
+
+// apiPostActionHandler sends an action about a post and returns the post.
+func (self HttpRestApi) apiPostActionHandler(c *gin.Context, actionPurpose string) {
+	e, err := self.post(c.Param("post_id"))
+	if err != nil {
+		self.apiNotFoundHandler(c, err)
+		return
+	}
+	_, err = self.Feed.ProcessLetter(letter.Letter{Purpose: actionPurpose, Content: e.Letter.FirstID})
+	if err != nil {
+		self.apiErrorHandler(c, err)
+		return
+	}
+	self.apiWrittenPostHandler(c, e.Letter.FirstID)
+}
+
+// apiUserActionHandler sends an action about a user and returns you.
+func (self HttpRestApi) apiUserActionHandler(c *gin.Context, actionPurpose string) {
+	user_id := c.Param("user_id")
+	if _, err := keypair.FromPublic(user_id); err != nil {
+		self.apiBadRequestHandler(c, errors.Wrap(err, "not a user"))
+		return
+	}
+	_, err := self.Feed.ProcessLetter(letter.Letter{Purpose: actionPurpose, Content: user_id})
+	if err != nil {
+		self.apiErrorHandler(c, err)
+		return
+	}
+	self.apiWrittenUserHandler(c)
+}
+
+// apiWrittenPostHandler opens the letters that were just written so that the post
+// is returned as it is now, and then updates everything else in the background.
+func (self HttpRestApi) apiWrittenPostHandler(c *gin.Context, post_id string) {
+	err := self.Feed.UnsealLetters()
+	go self.Feed.UpdateEverythingAndSync()
+	if err != nil {
+		self.apiErrorHandler(c, err)
+		return
+	}
+	posts, err := self.Db.GetPostForApi(post_id)
+	if err == nil && len(posts) == 0 {
+		err = errors.New("post was not written")
+	}
+	if err != nil {
+		self.apiErrorHandler(c, err)
+		return
+	}
+	self.apiSuccessHandler(c, gin.H{
+		"status": "ok",
+		"data": gin.H{
+			"post": posts[0],
+		},
+	})
+}
+
+// apiWrittenUserHandler is apiWrittenPostHandler for your own user.
+func (self HttpRestApi) apiWrittenUserHandler(c *gin.Context) {
+	err := self.Feed.UnsealLetters()
+	go self.Feed.UpdateEverythingAndSync()
+	if err != nil {
+		self.apiErrorHandler(c, err)
+		return
+	}
+	self.apiFetchUserHandler(c, self.PrimaryUserId)
+}
+
+// GetEvents streams the events of the feed as Server-Sent Events. A client resumes
+// after the last event that it got with the Last-Event-ID header, which EventSource
+// sends when it reconnects, or with ?last_event_id=.
+func (self HttpRestApi) GetEvents(c *gin.Context) {
+	lastEventID := c.Request.Header.Get("Last-Event-ID")
+	if lastEventID == "" {
+		lastEventID = c.Query("last_event_id")
+	}
+	lastID, _ := strconv.ParseInt(lastEventID, 10, 64)
+	backlog, events, cancel := self.Feed.Subscribe(lastID)
+	defer cancel()
+
+	c.Header("Content-Type", "text/event-stream")
+	c.Header("Cache-Control", "no-cache")
+	c.Header("Connection", "keep-alive")
+	c.Status(http.StatusOK)
+	send := func(e feed.Event) {
+		data, _ := json.Marshal(e)
+		fmt.Fprintf(c.Writer, "id: %d\nevent: %s\ndata: %s\n\n", e.ID, e.Type, data)
+	}
+	for _, e := range backlog {
+		send(e)
+	}
+	c.Writer.Flush()
+
+	keepAlive := time.NewTicker(eventKeepAlive)
+	defer keepAlive.Stop()
+	for {
+		select {
+		case e, ok := <-events:
+			if !ok {
+				// the client fell behind, it reconnects and resumes
+				return
+			}
+			send(e)
+		case <-keepAlive.C:
+			fmt.Fprint(c.Writer, ": keep-alive\n\n")
+		case <-c.Request.Context().Done():
+			return
+		}
+		c.Writer.Flush()
+	}
+}
+
+// GetNotifications returns your notifications, the latest first, or only the unread
+// ones with ?unread=1, along with how many are unread.
+func (self HttpRestApi) GetNotifications(c *gin.Context) {
+	self.apiNotificationsHandler(c, nil)
+}
+
+// ReadNotifications marks every notification as read and returns them.
+func (self HttpRestApi) ReadNotifications(c *gin.Context) {
+	self.apiNotificationsHandler(c, self.Feed.MarkNotificationsRead())
+}
+
+// ReadNotification marks a notification as read and returns the notifications.
+func (self HttpRestApi) ReadNotification(c *gin.Context) {
+	err := self.Feed.MarkNotificationsRead(c.Param("notification_id"))
+	if err != nil {
+		self.apiNotFoundHandler(c, err)
+		return
+	}
+	self.apiNotificationsHandler(c, nil)
+}
+
 func (self HttpRestApi) apiSuccessHandler(c *gin.Context, h gin.H) {
 	logger.Log.Debug(fmt.Sprintf("%v %v %v [%v]", c.Request.RemoteAddr, c.Request.Method, c.Request.URL, http.StatusOK))
 	c.JSON(http.StatusOK, h)
@@ -76,6 +622,16 @@ func (self HttpRestApi) apiErrorHandler(c *gin.Context, err error) {
 	c.JSON(http.StatusInternalServerError, gin.H{"status": "error", "error": err.Error()})
 }
 
+func (self HttpRestApi) apiBadRequestHandler(c *gin.Context, err error) {
+	logger.Log.Debug(fmt.Sprintf("%v %v %v [%v] %v", c.Request.RemoteAddr, c.Request.Method, c.Request.URL, http.StatusBadRequest, err))
+	c.JSON(http.StatusBadRequest, gin.H{"status": "error", "error": err.Error()})
+}
+
+func (self HttpRestApi) apiNotFoundHandler(c *gin.Context, err error) {
+	logger.Log.Debug(fmt.Sprintf("%v %v %v [%v] %v", c.Request.RemoteAddr, c.Request.Method, c.Request.URL, http.StatusNotFound, err))
+	c.JSON(http.StatusNotFound, gin.H{"status": "error", "error": err.Error()})
+}
+
 func (self HttpRestApi) apiPostsHandler(c *gin.Context, posts []database.ApiBasicPost, err error) {
 	if err != nil {
 		self.apiErrorHandler(c, err)
@@ -90,6 +646,28 @@ func (self HttpRestApi) apiPostsHandler(c *gin.Context, posts []database.ApiBasi
 	})
 }
 
+// apiNotificationsHandler returns the notifications, unless there was an error
+// before.
+func (self HttpRestApi) apiNotificationsHandler(c *gin.Context, err error) {
+	if err != nil {
+		self.apiErrorHandler(c, err)
+		return
+	}
+	notifications, unread, err := self.Feed.GetNotifications(c.Query("unread") == "1")
+	if err != nil {
+		self.apiErrorHandler(c, err)
+		return
+	}
+
+	self.apiSuccessHandler(c, gin.H{
+		"status": "ok",
+		"data": gin.H{
+			"notifications": notifications,
+			"unread":        unread,
+		},
+	})
+}
+
 func (self HttpRestApi) apiFetchUserHandler(c *gin.Context, user_id string) {
 	user, err := self.Db.GetUserForApi(user_id)
 	if user_id == self.RegionPublicId {
@@ -0,0 +1,162 @@
+package main
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/hex"
+	"net"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+)
+
+const (
+	// headerCSRF carries the CSRF token that the pages of the private server send
+	headerCSRF = "X-CSRF-Token"
+	// cookieToken keeps the API token in a browser that logged in with ?token=
+	cookieToken = "kiki_token"
+)
+
+var (
+	// apiToken lets local clients use the private server, and csrfToken is given to
+	// its pages so that other websites can not make requests in their place
+	apiToken  string
+	csrfToken string
+)
+
+// setTokens loads the API token and derives the CSRF token from it.
+func setTokens(token string) {
+	apiToken = token
+	mac := hmac.New(sha256.New, []byte(token))
+	mac.Write([]byte("csrf"))
+	csrfToken = hex.EncodeToString(mac.Sum(nil))
+}
+
+// sameToken compares tokens in constant time.
+func sameToken(given, want string) bool {
+	return given != "" && subtle.ConstantTimeCompare([]byte(given), []byte(want)) == 1
+}
+
+// unsafeRequest tells whether the request can change something. GET /exit and
+// GET /test change things even though they are GETs.
+func unsafeRequest(r *http.Request) bool {
+	switch r.Method {
+	case "GET", "HEAD", "OPTIONS":
+		return r.URL.Path == "/exit" || r.URL.Path == "/test"
+	}
+	return true
+}
+
+// localHost tells whether the Host of the request is this computer, so that a
+// website that points its own name at 127.0.0.1 can not pass as the same origin.
+func localHost(host string) bool {
+	if h, _, err := net.SplitHostPort(host); err == nil {
+		host = h
+	}
+	host = strings.Trim(host, "[]")
+	if host == "localhost" {
+		return true
+	}
+	ip := net.ParseIP(host)
+	return ip != nil && ip.IsLoopback()
+}
+
+// allowedOrigin tells whether the origin is this server or one of the origins that
+// were allowed in the settings.
+func allowedOrigin(origin, host string) (sameOrigin, allowed bool) {
+	u, err := url.Parse(origin)
+	if err == nil && u.Host == host {
+		return true, true
+	}
+	for _, o := range f.Settings.AllowedOrigins {
+		if strings.TrimSuffix(o, "/") == origin {
+			return false, true
+		}
+	}
+	return
+}
+
+// addPrivateCORS lets an allowed origin read the responses of the private server.
+func addPrivateCORS(c *gin.Context, origin string) {
+	c.Writer.Header().Set("Access-Control-Allow-Origin", origin)
+	c.Writer.Header().Add("Vary", "Origin")
+	c.Writer.Header().Set("Access-Control-Max-Age", "86400")
+	c.Writer.Header().Set("Access-Control-Allow-Methods", "GET,POST,PUT,DELETE,OPTIONS")
+	c.Writer.Header().Set("Access-Control-Allow-Headers", "Content-Type, Content-Length, Accept-Encoding, Authorization, "+headerCSRF)
+	c.Writer.Header().Set("Access-Control-Allow-Credentials", "true")
+}
+
+// requireToken is middleware for the private server. A request is let through when
+// it has the API token as "Authorization: Bearer TOKEN". Otherwise it has to come
+// from a page of this server or of an allowed origin, and if it can change something
+// it also needs the CSRF token of the pages. When the private port is exposed, every
+// request needs the API token, which a browser can keep as a cookie by opening any
+// page with ?token=TOKEN once.
+func requireToken() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		abort := func(status int, message string) {
+			logger.Log.Debugf("%s %s %s: %s", c.Request.RemoteAddr, c.Request.Method, c.Request.URL.Path, message)
+			c.AbortWithStatusJSON(status, gin.H{"status": "error", "error": message})
+		}
+
+		if !ExposeInternalPort && !localHost(c.Request.Host) {
+			abort(http.StatusForbidden, "host is not local")
+			return
+		}
+
+		// the headers are read with Header.Get, since GetHeader does not canonicalize
+		origin := c.Request.Header.Get("Origin")
+		if origin != "" {
+			sameOrigin, allowed := allowedOrigin(origin, c.Request.Host)
+			if !allowed {
+				abort(http.StatusForbidden, "origin is not allowed")
+				return
+			}
+			if !sameOrigin {
+				addPrivateCORS(c, origin)
+			}
+		}
+		if c.Request.Method == "OPTIONS" {
+			c.AbortWithStatus(http.StatusNoContent)
+			return
+		}
+
+		// clients that have the token can do anything
+		if sameToken(strings.TrimPrefix(c.Request.Header.Get("Authorization"), "Bearer "), apiToken) {
+			c.Next()
+			return
+		}
+
+		// browsers log in by opening a page with the token
+		if token := c.Query("token"); token != "" {
+			if !sameToken(token, apiToken) {
+				abort(http.StatusUnauthorized, "wrong token")
+				return
+			}
+			http.SetCookie(c.Writer, &http.Cookie{Name: cookieToken, Value: token, Path: "/", HttpOnly: true, SameSite: http.SameSiteStrictMode})
+			u := *c.Request.URL
+			q := u.Query()
+			q.Del("token")
+			u.RawQuery = q.Encode()
+			c.Redirect(http.StatusFound, u.String())
+			c.Abort()
+			return
+		}
+		if ExposeInternalPort {
+			cookie, err := c.Request.Cookie(cookieToken)
+			if err != nil || !sameToken(cookie.Value, apiToken) {
+				abort(http.StatusUnauthorized, "the API token is needed")
+				return
+			}
+		}
+
+		if unsafeRequest(c.Request) && !sameToken(c.Request.Header.Get(headerCSRF), csrfToken) {
+			abort(http.StatusForbidden, "the CSRF token or the API token is needed")
+			return
+		}
+		c.Next()
+	}
+}
@@ -147,7 +147,7 @@ func (fi bindataFileInfo) Sys() interface{} {
 	return nil
 }
 
-var _staticApiJs = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xcc\x56\x5d\x4f\xdb\x3c\x14\xbe\xef\xaf\x38\x58\xaf\x80\xa8\x7d\x13\xed\x36\x55\x2f\xa6\x09\x4d\x1a\x13\x20\xc1\xae\xa6\x09\xb9\xe9\x69\x6b\x70\xec\xc8\xc7\xe1\x43\xc0\x7f\x9f\xec\xa4\x89\x03\x09\xb4\x4c\xd3\xe8\x95\x63\x9f\x8f\xe7\x79\xce\x39\x76\x47\x37\xdc\xc0\xb1\x38\x16\x9f\x0b\x01\x33\x58\x96\x2a\xb3\x42\xab\xc3\x08\x1e\x9e\x46\xa3\xfa\x20\x2e\x8c\xb6\xda\xde\x17\x18\x2f\xd1\x66\xeb\xd0\x90\x5f\xf1\xbb\xd3\xf9\x55\xf4\x30\x02\x00\xf8\x2f\x76\xdf\xcd\xe6\x74\xd4\x1b\x44\xab\x23\x63\xb4\x09\xc3\x58\xcd\xc9\x5e\xe6\xb4\x9a\x40\xc6\xa5\x9c\xf3\xec\xba\x0e\x69\xd0\x96\x46\xb5\x96\x77\x6b\x33\x01\xb2\xdc\x96\x34\x01\x34\x26\x82\xca\xce\xfd\xc4\x12\xdc\x79\x6c\x90\x0a\xad\x08\xbf\x9d\x9f\x9e\xc4\xe8\x72\x85\x56\xee\x87\xc6\xa5\xef\xb7\x9d\x36\x96\x4f\xcd\xaa\xc1\x07\xfb\xfb\xd5\x87\xa9\x8c\x0f\xd1\x98\x49\x70\xfc\xf8\x08\x07\x9e\xdd\x41\x34\xed\xe0\xda\x43\x63\x62\xb2\x8e\x58\x2f\x16\x85\xb7\x70\xb4\x89\x18\xf5\x40\xd8\xe8\xe2\x10\x6c\xd6\xde\xb6\xca\xf3\x34\xa4\xf5\x79\x99\x65\x48\xf4\x3e\xb5\x17\xdc\xf2\x56\xee\xbb\xf5\x0b\xb9\x99\xbe\x66\xb0\x37\x03\x67\x18\x57\x76\xcf\xf9\x0d\x6b\xe7\x9d\xfc\xf2\x4d\x09\x07\x15\x68\x75\x6b\xa3\x45\xd1\xb6\x35\xa4\x4a\x9c\xca\x37\x47\x22\xbe\xc2\x00\x4b\x10\xa7\x3f\x79\x29\xe5\xc4\x73\x7f\xbd\x0a\x54\xce\x73\x61\xbf\xa3\xb5\xd8\x69\x7b\xe9\x77\x82\x2a\xd4\xd2\xb9\xa9\x24\x94\x4b\x98\x81\x5d\x0b\x9a\x36\x9b\x2d\x87\x19\xb0\x33\x4d\x56\xa8\x15\x58\xed\x47\x98\x4d\xc3\x19\x6c\x6b\x50\x1a\x99\x02\x4b\xaa\x5c\x6c\xd2\xec\xe7\x68\xd7\x7a\x91\x02\x3b\x3b\x3d\xbf\x08\xf6\x1d\x9d\x14\xfc\x3c\x90\x35\x42\xad\xc4\xf2\xbe\x46\x1a\xb5\x56\x99\x56\x16\x95\xbd\xb8\x2f\x30\x05\xc6\x8b\x42\x8a\x8c\x3b\x52\xc9\x15\x69\x15\x84\xf3\x15\x49\x3d\x9d\xcd\xe4\xf7\x36\x60\xeb\x51\x17\xa5\xf1\xa9\x3b\xb8\xd7\xab\x52\x7d\xe8\xa6\x91\xe2\x1a\x9d\x4a\xa1\xe6\x85\x26\x7b\x29\x16\x2f\x45\x77\x4a\x77\x2a\x15\x68\xc8\x8a\xd2\x14\x9a\x90\x39\xae\x3e\xce\xff\x2e\x76\x40\x93\x59\xcd\x52\xf8\xc9\x8a\x72\x2e\x45\xc6\x7e\x05\x27\xb5\x54\x2c\x85\x4d\xee\x0a\x75\x00\x61\x00\x7f\xb6\xe6\x6a\x85\x27\x3c\xc7\x90\x81\xe2\x39\xfe\x31\x7c\x4e\x24\x56\x2a\x71\xb1\x76\x65\xe1\x7c\xb6\x65\xb0\xd4\x52\xea\xdb\x1f\xd4\xed\xfb\x92\xd0\x5c\x8a\xc5\x3b\xa1\x57\x31\x77\x45\x5d\xe7\x9c\xbc\xde\x31\xfe\x81\x73\x2d\xd3\xb9\x30\xb7\x9a\xcf\xa1\xc1\xe3\x85\x48\x6e\x3e\x25\xae\xf6\xd4\x37\x7e\x5f\x8f\x2e\xde\x18\x97\xea\x9a\xd9\x61\x52\x9e\x39\x6c\x49\x79\xab\x29\x79\x2f\xf5\x84\x8d\x3b\xdd\xff\x31\x25\xf8\xa2\xf3\x1c\x55\xb7\xf8\x7f\x51\x8a\x31\x4b\xb2\x3a\xe3\x87\xed\x8c\x81\xd9\xdd\xe1\xd1\x2a\x8d\x74\xcf\xd5\x46\x02\x17\x80\xf9\x43\xf7\x07\xe2\xd9\x55\x50\x2b\x06\x63\xe7\xc0\xc6\xf5\x69\xfd\xb6\x0e\xeb\x5b\x1a\xf9\xaf\xf5\xfb\x1d\x00\x00\xff\xff\x2d\x28\xed\x78\x4b\x0b\x00\x00")
+var _staticApiJs = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xd4\x59\xdb\x6e\xdb\x38\x10\x7d\xcf\x57\x4c\x89\x45\x6b\xc3\x82\x85\xbe\x3a\x08\x8a\x45\xe3\x16\xbd\x6c\x1a\x6c\x92\xa7\xc5\xa2\x60\xa4\x71\xc4\x44\x22\x05\x92\x4a\x62\xb4\xfe\xf7\xc5\x50\xb4\x44\xdb\x92\xed\x18\xd9\x22\x45\x80\x98\xa6\xe6\x72\xe6\xcc\x90\x1a\xd2\x47\xf7\x5c\xc3\x17\xf1\x45\xfc\x59\x0a\x38\x81\x59\x25\x13\x2b\x94\x1c\x0c\xe1\xc7\xe2\xe8\xc8\x3f\x18\x97\x5a\x59\x65\xe7\x25\x8e\x67\x68\x93\x2c\x14\xe4\xb7\xfc\xf1\xdb\xf5\xed\xf0\xc7\x11\x00\xc0\x1f\x63\xfa\xde\x4c\x1e\x1f\x75\x1a\x51\x72\xaa\xb5\xd2\xa1\x19\xab\xb8\xb1\xdf\x0b\x73\x13\x41\xc2\xf3\xfc\x9a\x27\x77\xde\xa4\x46\x5b\x69\xd9\x4a\x3e\x66\x3a\x02\x63\xb9\xad\x4c\x04\xa8\xf5\x10\x6a\x39\xfa\x13\x33\x18\x3c\x66\x7a\xac\xd1\x94\x4a\x1a\xfc\x7c\xf1\xed\x6c\x8c\xe4\x2b\x94\xa2\x3f\xd4\xe4\xbe\x5b\xf6\xb8\x91\x5c\x34\xa3\x06\x1f\xbc\x7e\x5d\x7f\xd1\xb5\xf0\x00\xb5\x8e\x82\xc7\x3f\x7f\xc2\x1b\x17\xdd\x9b\x61\x6b\x87\x70\xbd\x42\xad\xc7\xc6\x52\x60\x9d\x58\x24\x3e\xc0\x74\x69\x71\xd8\x01\x61\xc9\x0b\x21\x58\x8e\xc9\xbb\xf7\xb3\xe8\xe3\xfa\xa2\x4a\x12\x34\xe6\x30\xb6\x53\x6e\x79\x4b\xf7\x63\xb6\x41\x37\x53\x77\x0c\x5e\x9d\x00\x09\x52\x78\xb6\x32\xeb\xf1\xf5\x73\xe7\x94\xdc\x70\x27\x85\xbd\x0c\xb4\xbc\xb5\xd6\x86\xc3\xe3\x0e\x02\xbb\x70\x98\x9a\x9c\x5a\xb7\x40\x63\xf8\x0d\x06\x58\x02\x3b\xdd\xce\xab\x3c\x8f\x5c\xec\xdb\xb3\x60\xaa\xeb\x42\xd8\xaf\x68\x2d\xae\x94\x7d\xee\x66\x82\x2c\x78\xea\x68\x55\x1a\xcc\x67\x70\x02\x36\x13\xe6\xb8\x99\x6c\x63\x38\x01\x76\xae\x8c\x15\xf2\x06\xac\x72\x4b\x98\x1d\x87\x6b\xb0\xcd\x41\xa5\xf3\x09\xb0\xb8\xf6\xc5\xa2\x66\xbe\x40\x9b\xa9\x74\x02\xec\xfc\xdb\xc5\x65\x30\x4f\xe1\x4c\xc0\xad\x07\x63\xb5\x90\x37\x62\x36\x1f\xd4\xda\xc3\x56\x2a\x51\xd2\xa2\xb4\x97\xf3\x12\x27\xc0\x78\x59\xe6\x22\xe1\x14\x54\x7c\x6b\x94\x0c\xcc\xb9\xfc\x4e\x5c\x38\xcb\x95\xdf\x59\x80\xad\x86\x4f\x4a\xa3\xe3\x2b\xb8\x53\xcb\x29\x2d\xfa\x76\x1a\x83\x32\x0d\xf9\xae\x43\x8e\xa0\xd2\x3e\x6d\x11\x74\x19\xdd\x96\x85\x6e\x7a\x2b\x9d\x6f\x12\xeb\xbd\x35\xf3\x35\xb1\xf4\x9f\x8a\x68\x8d\x60\x9a\xfe\xdd\xe8\x4d\x34\x72\x8b\xe7\xca\xd8\x90\xe4\x52\x19\xbb\x49\x26\x31\xe8\xf2\x31\xf0\xf5\x06\x2c\xe6\xa5\x88\xef\xdf\xc6\xa4\x60\x58\x04\xf4\x19\x6d\x96\x75\x60\xab\x07\x07\xa6\xc2\x76\xa1\xf8\x2e\xd2\x08\x76\xc3\xb9\xda\x40\x13\xb3\xd1\x9a\x3e\x9b\xa6\xc2\x2d\x36\x9a\xdf\x03\x52\x8a\x39\x76\x53\xe3\x8c\x6e\x81\x73\x3a\xfd\x3a\xbd\x9c\x6e\x43\x54\xef\x3a\xec\x94\x5c\x3c\x01\x52\x9d\xad\xf7\xaa\x28\x50\xf6\xa0\xaa\x9f\x3d\x39\x79\x2d\xb8\x11\x8b\xbd\x11\x4a\xa8\x1f\x46\xc0\xfe\xc6\x32\x9f\x0b\x79\xb3\x07\xcc\x5c\xdc\x1d\xc4\xdb\x4e\x60\x64\x98\x2d\xd9\xab\xff\xef\xc2\x52\xc9\x43\xd1\xec\xca\xe2\x81\x78\xca\x94\x5b\xbc\x32\xab\x6f\x91\xca\xa0\xde\x0a\x66\xad\xc2\x49\x9e\x45\x40\x1f\x11\xb0\x2b\xb2\xe9\xca\x48\xab\x99\xc8\x71\x9f\x4a\xca\xb8\xbc\xc1\x33\x5e\x60\x08\x43\xf2\x02\x7b\x60\xb4\xb8\x07\x3f\x18\xc9\xb1\x09\xd0\xc7\x62\xb7\xaf\x99\xca\x73\xf5\xd0\x15\xf2\x53\x0b\x82\x74\x62\x36\xf2\xaa\x23\x16\xd7\xa6\x9f\x5c\x12\x87\x23\xda\x2c\x8a\xe7\xc1\x74\x9d\xab\xe4\xee\x7f\xa1\xc8\x59\x7e\x32\x43\x07\xe3\xd9\x83\xa0\xdd\x88\xe2\x18\xaa\x32\x57\x3c\xfd\x54\xf0\x1b\x04\x8a\xd4\x00\x87\x0f\x22\x47\x50\x33\xe0\x70\x7e\xf6\x11\x94\x86\xcf\xe7\xd3\x8f\x11\x70\x99\xba\x88\x0c\x90\x05\x78\x10\x36\x03\x9b\x21\x7c\x3a\x25\x61\x1a\x09\x32\xd3\x15\x67\xe0\x24\x88\x93\xd6\x50\x04\x1a\x13\x51\x0a\x94\xd6\xec\xd9\x57\xd0\xe4\x4c\xe9\xc2\x1f\x05\x3e\x28\x5d\x9c\x72\xcb\x07\xbe\xb1\xa4\x47\x63\x5e\x96\x8e\x26\x87\x88\x45\x40\xae\xfc\x73\x3a\x62\xb4\x3e\x97\x9e\x36\x34\x5b\x11\x16\x62\x1c\xdf\x2a\x21\x07\x2c\x62\xcb\xce\x79\xb1\xb5\x95\xf4\xb9\x71\x30\x0c\xdb\x6c\x7c\x7c\x5d\x35\xf3\xd4\xdb\x4c\x1c\x90\x56\xb6\xd4\x8a\x3a\xef\xd3\xfa\x11\xcf\x0d\xf6\x34\x3f\x6b\xcf\xba\xfa\x1d\x76\xe5\x52\x41\x9b\xd8\x92\x9a\x86\xf3\xdd\x7d\xcf\x36\xed\xed\xfd\x8f\x3b\x0d\x9f\x53\xf7\x12\x16\xfa\x5e\xe9\xde\x41\xad\x6f\x89\x36\x99\xfd\x38\xbd\x64\xdb\xc9\x58\x5b\x12\xbb\xe3\x5f\x53\xd8\x33\xe4\xbd\x5e\x88\x87\x86\xde\xbe\x27\x5f\x32\x05\xbe\x95\x32\xbf\x88\x8a\x95\xde\xea\x85\xd2\xf2\x5e\xc9\x7b\xd4\xc6\x1d\x04\x9f\x7d\x51\x24\xa1\xf1\xdf\x82\x82\xbf\xea\x1b\x85\x55\x26\x82\xe7\xcf\x56\x29\x2b\xd4\xc4\x6c\xb4\xe6\x64\xc4\x62\x7f\xb9\xf1\x02\x78\x8b\x63\xd0\xc8\xd3\x90\x27\x28\xb8\xbe\x33\xee\xbd\xbb\xc4\xb9\x7c\xfb\x86\x91\x00\x37\x4e\xb5\x83\xfa\x0d\x8b\x4f\x61\x7c\x4b\x47\x14\xea\x76\x13\x4b\x9e\x77\xf5\x23\xae\x30\xce\x94\x15\x33\x7f\x8a\x37\x1b\x3d\xc7\x5c\x55\x1a\x64\x28\x12\x51\x93\xa2\x64\x3e\x77\xbc\x54\x92\x1c\x81\x92\x68\x22\x32\x49\x7d\x4b\xa6\x1e\xa0\xe0\x72\x0e\x5c\x2f\x05\xfa\xaa\x72\xd5\x79\x40\x4e\xad\xf6\x3c\x55\xb8\x02\x9f\xc1\x08\x06\x1e\xf5\x3b\x60\xef\xea\xe1\xc9\x5b\x06\x13\x60\x6c\xf8\x52\xca\x70\x95\x98\xb6\x0e\xc3\x58\x56\xba\x42\x5f\x83\x2e\x3b\x3c\xcf\x7d\x9d\x16\x3d\x35\xd9\xcb\x7b\x68\xbf\x77\x1b\xa8\x74\x0e\x27\x3d\xfc\xc6\x44\xa7\xbf\xf3\xa3\xee\x6f\xcd\xe0\x10\x56\xb2\xd4\x67\x26\x66\xa3\x35\xc5\x65\x49\x87\x8d\xe0\xe6\x02\xa9\x74\x53\xed\x6b\x1c\x6f\xdb\x1b\xf7\x3e\xa3\x74\x56\x5f\x07\x23\x64\x80\x35\x14\x78\x73\xeb\xa1\x8f\x48\xa1\x39\x43\xec\xec\x6f\x3b\xef\xf2\x7e\x7d\x71\x9a\xea\xda\x24\x5a\x5c\xe3\xc6\x56\x81\x3c\xc9\x00\xef\xe9\x16\xc7\x6f\x92\x33\xc4\x94\x36\x47\x61\x21\x73\x87\x04\x53\x1f\x6c\xea\xeb\x7c\x57\xd0\x64\x72\x4a\x3a\x17\xaa\xd2\x09\x46\xf0\x90\x89\x24\x03\x8d\xa6\x2a\xd0\xc0\xf5\x1c\x84\xa5\x70\xe0\x21\x43\x09\xc2\xd2\xe9\x40\x49\x89\x89\x35\x1d\xd9\x6c\xc1\x05\xd9\xec\x4c\xa2\xf3\xb6\xfc\x7d\xa3\xf5\x3f\x68\x52\xe8\x02\x31\xcc\x1f\x3c\xfe\x61\xfe\x36\x8b\xd1\xa5\x1e\x7d\xfa\x9e\x87\x86\xfe\xc6\x84\x35\x87\x64\x56\xa2\xbb\xca\x60\x66\x2e\x13\x4c\x69\x14\x56\x33\x7d\xd7\x68\xd0\xb2\x7f\xc7\x33\xa5\xa7\x3c\xc9\x06\x0d\x5a\x8a\x23\xac\x94\x1a\xe9\x98\xa7\xa9\xa3\xe9\xab\x30\x16\x25\x6a\x27\x17\xb5\x41\xae\xe8\x84\x3f\x0c\x0c\xdc\x85\x6e\xc9\xb5\xc1\x01\x8e\xe9\xc8\xb3\xf2\x33\x84\x1f\x2f\x86\xc7\xe1\x0f\x2d\x46\x55\x3a\xc1\xe3\xa3\xc5\xd1\x7f\x03\x00\xbc\xc1\xdd\xdb\x8a\x1b\x00\x00")
 
 func staticApiJsBytes() ([]byte, error) {
 	return bindataRead(
@@ -162,7 +162,7 @@ func staticApiJs() (*asset, error) {
 		return nil, err
 	}
 
-	info := bindataFileInfo{name: "static/Api.js", size: 2891, mode: os.FileMode(436), modTime: time.Unix(1516813205, 0)}
+	info := bindataFileInfo{name: "static/Api.js", size: 7050, mode: os.FileMode(436), modTime: time.Unix(1792283977, 0)}
 	a := &asset{bytes: bytes, info: info}
 	return a, nil
 }
@@ -1707,7 +1707,7 @@ func staticToastrMinJs() (*asset, error) {
 	return a, nil
 }
 
-var _templatesClientHtml = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xdc\x7d\xfd\x92\xdb\x36\x92\xf8\xff\xf3\x14\x30\xed\x0d\xa5\x58\xa4\x34\xe3\xaf\x58\x33\xd2\x6f\xed\x89\xf3\xbb\x6c\x9c\xd8\xb5\x9e\xd4\xd6\x96\xcb\xe5\x82\x48\x48\x42\x06\x04\xb8\x00\x34\x1a\xad\x77\xaa\xee\x35\xee\xf5\xee\x49\xae\xf0\x41\x12\x04\x41\x8d\xc6\xce\x25\xd9\xd3\xdd\x66\x24\xa2\xbb\xd1\x68\x34\xba\x1b\xdd\x20\x7c\x76\x2f\x67\x99\xdc\x95\x08\xac\x65\x41\xe6\x47\x67\xea\x0f\x20\x90\xae\x66\x11\xa2\xd1\xfc\xe8\xe8\x6c\x8d\x60\x3e\x3f\x02\xe0\xac\x40\x12\x82\x6c\x0d\xb9\x40\x72\x16\x6d\xe4\x32\xf9\x26\x6a\x1a\x28\x2c\xd0\x2c\xba\xc2\x68\x5b\x32\x2e\x23\x90\x31\x2a\x11\x95\xb3\x68\x8b\x73\xb9\x9e\xe5\xe8\x0a\x67\x28\xd1\x3f\x46\x00\x53\x2c\x31\x24\x89\xc8\x20\x41\xb3\xe3\x11\x10\x6b\x8e\xe9\x65\x22\x59\xb2\xc4\x72\x46\x59\x87\x70\x8e\x44\xc6\x71\x29\x31\xa3\x0e\xed\x0e\x18\xdc\xc8\x35\xe3\x1d\x08\x82\xe9\x25\xe0\x88\xcc\x22\x9c\x29\x02\x6b\x8e\x96\xb3\x28\x4d\xc7\xf5\xff\x2f\xe1\x95\x6a\x4a\x71\x66\xfb\x96\x58\x12\x34\xff\x01\x5f\xe2\xb3\xb1\xf9\xae\x9e\xde\x4b\x12\xf0\x92\x31\x29\x24\x87\x25\xc8\x18\x47\xe0\xfc\xdd\x3b\x90\x24\x4d\x37\x86\xf6\x58\x48\x28\x71\x36\x5e\x54\xc0\x69\x81\x69\x9a\x09\x11\x19\x3e\x84\xdc\x11\x24\xd6\x08\xc9\xa8\x26\x7c\xbe\x11\x92\x15\xc0\x34\x81\x25\xe3\x40\xae\xb1\x00\x12\x15\x25\x81\x12\xed\xeb\x85\xb0\xd5\x1e\xe2\x46\x74\x20\x47\x4b\xc4\x81\xe0\x59\x83\x08\x09\x49\x7f\x11\xd1\xfc\x6c\x6c\x60\xfa\x3a\x90\x0c\x0a\xc9\xc3\x5d\x80\xb1\x27\x63\xb7\xad\x4d\xa6\x40\x39\xde\x14\x09\xca\xb1\x64\xbc\x91\x88\x52\xc0\x59\x24\xd1\xb5\x1c\xeb\xdf\x0a\x0c\xce\x22\x91\x71\x84\xd4\x74\x07\x74\x6e\xbf\xa8\x75\x37\xfd\xdc\x1e\xb5\xf9\x85\x65\x49\x50\x22\xd9\x26\x5b\x27\x46\x3f\x04\xfe\x27\x12\xb3\xe8\xc9\xb3\xeb\x27\xcf\xfc\x31\x18\x68\x05\x97\xe8\xf6\xb4\xa4\x2b\x5f\xcb\x7a\x29\x3e\x9d\x5c\x3f\x9d\xec\xa1\xa8\xdb\xef\x44\xf1\xd9\xc9\xf5\xb3\x93\x3d\x14\x75\xfb\xdd\x28\x3e\xbd\x7e\xf6\x74\x1f\x45\xd5\x7e\x27\x8a\xc7\xc7\x8f\xaf\x8f\x8f\x1f\xef\xa1\x69\x21\xee\x46\xf5\x64\x72\x7d\x7c\xb2\x4f\x9a\x16\xe2\x6e\x54\x1f\x3f\xbe\x3e\x7e\xbc\x97\x57\x03\x71\x37\xaa\x4f\x4e\xae\x8f\x9f\xec\x9b\x27\x0b\x71\x37\xaa\xdf\x4c\xae\x8f\xbf\xd9\x2b\x01\x03\x11\xa2\x6a\x28\x99\xa5\x87\x0b\xb8\x42\x63\x05\x54\x93\x7e\x7e\x72\x7d\xfc\xfc\x24\x02\x3e\x6d\x9a\x73\x86\x73\x4b\xdd\x00\xdd\x9d\xfa\xa3\x93\xeb\x47\x1d\x61\x58\x13\x9c\xe8\xc6\xbb\xd3\x7c\xfe\xf4\xfa\x79\x47\x6d\x2b\x9a\xba\xf1\x33\xa4\xf0\xf4\xfa\xb8\x97\xa6\x6e\x0c\xd1\x2c\x20\xc5\x4b\x24\xba\xd6\xcf\x3e\x4f\x7f\x11\x8c\x76\x5c\x57\x21\xd4\xbc\xe1\x0c\x2a\x1f\x97\x5c\x60\x82\xce\x19\x69\xf9\xb2\xfb\xe8\x64\x71\x9c\x3d\x3b\x00\xf3\x7b\x35\x12\x07\xb3\xe6\x40\xf4\xa8\xb0\x43\x4d\xae\x51\x81\x92\xac\xb7\xef\x23\xc7\xa3\x68\x5f\xb2\x96\xb2\x14\xd3\xf1\x78\x43\xcb\xcb\x55\x9a\xb1\x62\x2c\xb6\x08\x49\x48\x10\x97\x27\x7f\x7e\x96\x3e\x4e\x8f\xc7\x39\x16\xd2\x7d\x9c\x76\x1d\xcf\x11\x00\x8a\xb0\xb2\xd4\xf6\x87\xfa\xe0\x62\x95\x5e\xe2\x4b\x9c\x94\x38\x03\x9f\xea\xc7\xea\x33\xfe\xba\x84\x79\x8e\xe9\x6a\x0a\x26\xe9\x31\x2a\x4e\xbf\x1e\xb7\xda\x0b\x78\x6d\xe2\x8d\x29\x80\x1b\xc9\x4e\x3b\x8d\x6b\x84\x57\x6b\x39\x05\x8f\x27\xe5\x75\xd3\x7a\xd3\x74\x5e\x40\x4c\xbd\x4e\x6d\x97\x89\x64\xe5\x14\x3c\xef\x43\xbc\x5f\x32\x21\x85\x87\xba\x64\x54\x26\x4a\xb1\xa6\xe0\xf8\xa9\x8b\xe8\xd2\x25\x68\x29\xa7\xe0\xe4\x71\x0f\xe1\x54\x11\x4e\x72\x28\x91\xc4\x05\xda\xd3\xc1\x49\x0f\x01\x25\xcf\x92\xb3\x25\x26\x28\x20\x52\x47\x64\x27\x4f\x7c\x16\x5d\x91\xb5\x5b\xbb\x23\x4f\x33\xc8\xf3\x64\xc1\xf2\x5d\x58\x7e\x6a\xca\x9e\x3d\xe1\xa8\xb8\x95\x48\x87\x43\xbe\xc2\x34\x59\x30\x29\x59\xb1\x9f\x4a\xca\x51\x86\x4b\x8c\xa8\x0c\xd3\xb0\xa2\xee\x0e\x53\xb7\x72\x3b\xd0\xbd\x13\x91\xb1\xa2\x40\xb4\x33\xd3\xed\xc9\x6c\x4f\x76\x87\x06\xcc\xd4\xb2\x0d\xb3\x68\x99\x78\xec\xf3\x98\x6d\xb8\x60\x7c\x0a\x4a\x86\xa9\x44\xdc\x6b\x54\x4b\x77\x0a\xee\x4f\x26\xcf\x16\xcb\xe5\x69\xaf\x8a\xec\xd7\x31\xb5\xf2\x21\xa6\x88\x7b\x9c\x59\xf5\x38\x9e\x4c\xfe\xb4\x6f\xee\xe0\x08\xa4\x1b\x81\xb8\x1d\x9e\x00\xd0\xa3\xa3\xa2\xbd\x24\x47\x19\xe3\xda\x6c\x4d\x01\x65\x14\x85\xf9\x51\x74\x44\x42\xb0\xe8\x4e\x64\xa3\x91\x8f\x26\x13\x5f\x4a\xec\x0a\xf1\x25\x61\xdb\x64\xe7\x5b\x00\x9f\x7a\x52\x2d\x09\xed\x02\x0e\x19\xb1\xfa\x54\x5d\x77\xad\xcb\xf8\x6b\x77\x25\x69\xce\x3c\xe3\x64\x20\xea\xe5\xe4\x83\x38\x0c\x8e\xbf\x36\x13\x52\x6b\xf3\x7e\x6d\xeb\xe8\x73\xd5\x1c\x54\xe8\xaf\xc7\xad\x8e\x04\xce\xd1\x02\xfa\x53\x5e\x32\x81\xcd\x1c\x2d\xf1\x35\xca\x0f\x15\xb2\xfa\xfc\x33\xc1\x34\x47\xd7\x5a\x7a\xed\x96\x80\x01\x55\x9f\x6a\x61\x7f\xd3\xd1\xf9\x05\xe3\x39\xe2\x53\x70\x5c\x5e\x03\xc1\x08\xce\x01\x5f\x2d\xe0\x60\x32\x52\xff\x97\x1e\x9f\x3c\x19\x86\xe0\x13\x0e\x73\xbc\x11\x53\x90\x9e\xf4\x1b\x0a\x3b\xec\x64\xcb\x61\x59\x76\x34\xbe\x19\x3e\x47\x04\x4a\x7c\x85\xfa\x05\x48\xd8\x8a\x79\xe8\x76\x39\x6e\xd7\x58\xf6\x21\xde\xc2\xc0\x7e\x5f\x63\x14\x58\xad\x0e\xd1\x10\x2a\x58\xbe\x21\xbe\x22\xd7\xb6\xf7\x49\x79\x0d\x8e\x03\xa2\xff\x55\x05\x0c\x6a\x33\xa6\x3b\xbc\x95\x77\x02\x17\x88\xdc\x62\xed\x3d\x32\x0d\x1d\xc9\x56\x2b\x82\x42\x36\x42\x5b\xbc\xad\x5d\x67\x0b\x46\xf2\x3b\x58\x52\xc9\x21\xad\xe6\x1e\x12\x02\xd2\x13\x01\x10\x14\x28\xc1\xb4\xdf\xac\x7a\xd6\x3e\xc4\xe2\x74\xad\x56\x4d\x58\x4f\xee\x3f\x3e\xf9\x66\x91\xc1\xcf\x60\x64\x8f\x09\xc7\xc5\xea\xcb\x8c\x5a\x25\xec\xb3\x71\x15\xa0\xe9\xcf\xd9\xd8\x64\x87\x8e\xce\x94\xab\xaf\x62\xb8\x7b\x49\x02\x74\xda\x08\xf1\xb9\x81\x40\x7c\x6e\xb3\x17\x00\x9c\x51\x78\x05\x32\x02\x85\x98\x45\x0b\xc2\x56\x49\x01\x85\x54\x40\x80\xc2\x2b\x65\x7c\xb4\x91\x51\x01\x56\x34\xaf\xfb\x3f\x5b\x32\x5e\x54\x58\xea\x7b\x82\x29\xc1\x14\x81\x42\x26\x27\xea\x3f\x45\x9e\x4c\x1c\x78\x8d\x03\x2b\x04\x43\x38\x59\x70\x48\x73\x0f\x48\x03\x2a\xf1\x58\xd0\x3a\xd6\xc4\xc5\x2a\x59\x92\x0d\xce\xa3\x76\xc6\x44\x01\xd4\x71\xb3\x43\x63\x0c\xbd\x07\x98\x96\x1b\xd9\x62\x59\xcd\x07\x67\x04\x14\x3c\x11\x45\x72\xe2\xe6\x3d\x22\x50\x12\x98\xa1\x35\x23\x39\xe2\xb3\xe8\x1d\x82\x3c\x5b\x47\x00\xe7\xb3\x48\xe8\xef\x17\x0a\xc8\x91\xc7\x58\x51\xb4\x02\x1d\x53\x78\xa5\x65\x7f\xa6\x03\x56\xce\x88\xda\x14\x40\x4c\xa3\xaa\xfb\x5a\x13\xec\x90\xaa\x99\x32\x3c\x6a\x36\xd6\x38\xcf\x11\xd5\x3d\x12\x24\x25\xe2\x6f\x37\xbc\x64\x02\x45\xe0\x0a\x92\x0d\x9a\x45\x36\xcf\x73\x0b\xd6\x5f\x51\x49\x76\x17\xec\x33\xb0\x60\x86\x44\x00\xad\xe4\xa8\x1a\x45\x01\xf9\x65\xce\xb6\xd4\xc8\xa5\xfa\xf5\xbd\xa2\x5b\xc9\xd2\x50\x8e\x4c\x0e\x6d\x16\xe5\x58\x94\x04\xee\xa6\x3a\xb6\x50\x9b\x8d\x92\xa3\x79\xa3\xa4\x0b\xb4\xc2\x14\x98\x6c\x11\x28\x58\x0e\x49\xa3\xa5\xaa\xfd\xc7\xf6\xa3\x1c\xd7\x8a\x6b\x80\x97\x30\x47\x86\x99\x2d\xc7\x12\xd3\x95\x46\x88\xec\x0c\xe4\x18\x12\xb6\x8a\x00\xe4\x18\x26\xda\xba\x11\x94\x2f\x76\x6d\xe0\xd7\xea\xb9\x85\x31\xcc\xcf\x22\xc9\x37\x28\x02\x39\x94\x30\x59\xc0\xec\x32\xe7\xac\x9c\x45\x46\xfd\xec\xe3\x4b\xb4\x5b\x30\xc8\xf3\x59\xb4\x84\x44\xa0\x5a\x31\x3a\x2c\x26\x86\x09\x33\xb8\x84\xac\x6a\xde\x58\xb6\x51\x91\xab\xab\x52\x1d\x5c\xbb\xf9\x8b\x40\x67\x88\x2f\x59\xbe\x6b\x2d\x80\x2e\xb2\x59\xf6\xfe\x2a\x59\x3f\x69\x43\xe9\xcc\x6a\x57\x84\x46\x2a\xca\x78\x3c\xf1\x08\x2c\x36\x52\x32\x6a\x67\xdb\xfc\x68\xb4\x9c\x68\x7d\xd5\x12\xca\xb1\x28\x70\xdd\x8f\x3b\x09\xb3\xe8\x5c\xc3\x75\xed\x40\xab\x23\x51\x42\x1a\x98\x96\xf9\x57\x6a\xe3\x25\x4e\xcf\xc6\x0a\xe0\x16\x1a\x63\xc3\x60\x4b\x50\xe3\x1c\x5f\xed\x97\xdc\xc2\x17\x6e\x1b\x08\xe5\x58\xc2\x05\xe9\x0e\xe0\xac\x9c\xff\xb8\x03\x4b\x28\xd7\x88\xff\xf7\x7f\xfe\x97\x00\x4b\x58\x60\xb2\xd3\xdb\x7a\xb0\x40\x98\xae\xba\x96\x0f\xda\x14\x45\xb5\x87\x47\x34\xdd\xe2\x4b\x5c\xa2\x1c\xc3\x94\xf1\xd5\x58\xfd\x1a\xbf\xc5\xe5\xc7\xc1\xff\xe7\x08\xca\x8f\xaf\xae\x4b\x94\x49\x1d\xb2\x8b\x61\x34\x7f\x8b\x39\xc7\xa5\x32\x7e\x23\x00\x69\x0e\x8a\x1d\x38\x5f\x73\x2c\x24\x86\xd4\x74\xfc\x76\x8d\x09\x2e\x47\xaa\x05\xd3\x25\xa4\x12\x48\x46\x57\x1b\x04\x32\xb6\x21\x39\x28\xe0\x25\x02\x6c\xa9\xe2\xbe\xb5\x46\x10\x80\x32\xb9\xc6\x74\x05\x08\xa3\x2b\xc4\x01\xe3\xa0\x60\x1c\x01\x74\x5d\x12\x9c\x61\x09\xe4\x1a\x52\xf0\x16\x97\x29\x78\xc7\x46\xe0\x7b\x90\x41\xb5\xb0\x40\xb1\x13\x88\x2c\x55\x83\x61\x25\x53\xbd\x4b\x06\x16\xc8\x42\x74\x46\xaf\x68\xf8\x32\x1c\x97\xbe\x5d\xf7\xe7\xeb\xf6\x09\x5c\x32\x26\xbb\xaa\xbf\x4f\x73\x17\x92\x82\x85\xa4\x89\x40\x19\xa3\x39\xe4\xbb\xb0\x16\xcf\xb5\xe6\x86\xd4\xaa\xcd\x86\x22\xb5\xe2\x6c\x53\x82\xfa\x5b\x62\xc2\x0f\x4b\xd7\xfc\xa8\xd8\x10\x21\x9f\x68\xc2\x31\x8f\x3f\x4c\x97\xac\x67\xe1\xb8\xf6\x3d\x52\x31\x21\xab\x47\xc7\x11\xcc\x93\x12\x71\x35\x14\x5d\xbf\x31\x89\x26\xa6\xab\x39\x8d\xcd\x57\xd3\x17\xe9\xb8\x23\x63\x45\x49\x90\x54\x30\xcb\x65\x34\x7f\x87\xc8\xb2\xcb\xe0\x58\x73\xf8\x87\xe0\x7c\xc9\x31\xa2\xb9\x08\x32\x0f\xbe\x33\x8d\xbf\xc6\x00\x80\xda\x4c\x5f\xf5\x99\xae\x2f\x1f\x47\xb9\x59\x10\xe5\x63\xba\xc3\x00\xd9\x1a\x65\x97\x28\x9f\x83\xb7\x1a\xe6\xb0\xd1\x74\xd6\xca\x81\xcb\xa0\xe4\xb8\xd0\x8b\x40\xc7\x3f\x9b\x45\x81\x65\x62\xdd\x43\x34\x7f\xa7\x7f\x1f\x60\x5b\x5b\x3f\x9d\x1f\xee\x57\xe5\xe5\x91\xb2\x5b\xbf\x42\x0c\xa0\x64\x7a\x58\x00\x50\x43\xf6\x7a\xff\x03\x1d\xba\xf9\x91\x64\x48\xed\x5d\x50\xfe\x59\xde\xfd\x57\x76\xe3\xde\xd8\xe6\x3a\xb8\xd6\xf1\x9a\x7a\xf8\x13\x2c\xaa\xe4\xb4\x8e\xaa\xeb\xa9\x87\x04\xaf\x68\x52\xe0\x3c\x27\x08\x38\x09\xca\x68\xfe\x15\x5d\x88\xf2\x74\xaf\xa3\x05\xb5\xc3\x6e\x75\xa4\xfe\x17\xcd\x7f\x84\xfc\xd2\x7a\x6b\x70\xb6\xe0\xf3\x33\x51\xa8\x2d\x94\xed\x57\xa7\xa1\x8a\x8d\x54\xc2\xb3\x41\xa3\xb3\x99\x7b\x36\xf9\xd3\x69\xd4\x26\x6a\xf4\xff\x07\xb4\x8b\xe6\x10\x8a\x9c\x5c\x2e\x7f\x81\xe2\xf9\x2f\xcb\xe7\x8f\x7e\x59\xc2\xb3\xb1\x26\xfe\x7b\x06\x2e\xe0\x57\x0a\x5e\xc0\xaf\x1b\xc0\x74\x67\xe7\xbc\xd2\xbf\x2e\x37\xff\xab\x3e\xd6\x78\x82\xef\x30\x91\x88\xf7\xb8\xd2\x3b\xdb\xa8\x25\x23\x84\x6d\x5f\x1a\xc8\xf9\x77\xfa\xd7\x17\xd0\xce\xa1\x8a\x7c\xa2\xf9\x4b\xc2\xb2\xcb\x2f\xa0\xf3\xd9\xe1\xc4\x67\x58\x52\x67\x86\x38\xdb\x06\x0d\x58\xc6\x88\xda\xf8\x3e\x07\x76\xdb\x8f\x69\xe4\x54\x7a\x0c\xa8\x92\xa6\xce\x20\x2b\xbd\xd0\xd4\x1b\x2e\x94\x39\x1e\xa7\x1a\x59\x81\xd4\x46\x19\x84\xb2\x0a\x25\x5c\x61\xaa\xe3\xd4\xb6\x85\x83\xbe\x90\xd8\x46\x12\x4c\x51\x33\xa1\x26\x22\xbe\x1f\xcd\xdf\xa8\xcd\xb8\xb7\xb1\xef\xc7\xaf\x85\x0d\x72\x2c\x54\x80\x9e\x3b\xa4\x7e\x42\x5b\x8f\x94\xdd\xb5\x87\x04\xec\x8c\x53\x6f\xe8\xd5\x38\x8f\x8e\x4c\x5a\x45\x21\x01\x67\xe4\x67\x50\xe0\x1c\x79\x12\x7e\x04\x0a\xfd\x57\xf9\x71\x23\xec\x2a\xb1\xeb\x27\x1a\xdd\x5c\xcb\x91\x3b\x57\x16\x30\x72\x3c\xe1\xcf\x02\xf1\xca\x34\xb7\x65\xdf\xc5\xab\x12\x90\xed\x9f\x09\xa6\x02\xc9\xc0\xde\xa6\x27\x27\xa3\x94\xa1\x9b\x9e\xaf\xd5\x3c\xd4\x64\x72\x35\x97\x6a\xd7\xd2\xc9\xd0\x74\x83\x91\x70\xd7\xeb\x27\x4d\xcf\x54\xfb\x90\x80\x3d\x3f\x98\x9a\xf1\x08\x41\x5b\x6b\x7c\x09\xb8\x44\xbb\x69\x38\xa2\xcb\x58\x8e\x1c\x29\x68\x70\xb5\xf9\x57\x1c\xa9\xb6\x40\x77\xe3\x40\x7f\x3d\xcc\x76\xe4\x5b\xaf\xb9\x03\x86\x65\xed\x8f\x6f\x71\x0a\xfd\x47\xd7\xc2\x9d\xf2\x0f\xc8\xd6\xca\xa4\x19\x69\x02\x1d\x2d\xcc\xa2\x73\xfd\x4c\x83\x19\x29\xf7\x44\xb5\x75\x0e\x0d\xaa\x2d\x6d\x55\x73\x4b\x20\xd1\xbe\x03\x87\x64\x10\xb2\x98\x01\xae\xd5\x56\xba\x1a\x3b\xb8\x65\x08\x35\xdb\x3f\x97\x39\x94\x75\x8c\x72\x28\xd7\x9a\x52\x86\x79\x66\xa4\xfc\xa5\x5c\x9b\xd8\xf3\x70\x96\x09\x83\x79\xbd\x76\xed\x52\x31\x3b\xc1\xd6\xa0\xdc\x96\xd2\xe4\xff\x66\x91\x21\x96\x40\x21\xf0\x8a\x8e\x5d\x10\xb5\x79\xaf\x4f\x08\x7c\xcb\xe1\x4a\x6f\xbc\x73\xce\x4a\xa0\xbc\xbd\x82\x4c\xd3\xf4\x60\x11\x69\x2e\xef\x24\x9d\xb0\x9f\xaa\x4d\x56\xaa\x42\xfb\x8e\xd9\xf2\x2c\x5a\x55\xa0\x3c\xd0\xa2\x69\xf9\xea\xcd\x98\x5b\xde\x0c\xd9\x34\xb3\x8f\x9b\xbf\x30\x10\xd5\x06\x29\xb4\xc2\x58\x1d\x8e\x12\x2c\x64\xb2\xa1\x3a\x12\x0d\xa6\xaa\x49\x40\x36\xc0\xcd\xe4\xdc\xbf\x17\x75\x14\xa5\x3d\xd7\x7f\xe3\x58\x22\x00\x81\xf2\xa1\xfe\x5c\x8b\x35\xe4\x28\x91\xed\x64\xf3\xad\x33\x57\x22\x9a\x61\xd2\xac\x48\xf0\x4e\x91\x09\xf3\xe9\xe7\xc9\xcd\x43\x35\xac\x2f\x1b\x2c\xcc\x73\x81\xf8\x55\x27\x26\xdc\xcb\x78\x85\xa1\x99\x7e\x91\xe7\xc0\x3c\xb8\x2b\xe7\x5f\xc4\xb8\xd8\xd1\x6c\x53\xde\x89\xeb\x1d\xcd\x5c\x61\xef\x68\x77\x3f\x7e\x57\x8e\x75\x70\x71\x27\xe5\xe2\x50\xa0\xfd\x46\x30\xc8\xbc\xe4\x50\xac\x1d\xee\x5f\x29\x3a\xd5\xfa\xbc\xe3\x30\x5a\x6b\xd6\x3c\x65\x24\x18\xb6\xb6\xad\x81\xbb\xe4\x83\x6b\xbe\x29\x4b\xee\x49\xc4\x7a\x86\xc1\xec\x01\x70\xa7\x32\xe4\xa3\x39\x75\xc0\x03\x2c\xa3\xf6\x78\x90\x23\x69\xea\xf7\x3d\xd6\x11\xd4\x53\x18\x44\x35\x45\x93\x40\x4d\xc4\xce\x81\x2f\xc6\xea\xf3\x5d\x35\x24\x30\xd0\x1b\xb8\xf9\xc4\xee\xd5\x86\x81\xf9\x08\x87\x09\xce\xc0\x5b\xb6\x0d\x34\x87\x3a\xfa\xab\x35\x5d\x4b\xd9\x13\xc8\xec\x9b\x11\xc4\x83\xf9\xcd\xdf\x60\x46\xac\xfc\x10\x17\xb7\xcb\xef\x8f\x29\x40\x9b\xd7\xfc\xbd\xc4\x67\xba\xff\x37\x15\x5e\xc6\x28\x45\xc1\xe8\xe0\x37\x13\xe0\x79\xc3\xc2\xbf\xa9\x10\x17\x84\x65\x97\xe1\x48\xe8\xb7\x10\xe0\x4b\xd3\xfd\x1f\x55\x78\x55\xbc\xeb\xe5\x67\x74\x4a\x20\x94\x4d\xa8\xb2\x00\x75\x36\xdb\xcb\x84\x8f\x53\xce\xb6\xd5\x6b\x26\xe3\x02\x62\x1d\x6e\xdb\xa6\xe6\x34\x89\x05\xd0\xfe\xc6\xe4\xe1\x5a\x99\x97\x56\x6a\xee\xac\x9c\xbf\x24\x6c\xd5\xbc\xc4\xb2\xd8\x60\x22\xf5\xcb\x2d\x9d\xe2\xe3\x0a\xc9\xe6\x1d\x8e\x8c\x15\xe3\x68\x5e\xbf\x6b\xa3\x82\x00\xb0\xd8\x75\x91\xe4\x16\x4b\x89\xb8\x86\x2f\x72\x16\xcd\xff\x5c\xe4\x4c\x41\xa7\x75\x2d\xef\xac\x6c\xb2\x25\x4d\x4a\xe6\x25\xcc\x2e\x81\x64\x40\xb2\xb2\x8e\x30\x2c\xca\xd9\xd8\x8c\x61\xee\x0e\xd5\x7b\xed\xe7\x2f\xf0\x0a\xbe\xd3\x47\x97\x35\xe6\xec\xce\x1f\x97\xf6\x5b\x02\x33\x94\x03\x28\x81\x5c\x23\x5d\x90\x60\x4b\xfd\xb5\xca\xe4\x03\xc1\xf4\xef\x12\xae\x90\x00\x7a\x37\xb7\x84\x42\x36\x93\xe1\x1e\xc8\xae\x8e\xaa\xfc\xf2\x8f\x0d\xe2\xbb\xe4\x51\x7a\x92\x1e\xeb\x97\x6d\x3a\x6f\xfa\x84\xb0\x4a\x56\x96\x88\x1f\x0e\xdf\x7e\xc3\xe9\x20\x94\xee\x6b\x40\x07\xa2\x25\xd5\xa1\x8f\x54\x48\x48\x73\x48\x18\x45\x87\xe1\xda\x17\x98\xba\x7d\xf5\x21\xbc\x28\xf1\x61\x94\xdf\x32\x21\xc5\x61\xa0\x2a\xf8\xec\x05\x9d\x1f\x1d\x5d\x41\x0e\x7e\xc0\x3f\xe0\x73\xa2\x4f\x2d\xcf\xc0\x72\x43\xb5\xf9\x1e\xc0\x12\x8f\xb4\xc9\x18\xe9\x62\xf6\x02\x66\x97\x43\x73\xa0\x4b\xae\xb1\x48\x61\x89\xc1\x0c\xc0\x12\x9f\x36\xcf\x14\x34\x98\x69\x24\xe7\xa9\x39\x9d\x3b\x03\xef\x3f\xb8\x0f\x4d\xea\x13\xcc\xc0\xa7\x1b\xe7\xf1\x03\x44\xc0\x0c\x3c\x18\x44\xe6\x4c\x6f\x34\x74\xda\x30\xc5\x72\x50\xb3\x72\x7a\x74\x73\x74\xd4\x70\x9e\x96\x9c\x49\x26\x77\x25\x4a\x33\x46\x08\xca\xa4\x1e\xf9\x77\x9c\x15\x4a\x5a\xee\xc0\x14\xe5\x21\xf8\x64\xc2\x70\x35\xfe\x8f\x8c\x9a\x64\xc4\xb9\x25\xee\x42\x23\xce\x8d\x18\x86\xce\x71\x36\xbc\x04\x03\xff\x99\xfb\x1c\x7c\xf5\x15\x88\x22\x70\xcf\xc8\x22\x5d\x21\xa9\x2b\x50\x83\xa1\x8f\xa0\x3e\x0f\x06\xb1\x77\x44\xfe\x3d\xdb\x52\xc4\x3f\xe2\x7c\x16\xc5\x0f\x2b\x0a\x75\x15\x68\x30\x7c\x18\x47\x1f\xe2\x61\x0a\xa5\xe4\x83\x58\xf0\x2c\x1e\x81\x78\x8c\x8b\xd5\xb8\x81\xae\xfa\x6b\x57\xb1\x6e\x8e\xbc\x8e\x95\x6f\x69\x0e\xad\x1f\xd8\xaf\xda\xab\x0f\x3a\xc3\xa8\xe0\xbf\x35\x2e\xe5\x27\x58\xa0\x41\xdb\x6b\x0d\xfd\x43\x8a\x37\xce\x1c\x64\x64\xd1\x23\xf7\xae\xd8\x95\x78\x27\x60\x66\xa5\x5b\xa9\x84\x48\x09\xa2\x2b\xb9\xf6\x65\xac\x81\x38\x5a\x61\x65\xbc\xda\x13\x3d\xe8\x4c\x7d\x87\x47\xf5\xe9\x40\x39\xdc\x9d\xba\x23\x81\x65\x99\x9a\x15\xb7\x44\x32\x5b\xab\xaf\x5a\xdd\x94\x5c\xde\x28\xd9\x7e\x9f\x0f\x86\x23\x33\x58\x8b\xa9\x06\xef\x9c\xb3\x9e\x81\x0a\xfe\xaf\xf5\xc3\x81\x05\x55\xc3\x0f\xb5\xba\x03\x56\x5e\x6e\xa0\x68\xe2\xd9\xe4\x14\xe0\xb3\x86\xb4\x95\xce\x29\xc0\x0f\x1f\xfa\x22\x0a\xf1\xdd\x60\xbe\xc7\x1f\xda\x3c\xbb\x33\xd8\xb7\x0a\x95\xd3\xcd\xd5\xd2\xfb\x19\x87\x16\x5f\x35\x74\x7d\x80\x66\xa6\x17\xb8\xa1\xcd\x91\xdc\x70\xaa\x4c\x80\xce\x68\x45\xc3\x14\xe6\xf9\xb9\xf2\xf5\x83\x28\x83\x3c\x57\x0f\xca\x12\xd1\xbc\xd1\xc0\x5e\x58\x53\x16\xec\x22\x00\x73\xc4\x5a\x2a\x73\x53\x3f\x34\xba\xba\xc7\xae\xd4\x23\x12\xed\x21\xd5\x26\x09\x7c\x6a\x19\xb2\x74\x2d\x0b\x32\x88\x63\x2b\x34\x7f\x66\x1a\xdb\xd8\x37\x33\x0a\xb8\x34\xc6\xab\x01\x7e\x8f\x3f\x34\x93\x50\x77\x15\x1a\xa1\x6e\x74\xa6\xc1\xc8\xfe\x28\xb0\x1a\x35\x64\xc8\x6c\x1a\x94\xd3\x5b\xa6\x5a\x19\xe6\xa0\x40\xf6\x4c\xf3\x83\x41\x9c\x36\x79\xcd\xb4\xce\x1b\xc6\xc3\x94\xd1\x41\x9c\x11\x9c\x5d\xc6\x23\xad\x98\xac\x44\x54\x97\xf7\x87\x15\x6a\x74\xbf\x55\xfd\x1c\xa6\x1a\x7c\x50\x33\xe0\x0a\x51\xf5\xac\x9c\x55\x6a\x50\xb4\x6a\x3f\x18\x28\x46\x86\x69\x0e\x25\x1c\xd8\x23\x28\x97\x68\x17\x55\xe6\xf2\xc6\xfe\x55\xbd\x1b\x5f\xab\x97\x85\xfe\x3a\x68\x5b\x28\x3d\x27\x9e\x89\x42\xbc\xe3\x18\xe4\x5a\x85\xb9\x88\xf3\x90\x79\xd1\x2c\x56\x5e\x52\xff\x3d\x6d\xb7\xb9\x9a\x37\xf0\x78\xac\x84\xad\x6c\x62\xf5\x7d\x60\xb4\x38\x3c\x5b\x1b\x93\x77\x77\xe6\x6b\xef\x7a\xfc\xa3\xcb\x40\x8d\x53\x47\x31\x6a\x66\x7b\xa2\x98\xe0\x0a\x55\x93\xef\x87\x1f\xa1\xa0\x06\xe6\xf9\x6b\xe5\x3a\x28\xe2\xb5\x25\xee\x8b\x47\x1a\x1e\x0e\x59\x1e\xfb\xc4\x5e\xb1\xe3\x18\x64\xba\x21\x64\xd4\x76\x90\x1c\x05\xe5\x1e\x90\xb4\x96\xa1\x1d\x32\x47\x66\xf4\x69\x13\xa8\xd5\x20\x36\x84\xa3\x68\x0b\xac\x17\x70\x60\x47\xf5\x6a\x1a\x06\xd0\xfa\x7c\x6c\xa3\x67\x6d\x7d\xd0\x88\x5a\x88\xae\x5b\xf1\x29\xeb\x79\x57\xac\x34\xd3\xde\xab\xf6\x0a\xa1\xad\x1a\xc1\x09\x71\x83\x9a\xd0\x3a\xb0\x2e\xa8\x0e\x69\xbd\x28\xa8\x9f\xb0\xab\x2a\x07\x2f\xb0\xda\x12\x9a\x14\x71\xea\xd4\x2d\x3d\x63\x18\x12\xa4\xd8\x42\x32\xf0\xd6\x19\x96\x04\x4d\x41\xfc\xb3\xad\x72\xc6\xa3\x76\x33\xba\x96\x53\x10\xff\x4d\x9f\x85\xdd\xb1\x0d\x20\xf8\x52\x9f\x5a\x35\x1d\xab\x47\xbc\xae\x90\xfe\x3f\x1f\x79\x57\x2a\xd2\x5b\xc8\x29\xa6\x2b\xaf\x51\x1f\x06\x9c\x82\x58\xf5\xe0\x35\x89\x35\xdb\x9e\x43\x9a\x21\x62\x6c\xf6\x14\x48\xbe\x41\x6d\x98\x8c\xd1\x25\xe6\x85\x01\xb8\x30\x5c\xfe\x1d\x09\x8f\x52\xe6\x50\xb1\x40\x3f\xb1\xd8\xd1\x9f\x54\xae\x11\x6d\x94\x8e\x23\xb1\x21\x32\x14\xa8\x9b\x96\x54\x1f\x44\x0c\xc5\xe5\xb5\xe7\x30\x82\xd1\x01\xad\x8b\xe3\xad\x45\xb5\xac\x42\x64\x40\xbf\x45\x6c\xf5\xa6\x26\x32\x7e\xc5\x39\xe3\xf7\x54\x3c\xff\x82\xaa\x05\xcc\x38\x60\x59\xb6\xe1\x1c\xe5\x53\x10\x83\x87\xea\x59\x5a\x20\x21\xe0\x0a\x8d\x40\xac\x21\xe2\x61\xff\x51\x35\xa3\xca\xe1\xf6\x9b\xf0\x63\xc3\xc8\xbb\x4d\x96\x21\x21\x34\x2b\x7f\x77\x35\x02\xac\xa1\x00\x0b\x84\xaa\x02\x7b\x9e\x2a\x10\x61\xc0\xfb\x58\x69\x6c\x84\xf1\x3e\x83\x00\xdc\x4d\xef\x5e\xe5\xc6\x59\xd3\xc1\x25\xe3\x16\xd5\x7b\xd7\x8c\x23\xfb\x56\x54\x31\x88\x5f\xe5\x58\x56\xe5\xa0\x78\xe4\xbf\xc1\x66\xea\x94\x53\xe0\x15\xa5\xab\x3a\x54\x47\x87\x25\xa2\x72\xda\xd8\xdb\xd4\xaf\x33\x05\x46\x13\xb9\x6f\x6a\x45\x7a\x00\x91\x1e\x40\x14\x5e\xf4\x41\x3b\x62\x48\x99\xc0\x46\xe0\x05\xc1\x74\x25\x06\xc3\x54\x10\x9c\xa3\x0b\x4d\x7e\x70\xf2\x64\x12\x26\xa8\x3f\x4a\x49\x2b\x02\x58\x0c\xa2\xe9\x15\x56\x74\x50\x14\xdc\xb5\x6a\x26\x70\xc6\xa8\xde\xaf\x6b\xcb\x9b\x2e\x31\xcd\x07\x91\xb8\x5a\x45\x21\x3d\x78\x30\xf8\x94\xa3\xd5\x14\x4c\x6e\x86\x29\xa4\xb8\x50\x7a\x60\x9e\x3c\x9f\xdc\x8c\xc0\xa7\x7c\x53\xbd\x97\x7b\x32\x99\x8c\x80\x90\xa8\x9c\xd6\xcc\x52\xb6\x1d\x7e\xd2\xd7\xe5\x64\x42\x0c\x3e\xe9\xb7\xd3\x96\x8c\x17\x53\x10\x73\x26\x15\xa9\xf8\x21\x65\xdb\x87\x71\x8e\x56\xc3\xf8\x66\x78\x73\xe3\x65\x4c\x6f\x00\x22\xc2\x7f\x3d\xf1\xcb\x06\xf2\xbc\x3b\x92\xdf\x62\x20\xfb\x35\xc9\xd6\x76\x6f\x57\xa2\xf1\x18\xa0\x2b\xe3\xbf\xf4\xdf\x6f\xd1\x12\x6e\x48\xcb\x19\x57\x1b\x10\x4c\x57\x4a\x38\x3a\x3e\x1b\x80\x68\xac\xfa\x88\x46\x7f\x79\xf7\xe6\xa7\x54\x48\x8e\xe9\x0a\x2f\x77\x83\x4f\x20\x82\x79\xce\x91\x10\xd1\x14\x44\x11\xb8\x19\xb6\xf6\x18\x96\x4e\x9a\x33\x8a\x1a\xeb\xac\xcf\x02\x80\x4e\xa4\x68\x32\x67\xd6\xa8\x0c\xf4\x32\xaa\x8d\x9e\x39\x38\xa2\x58\xfa\x01\x5f\xe2\x28\x10\x3b\x68\x79\xd4\x02\x69\xaa\xf4\x07\xc9\x04\xdc\x2a\x95\x3d\x2e\xf7\x9d\xee\xc8\xa9\xc3\x84\x7d\xef\x2b\x2a\x11\x07\xd0\x96\xfe\xb5\xef\x35\x18\x40\xb2\xe9\x5d\x3c\xee\x78\x5c\x3b\xdd\x0d\x27\x87\xbb\x63\xdd\xf4\xb6\x79\xfd\x6f\x0a\xe2\x2a\xb9\xad\x5f\x36\xa4\x48\x6e\x19\xbf\xfc\x3f\xe0\xc4\xf7\xab\x30\xd8\xa7\xc3\x2e\x69\xa5\xcd\x01\x53\x70\x17\xa5\xae\x27\xf4\xf3\x94\xbb\x91\xcd\x41\x6e\xb2\x2f\x44\xad\xc3\x69\x15\xa8\x7e\x2f\x51\xe1\xc6\xa9\x6e\x4a\xb3\xce\xc3\xc4\x67\x04\xcf\xe3\x70\x12\x45\xb5\xc2\x79\xc7\x67\xaa\x4f\xbc\xe6\x68\x19\x4f\x41\x74\xff\x9e\xe7\x23\x75\xab\x3e\x03\xa4\xa3\xd3\x69\x38\x73\xd8\x87\x53\xef\xd6\x1d\x44\x27\x38\xef\x43\xb3\x8e\xd9\x45\x32\x7e\xd9\xcb\x51\xde\x38\x39\xa4\x58\xbf\xfb\x02\x25\x52\x7c\x9a\xe3\x6f\x4d\xef\x61\x81\x54\x42\xc9\xf1\x95\x96\x59\x4d\xea\x10\x44\x60\x73\x59\xb8\x58\xcd\xa3\xd1\x9e\x50\x91\x67\x53\xd0\xbb\xad\xf2\x3f\x55\xd2\xd4\xc9\x0a\xdf\x29\x3d\xed\x7f\xac\x62\x44\x3a\xe1\x1c\x81\x87\x3e\x8d\xfd\xef\x4d\xdc\xec\x6d\xb5\xb4\xd5\x4e\xb7\x9f\xcc\xcd\x70\x10\xa8\x86\x76\xe6\xae\xe7\xed\x8e\x38\xa0\x22\xc0\xce\x9a\x39\xc7\xda\xcc\x50\x50\x31\xbb\x7d\x7b\x59\x6e\xfd\x6b\xcf\x2e\x31\x23\x08\x72\xb3\xa3\x0d\x6d\x12\x1f\x0c\xa2\xfb\xad\x13\xb2\x43\x93\x48\x8c\xa2\x26\xff\x95\xd6\x27\x7f\xdc\xab\x4a\xf6\x40\xaa\x0d\xe9\xad\x90\xf6\xec\xc3\x6d\x70\xb6\x40\xde\x0b\x77\xab\xe9\xf1\xc6\xbd\x67\x6f\xac\x74\xb7\x4e\xd6\xd4\x35\x1b\x50\xa7\x2c\x1b\x49\x0e\xaa\x18\xa8\x2d\xc2\xe6\xf8\xb2\x2d\x5b\xd4\xd4\x52\xd3\xf6\xf1\x12\xed\x82\xa8\xfa\x7c\xb0\x45\x6a\x12\x46\xa9\xde\x02\xfd\xeb\x5f\x20\x44\xc7\xf5\x10\x81\x59\xb4\x4a\xe5\xa2\xda\x23\xa2\x0e\x5e\x6b\xc4\xa9\xb9\x1b\xa6\x5e\xad\x5e\x83\xbf\x5a\xfd\x3e\xed\x01\x75\x5b\x28\x8a\x04\x57\x1e\xcf\xae\xdb\x87\x1d\x62\x4e\x1c\x15\x0a\x95\x0f\x24\x6e\x8e\xc1\x3f\x0c\xca\x67\x18\xbc\x38\xa2\xad\xce\xa2\x84\xb4\x3b\x57\x75\x7b\x55\xe2\x39\x0d\xa0\x2b\x1d\xdf\x87\x8e\xb8\xe8\x41\xb7\x8a\xdf\x83\x6c\x5a\xc3\xa8\xd5\x5a\x08\xa3\xda\xd6\x06\xb5\xc6\x0d\x16\x03\x82\x43\x0d\xd6\x6b\x14\xa6\x9a\x8c\x8f\x38\x07\xae\x5a\xd4\xc8\xad\x2a\x01\xe8\xa9\xf0\x58\x0a\x7e\x1e\xc3\x2b\xba\xb9\x9a\xd9\x97\x5d\x0c\xcf\x65\xdb\x40\xec\xf3\x7a\xed\x4c\x5f\x15\x9a\x98\x78\xe4\x16\x73\x7b\x13\xac\xd8\xdd\x26\xdf\x46\x17\x3e\x43\xbe\x88\x8b\xdf\x5d\xbe\x5d\x83\xfe\x9b\xc8\xf7\x10\x01\xb7\xd6\xcb\xdd\xc4\x6b\x50\x7f\x4f\xe1\x06\x7d\xe0\x1f\x45\xb4\x6d\x7b\x72\x27\xd1\x5a\xd4\xdf\x51\xb4\xe1\xb0\xe1\xb7\x13\xad\xae\x5f\xea\x4a\x11\x2c\x4b\x5d\xf8\x39\x6a\xe5\x01\xdd\x98\x44\xef\xe7\x47\xa0\x8a\x3a\xec\x10\xee\xb7\xae\x5c\x19\xa6\x7a\x4b\xe0\x16\xe7\x63\xb3\x93\x8c\xc1\x6c\xa6\xf7\xed\x6c\x69\x28\xb5\xe2\x6a\x9f\x90\x79\x69\xb9\xf2\x1d\x1a\xbc\xed\x63\xea\xdb\x37\x6c\x90\xd5\x1a\xa8\x9e\x5c\xbb\xb9\x51\x71\x89\x7d\xd5\x42\x27\xf1\x75\x89\x3b\x4d\xa3\x1a\xc1\x8b\x4f\xda\xd7\xe7\x0c\xd5\x4e\xd7\x6c\x47\xb9\x7a\xf4\x51\x32\x4d\x30\xea\xc5\xd2\xd7\xe7\x38\x68\x4b\xcc\x85\x54\x8a\xd7\x8f\x56\xdd\xf0\xe3\x60\xd9\x04\x6b\xb5\x79\xf5\x63\x8f\x8c\x51\xc1\x08\x4a\x09\x5b\x0d\xa2\x8b\x37\xdf\xbe\x01\x89\x7d\x67\x5e\x1f\x00\x8c\x86\x87\x09\xb6\x55\xf2\x8d\xb5\x98\xe3\x61\xbf\xa0\x97\x2c\xdb\x88\x70\x41\x19\xb8\x79\x56\x44\x50\xd6\x5b\xbf\x72\x13\xaa\xa6\xd4\xbc\x11\xd5\xcb\x4b\xdd\xa4\x6a\x68\xaa\xd5\x90\xd4\x16\x6b\x0f\x15\x03\xe8\xec\x4a\xfa\xa2\x37\x97\xb8\x16\x49\x48\x55\x82\xce\xb4\x3b\x0e\xe7\x2d\xac\x43\x07\xd2\x8f\x7e\x58\x97\x5a\x27\x25\x0b\x76\x17\xd4\xe4\x3e\xf4\x03\xe2\xdc\x10\xb9\xe8\x50\xd9\xf0\x7a\x65\xec\xe7\xd4\x59\x3d\xbd\x04\xee\xc4\xab\x43\xb0\x87\xd9\xbe\x75\xe8\x9f\x87\xb0\x6d\xce\xc1\x0f\x65\x29\x5f\xe8\xe2\x38\x45\x5b\x7d\x60\xf0\x45\xa9\xf7\x5c\xb5\xff\x70\x8a\xc6\xe2\xdc\x1c\x25\x31\xf5\x77\x8d\x68\x21\xdf\xda\x02\xbf\x82\xd4\xdf\x7b\x21\x7f\x6e\xd7\xa1\xcd\x66\xb2\x82\x19\x79\x29\x2b\x23\x15\x8d\xe6\xb2\xe8\x23\xd9\xf3\x5a\x26\x3d\x6c\x33\xc4\x36\x1b\x67\xaf\x25\x51\x6e\xa1\x96\x96\xb9\x23\xc1\x9e\x31\xf1\x73\x9d\x51\x8e\x16\x9b\x55\x34\x05\xfa\x36\x2c\xe7\x39\x45\x5b\x24\xe4\x1b\x7a\xc1\xca\x40\x6b\xc9\xd9\x8a\x23\x21\x5e\x42\x1e\x6a\xb5\xd7\x3e\xea\x14\x46\x34\x05\x91\xe6\xce\xde\x45\x68\xaf\xdc\x88\x5a\xd4\x4c\x8e\x7a\x63\xae\x62\x46\x22\x40\x93\x51\x93\xf0\x9e\xea\xac\x8a\xd3\x20\xd6\x6c\xfb\xad\xad\x54\xa8\xbe\x1e\x4d\x26\x2e\xed\x35\xce\x91\xdb\x7c\x3c\x69\xb7\x4b\x5c\xa0\x37\x1b\xa9\x9a\x9e\x78\x4d\xe8\x5a\x22\x9a\xa3\xfc\xa2\x01\xf1\xb1\x55\xe7\xaf\xa0\xc0\x54\x89\x30\x12\xfa\x1d\x26\xaf\xf3\xa6\x99\x60\x8a\x20\xf7\xd1\x7f\x44\x72\xcd\x72\xd5\xbe\x84\x39\xfa\x9e\xfa\xf8\xed\x76\xc5\x87\xd5\xe6\xe6\xd0\x61\x01\xf9\xe5\xb7\x6c\x4b\x5f\x29\xe7\x5f\x9d\x7b\x4e\xf5\x11\xe6\x77\xda\xb2\x33\x3e\x88\xd2\xfa\x0e\x38\xe7\xc4\x9e\x39\x47\x6c\x75\xed\x47\x7d\xb6\xf8\x95\x7e\x34\x88\x6b\xdb\xd7\x4a\x8b\x6a\x99\x08\xa5\x63\xd3\xee\x85\x93\x9a\xfc\xd4\xd2\xfa\xd1\xfe\xae\xbd\x0f\x18\x14\x79\x28\x31\xd7\x70\xaf\xed\xb9\xbd\xdc\x02\xcc\x40\xe1\x5d\x38\xe9\x54\x90\x6e\x1a\x21\x49\xc6\xc8\x02\x72\x9f\x9d\xea\xc2\xd1\xf3\xea\xec\xfd\xb4\x57\x32\xf7\x3b\xf7\xb3\x79\xb9\x35\xa8\x76\x2a\x3f\x6e\x88\xc4\x6f\x21\x87\x2b\x0e\xcb\xb5\xc1\xc6\xe1\xe2\x81\xbd\x1b\x6a\x0a\xde\xc7\x0b\x46\xf2\x78\x04\x62\x2c\x21\xc1\xfa\x80\xeb\x86\xe6\x88\x2b\x55\x50\x3f\x20\xcd\xd6\x8c\xab\x6f\xeb\x13\xfd\xdf\x47\xea\xbf\xff\xd8\x30\x89\xe2\x0f\x6d\xa2\x39\x5e\x2e\x5f\xeb\x7b\x6a\x27\xdd\x86\x0b\x56\x4e\x41\x72\xec\xb5\xe8\x40\xc6\x2c\x7c\xbd\x14\xa7\x20\x6e\x9d\x1f\x4f\x0c\xa3\x89\x86\xf3\x4a\x1b\x04\x1e\x84\xaa\xc0\xfc\xf2\x8a\x3e\x5f\xce\x73\xfc\x4f\x54\x4b\xe9\x9d\x84\x5c\x76\x16\xb5\x85\x96\x38\x0b\x49\x11\x6e\x24\x7b\x8d\xe9\x65\xa8\x6d\xfc\x35\xa8\x4c\xdd\x76\x8d\xb3\x35\x60\x94\xec\x94\xe5\x24\x3b\xb0\x5d\x23\x6a\xc9\x02\x2c\x34\x32\xf0\xae\x10\xd6\x49\xd5\x29\x88\x8d\x29\xea\xf0\x8f\xb3\xcb\x5d\x90\x59\x53\xf5\x7f\x43\x5f\x15\xa5\xdc\x39\x2a\x10\x00\x2d\xdd\xaa\x54\x57\xe9\xc7\x5f\x83\x8b\x35\x16\x00\x5d\xc3\xa2\x24\x08\x60\x9a\x91\x4d\x8e\x84\x79\x75\xc1\x14\xeb\xea\x21\xaa\x9d\x94\x43\x2f\x90\xf8\xc5\xcb\xfa\xee\x37\x2c\x40\x09\x85\x40\xb9\xf9\x27\x50\xb0\x92\x10\x94\x00\x4b\xe5\x37\x72\x5f\x12\xa0\xa9\xe6\x5d\xec\x4a\x1b\x45\x05\x2a\x6d\xea\xa3\xac\xd1\x1b\x7a\xae\xcc\xb0\x99\x93\xf6\xea\xf4\x1c\x75\xe5\x9e\x80\x76\xd8\xd5\xda\x1b\xa6\x1c\xc1\x7c\xd7\x0d\x49\x75\xc9\xf2\xc1\x20\xc2\xc5\xaa\x75\xfe\xb5\xb9\x4c\xd4\x0b\xc4\xbd\xcb\xad\x3a\x87\x27\xb5\x4b\xf1\xed\xcd\x78\x0c\xde\x49\x56\x02\x7d\x27\xea\x92\xb3\x02\x18\x32\xba\x54\x45\x19\x2f\x20\x21\x3b\x1f\xe3\xb6\x42\xaa\xfa\x98\x70\xa4\xe5\x77\xab\x4f\x1d\x88\x4c\x7b\x03\x97\x40\x2e\x3f\xaa\x76\x21\x2d\x34\x2f\x3e\x0a\xe1\x55\x9b\x9e\x0e\x5e\x13\x03\x86\xd0\x34\xc2\xfb\xe0\xfe\xf5\xc1\x20\xd6\x85\xd6\xd4\xbb\x0b\xed\x7d\xfb\x2a\xb4\x0f\x53\x7b\xd3\x59\x6c\x7b\xe9\x10\xfb\xd0\xed\x77\x3c\x06\x51\x15\x42\x4f\xad\x3b\x4a\x57\xa8\xf2\x02\x01\x22\x0e\xb8\x1a\x5f\xfb\x2e\xd3\xe1\xfb\xc9\x87\x14\x53\x8a\xf8\x05\xba\x96\x3d\xfa\x09\xbc\xed\x98\x11\xd1\xb0\x9b\x50\x78\x51\xe2\xd4\xe8\xc7\x6b\x0d\x62\x21\x7b\x4e\xe3\x38\x43\xfa\x8f\x17\xe7\x3f\x84\x9e\x83\xc4\x9a\x10\xab\x2f\xc1\x24\x84\xc9\x91\x1b\x80\x7a\x0b\xdb\x57\xd1\x6a\x8e\xb5\xaa\xa0\xf7\xbd\x87\xf5\x61\xdf\x39\x25\x13\x89\xeb\x1e\xc3\x47\x82\xd4\xf6\xdf\x12\xac\x36\xb7\x7b\xb8\xf8\x59\x1f\x8b\xba\xe5\x6c\x94\xd3\xa7\x3b\xca\x4a\xd7\xfb\xe8\x3b\x27\xc8\xc3\x03\xae\x08\x7c\x08\x97\xdb\xf4\xb6\xf0\x56\xb6\xf6\x8c\x0d\xef\xc3\xee\xcd\xd8\x80\x7d\xf9\x96\xd8\x9c\x95\x8a\xbd\x4d\x77\x95\x3f\x53\x96\xea\xfc\xaf\x6f\x5e\xbf\x06\x17\x6f\xc0\xc5\x9b\xb7\x8e\xed\x8b\x17\x2c\xef\xab\xbf\xd6\x45\xdb\x11\xf8\x84\xf3\x29\x88\x25\xbb\x60\x65\xec\x1f\xbe\x01\x00\x98\x73\x3b\xc1\x21\xc7\x55\x00\x1f\x4f\x41\xac\xef\x39\x0a\x78\x03\x0d\x68\x42\x7a\x05\x76\x32\x29\xaf\xfb\xa0\xf4\xcb\xa2\x0a\xe8\xf1\x1e\x20\x73\x9d\xb8\x82\xb2\x17\x8a\xf7\x01\xda\x77\x3d\x15\x24\x65\xd4\x3f\x16\x5a\x83\xd9\xfb\xf3\x63\x7d\x53\xf7\xa4\x3b\x6b\x01\x89\x34\x55\x57\xf7\xc6\xb3\x38\x04\xb9\xb7\xf4\x1d\x9f\xe1\x76\xd5\xdc\xbe\x39\xbb\x46\x57\x9c\xd1\x64\x53\x06\x48\xde\x7e\x44\xb6\xdd\x47\xb4\x96\x05\x19\x81\xea\xcd\x90\xea\xf4\x16\x10\x19\x67\x84\xe8\x78\x70\x02\x6e\x46\xe0\xe9\x64\x12\x3c\x7d\x11\x4e\xa6\x6d\x31\xcd\xd9\x76\x98\x1a\x2a\x4e\xe8\x1e\x3a\xb3\x52\x67\x8f\xaa\x2e\x07\x43\xb5\xa6\x27\x3d\x2f\x87\xdd\x37\xaa\x38\x4c\xcd\x3e\xc7\x5f\x4a\xbd\x8b\xd0\x47\x7d\xb3\xe9\x78\xdf\x9b\xe0\x12\xd2\x8b\x48\x20\x98\xad\x75\x44\x53\x3f\x35\x25\x5a\xc8\xb3\xf5\x5b\x44\x73\x4c\x57\x5e\x60\xd1\xdc\x1a\x6e\x27\x45\x1f\x10\xdd\x33\x2b\xba\x7e\xab\x76\x89\x6c\x23\x07\x2d\xca\x1e\x9f\xad\x36\x30\x03\x02\xc9\x0a\x6d\xdf\x8c\x37\x0c\x2b\xae\xec\x8b\x85\x2d\x36\xb5\xbf\x4d\x25\x7b\xcd\xb6\x88\x9f\x43\x11\x34\x54\x2d\x12\xcd\x8f\x54\x94\x04\xcb\x41\x7c\x0a\x62\xb7\xb4\xe7\x8a\xc4\xf9\x47\x65\xa2\x61\xaa\x24\xda\xf0\x8b\x47\x88\xa0\xa2\x47\x4f\x35\xe7\x6b\xb6\x05\x33\x13\x23\x87\xcd\xb3\xde\x89\x9a\x17\x26\x35\xad\x30\x54\x5d\x4f\xf8\xa8\x0b\x0a\x1f\xf1\x99\x33\x86\xaa\x94\xf0\x31\x50\x4b\x70\x3f\x4a\x6f\xef\x69\x8e\xbe\xfa\x0a\x24\xc7\x4a\x5f\x91\xd9\x77\xfa\xf2\x4b\xb5\xf9\x78\xb3\x1c\x34\xbd\xbc\xff\x88\x3f\xdc\x7a\xb6\xc4\x0e\x57\xc5\xc7\x7b\xce\x7e\x04\x5b\xc2\x4f\x15\xcb\x8a\xe8\xbe\x8e\x11\xe9\x59\x55\x35\xe9\x7d\x2e\xce\xa1\x10\x58\x5c\xfd\xdc\xdd\xf8\xfa\x72\x33\x02\x4f\x5a\xf6\xa6\x05\x31\x1e\x9b\xb2\x8e\xf6\xa4\xdf\x2b\x13\x7f\xa5\xcb\x15\x02\xc9\xea\x57\x70\x19\xe8\xad\x81\xfe\x93\x33\x2a\xab\x08\xaa\x84\x2b\xa4\x36\x7f\x04\x59\x5d\x56\x4b\x4a\x2b\xaa\x8f\x68\xc3\x8d\x59\xdf\xba\x71\x05\x6b\x51\x80\x9f\xb1\x77\x3a\x75\x37\x22\x0e\x78\x6f\x9c\x60\x61\x6e\xdc\x07\x37\x23\x70\xfc\x64\xa2\x45\x55\x6f\x96\xf4\x0d\x74\xf6\x7d\xe6\xb3\xb1\xfd\x57\x21\xce\xc6\xe6\x5f\x17\xfd\x9f\x00\x00\x00\xff\xff\xfa\x92\xf7\x3b\x6e\x74\x00\x00")
+var _templatesClientHtml = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xdc\x7d\xff\x72\xe3\x36\x93\xe0\xff\x7e\x0a\x84\xe3\xfd\x28\x65\x4c\x52\xf6\xfc\xca\xc8\x92\x6e\x13\x67\x72\x37\x9b\x49\x66\x2a\x76\x6a\x6f\x6b\x6a\x6a\x0a\x22\x21\x09\x31\x09\xf0\x03\x20\xcb\xfe\xbc\xae\xba\xd7\xb8\xd7\xbb\x27\xb9\x6a\x00\x24\x41\x12\x94\xed\xfc\xf8\x92\x5d\x4b\x65\x4b\x44\x77\xa3\xd1\x68\x34\x1a\xdd\x00\x3c\xfb\x22\xe3\xa9\xba\x29\x09\xda\xa8\x22\x5f\x1c\xcc\xe0\x0f\xca\x31\x5b\xcf\x03\xc2\x82\xc5\xc1\xc1\x6c\x43\x70\xb6\x38\x40\x68\x56\x10\x85\x51\xba\xc1\x42\x12\x35\x0f\xb6\x6a\x15\x7d\x15\x34\x05\x0c\x17\x64\x1e\xa4\x52\xac\x22\xc5\x2f\x09\x0b\x50\xca\x99\x22\x4c\xcd\x83\xdb\x5b\x14\x9f\x9d\xff\xf4\xdd\x05\x3c\x47\x77\x77\x3d\xac\x2b\x4a\x76\x25\x17\xca\xc1\xd9\xd1\x4c\x6d\xe6\x19\xb9\xa2\x29\x89\xf4\x97\x23\x44\x19\x55\x14\xe7\x91\x4c\x71\x4e\xe6\xc7\x47\x48\x6e\x04\x65\x97\x91\xe2\xd1\x8a\xaa\x39\xe3\x3d\xc2\x19\x91\xa9\xa0\xa5\xa2\xdc\xe5\xa7\x07\x86\xb7\x6a\xc3\x45\x0f\x22\xa7\xec\x12\x09\x92\xcf\x03\x9a\x02\x81\x8d\x20\xab\x79\x10\xc7\x49\xfd\x5e\xe1\x2b\x28\x8a\x69\x6a\xeb\x56\x54\xe5\x64\xf1\x3d\xbd\xa4\xb3\xc4\x7c\x86\xaa\xbe\x88\x22\xf4\x0d\xe7\x4a\x2a\x81\x4b\x94\x72\x41\xd0\xd9\xf9\x39\x8a\xa2\xa6\x1a\x43\x3b\x91\x0a\x2b\x9a\x26\xcb\x0a\x38\x2e\x28\x8b\x53\x29\x03\xc3\x87\x54\x37\x39\x91\x1b\x42\x54\x50\x13\x3e\xdb\x4a\xc5\x0b\x64\x8a\xd0\x8a\x0b\xa4\x36\x54\x22\x45\x8a\x32\xc7\x8a\xec\xab\x25\xe7\xeb\x3d\xc4\x8d\xe8\x50\x46\x56\x44\x20\x29\xd2\x06\x11\xe7\x79\xfc\x8b\x0c\x16\xb3\xc4\xc0\x0c\x55\xa0\x38\x96\x4a\xf8\xab\x40\x49\x83\xd5\x2b\x6b\x93\x29\x48\x46\xb7\x45\x44\x32\xaa\xb8\x68\x24\x02\x6a\x3b\x0f\x14\xb9\x56\x89\xae\x01\xc0\xf0\x3c\x90\xa9\x20\x5a\xfd\x3c\x9a\xba\x5f\xd4\xba\x9a\x61\x6e\x0f\xda\xfc\xe2\xb2\xcc\x49\xa4\xf8\x36\xdd\x44\xa0\x04\x01\x92\xf4\x1f\x44\xce\x83\x17\xaf\xae\x5f\xbc\xea\xb6\xc1\x40\x03\x5c\xa4\xcb\xe3\x92\xad\x83\xc5\x03\x29\xbe\x9c\x5c\xbf\x9c\xec\xa1\xa8\xcb\x1f\x45\xf1\xd5\xc9\xf5\xab\x93\x3d\x14\x75\xf9\xe3\x28\xbe\xbc\x7e\xf5\x72\x1f\x45\x28\x7f\x14\xc5\xe3\xe3\xe7\xd7\xc7\xc7\xcf\xf7\xd0\xb4\x10\x8f\xa3\x7a\x32\xb9\x3e\x3e\xd9\x27\x4d\x0b\xf1\x38\xaa\xcf\x9f\x5f\x1f\x3f\xdf\xcb\xab\x81\x78\x1c\xd5\x17\x27\xd7\xc7\x2f\xf6\xf5\x93\x85\x78\x1c\xd5\xaf\x26\xd7\xc7\x5f\xed\x95\x80\x81\xf0\x51\x35\x94\xcc\xd0\xa3\x05\x5e\x93\x04\x80\x6a\xd2\xaf\x4f\xae\x8f\x5f\x9f\x04\xa8\x4b\x9b\x65\x82\xd3\xcc\x52\x37\x40\x8f\xa7\xfe\xec\xe4\xfa\x59\x4f\x18\xd6\x04\x47\xba\xf0\xf1\x34\x5f\xbf\xbc\x7e\xfd\x72\x88\xa6\x2e\x7c\x3c\xcd\xe3\x97\xd7\xc7\x83\x34\x75\xa1\x8f\x66\x81\x19\x5d\x11\xd9\xb7\x7e\xf6\x79\xfc\x8b\xe4\xac\x37\x75\x15\x12\xfa\x99\xa6\x18\xe6\xb8\xe8\x82\xe6\xe4\x8c\xe7\xad\xb9\xec\x09\x39\x59\x1e\xa7\xaf\x1e\x80\xf9\x16\xfa\xd3\xc1\xac\x39\x90\x03\x2a\xec\x50\x53\x1b\x52\x90\x28\x1d\xac\xfb\xc0\x99\x51\xf4\x5c\xb2\x51\xaa\x94\xd3\x24\xd9\xb2\xf2\x72\x1d\xa7\xbc\x48\xe4\x8e\x10\x85\x73\x22\xd4\xc9\xbf\xbe\x8a\x9f\xc7\xc7\x49\x46\xa5\x72\x1f\xc7\xfd\x89\xe7\x00\x21\x20\x0c\x96\xda\x7e\x81\x37\x2d\xd6\xf1\x25\xbd\xa4\x51\x49\x53\x74\x5b\x3f\x86\x77\xf2\x65\x89\xb3\x8c\xb2\xf5\x14\x4d\xe2\x63\x52\x9c\x7e\x99\xb4\xca\x0b\x7c\x6d\xfc\x8d\x29\xc2\x5b\xc5\x4f\x7b\x85\x1b\x42\xd7\x1b\x35\x45\xcf\x27\xe5\x75\x53\x7a\xd7\x54\x5e\x60\xca\x3a\x95\xda\x2a\x23\xc5\xcb\x29\x7a\x3d\x84\xf8\xa4\xe4\x52\xc9\x0e\xea\x8a\x33\x15\xc1\xf0\x9a\xa2\xe3\x97\x2e\xa2\x4b\x37\x27\x2b\x35\x45\x27\xcf\x07\x08\xc7\x40\x38\xca\xb0\x22\x8a\x16\x64\x4f\x05\x27\x03\x04\x40\x9e\xa5\xe0\x2b\x9a\x13\x8f\x48\x1d\x91\x9d\xbc\x28\xaf\x87\x45\x76\xf2\x62\x80\xbe\x6d\x79\x9c\x62\x91\x45\x4b\x9e\xdd\xa0\x5b\x5f\x3b\xa1\xcb\x5e\xbd\x10\xa4\xb8\x97\x48\x8f\x43\xb1\xa6\x2c\x5a\x72\xa5\x78\xb1\x9f\x4a\x2c\x48\x4a\x4b\x4a\x98\xf2\xd3\xb0\xa2\xee\x37\x53\xd7\x20\x6c\x43\x07\xda\x69\x3a\x22\xe5\x45\x41\x98\x92\xfe\x46\xda\x1a\x8e\x5f\xee\xa5\x81\x53\x18\xb6\xe8\x76\x0f\x13\x2d\x6d\x80\x77\xba\x15\x92\x8b\x29\x2a\x39\x65\x8a\x88\x4e\x21\x0c\xdd\x29\x7a\x32\x99\xbc\x5a\xae\x56\xa7\x83\x2a\xb2\x5f\xc7\x60\xe4\x63\xca\x88\xe8\x70\x66\xd5\xe3\x78\x32\xf9\x97\x7d\x7d\x87\x8f\x50\xbc\x95\x44\xd8\xe6\x49\x84\x3b\x74\xc0\xdb\x8b\x32\x92\x72\xa1\x0d\xde\x14\x31\xce\x88\x9f\x1f\xa0\x23\xa3\x9c\xca\x7e\x47\x36\x1a\xf9\x6c\xd2\x1a\x8c\xf0\xe6\x57\x44\xac\x72\xbe\x8b\x6e\xba\x16\xa0\x4b\x3d\xaa\x86\x84\x9e\x02\x1e\xd2\x62\x78\x55\x55\xf7\xad\x4b\xf2\xa5\x3b\x92\x34\x67\x1d\xe3\x94\x7c\xe9\x32\xdf\x03\x71\x18\x4c\xbe\x34\x1d\x52\x6b\xf3\x7e\x6d\x6b\x0d\x7c\xb7\xd8\xab\xd0\x5f\x26\xad\x8a\x24\xcd\xc8\x12\x77\xbb\xbc\xe4\x92\x9a\x3e\x5a\xd1\x6b\x92\x3d\x54\xc8\xf0\xfa\x47\x44\x59\x46\xae\xb5\xf4\xda\x25\x1e\x03\x0a\xef\x6a\x60\x7f\xd5\xd3\xf9\x25\x17\x19\x11\x53\x74\x5c\x5e\x23\xc9\x73\x9a\x21\xb1\x5e\xe2\xd1\xe4\x08\x5e\xf1\xf1\xc9\x8b\xb1\x0f\x3e\x12\x38\xa3\x5b\x39\x45\xf1\xc9\xb0\xa1\xb0\xcd\x8e\x76\x02\x97\x25\x19\x6e\xbe\x20\x39\x56\xf4\x8a\x0c\x0b\x30\xe7\x6b\xde\x41\xd7\x33\xe9\x14\xed\x36\x54\x0d\x21\xde\xc3\xc0\xfe\xb9\xc6\x0c\x33\x18\x1d\xb2\x21\x54\xf0\x6c\x9b\x13\xbf\xa2\x4c\xd1\x8b\xf2\x1a\x1d\x4f\xfe\x60\x01\x37\x66\x4c\x57\x78\x2f\xef\x39\x5e\x92\xdc\x6f\x06\x6b\x6b\xdf\x21\x53\x7f\x8e\x15\x5f\xaf\x73\xe2\xb3\x11\xda\xe2\xed\xec\x38\x5b\xf2\x3c\x7b\x84\x25\x55\x02\xb3\xaa\xef\x71\x9e\xa3\xf8\x44\x22\x82\x25\x89\x28\x1b\x36\xab\x1d\x6b\xef\x63\x71\xba\x81\x51\xe3\xd7\x93\x27\xcf\x4f\xbe\x5a\xa6\xf8\x57\x30\xb2\xc7\x84\xd3\x62\xfd\xdb\x8c\x5a\x25\xec\x59\x52\x39\x68\xfa\x35\x4b\x4c\x4c\xe9\x60\x06\x53\x7d\xe5\xc3\x41\x2c\x43\x07\x9b\x88\x58\x18\x08\x22\x16\x36\x7a\x81\xd0\x8c\xe1\x2b\x94\xe6\x58\xca\x79\xb0\xcc\xf9\x3a\x2a\xb0\x54\x00\x84\x18\xbe\x02\xe3\xa3\x8d\x0c\x38\x58\xda\xd1\x85\x5a\x11\x9a\xad\xb8\x28\x2a\x2c\xf8\x1c\x51\x96\x53\x46\x50\xa1\xa2\x13\xf8\x55\x64\xd1\xc4\x81\x87\xf7\x0c\x57\x08\x86\x70\xb4\x14\x98\x65\x1d\x20\x78\xcf\x40\x3c\x16\xb4\xf6\x35\x69\xb1\x8e\x56\xf9\x96\x66\x41\x3b\x62\x02\x00\xb5\xdf\xdc\xbc\x66\x09\xee\x3c\xa0\xac\xdc\xaa\x8a\xac\x66\x19\xa6\x54\xc1\x73\x54\x88\x48\x16\xd1\x49\xb5\xec\x80\x99\x30\x40\x65\x8e\x53\xb2\xe1\x79\x46\xc4\x3c\x38\x27\x58\xa4\x9b\x00\xd1\x6c\x1e\x48\xfd\xf9\x02\x80\x9a\x1a\x66\x09\x50\xb4\x02\x4d\x18\xbe\xd2\xb2\x9f\x69\x87\x55\xf0\x1c\x16\x05\x98\x42\xe8\xc4\x54\x5f\x6b\x82\x6d\x52\xd5\x53\x86\x47\xcd\xc6\x86\x66\x19\x61\xba\xc6\x9c\x28\x45\xc4\x87\xad\x28\xb9\x24\x01\xba\xc2\xf9\x96\xcc\x03\x1b\xe7\xb9\x07\xeb\x27\x52\xe6\x37\x17\xfc\x57\x60\xe1\x94\x48\x0f\x5a\x29\x48\xd5\x8a\x02\x8b\xcb\x8c\xef\x98\x91\x4b\xf5\xed\x2d\xd0\xad\x64\x69\x28\x07\x26\x86\x36\x0f\x32\x2a\xcb\x1c\xdf\x4c\xb5\x6f\x01\x8b\x8d\x52\x10\x4b\x17\x94\x74\x49\xd6\x94\xe9\x68\xd3\xb6\x40\x05\xcf\x70\xde\x68\x29\x94\xff\xd0\x7e\x94\xd1\x5a\x71\x0d\xf0\x0a\x67\xc4\x30\xb3\x13\x54\x51\xb6\xd6\x08\x81\xed\x81\x8c\xe2\x9c\xaf\x03\x84\x05\xc5\x91\xb6\x6e\x39\xc9\x96\x37\x6d\xe0\x77\xf0\xdc\xc2\x18\xe6\xe7\x81\x12\x5b\x12\xa0\x0c\x2b\x1c\x2d\x71\x7a\x99\x09\x5e\x42\xc0\x10\xd6\x90\xf6\xf1\x25\xb9\x59\x72\x2c\xb2\x79\xb0\xc2\xb9\x24\xb5\x62\xf4\x58\x8c\x0c\x13\xa6\x71\x51\xbe\xae\x79\xe3\xe9\x16\x3c\x57\x57\xa5\x7a\xb8\x76\xf1\x17\xa0\x5e\x13\xbf\xe1\xd9\x8d\x83\xea\x43\x86\x11\x4d\x44\x0b\x08\xa1\xd9\xe6\x45\x1b\x4a\x47\x56\xfb\x22\x34\x52\x01\xe3\xf1\xa2\x43\x60\xb9\x55\x8a\x33\xdb\xdb\xe6\x4b\xa3\xe5\xb9\xd6\x57\x2d\xa1\x8c\xca\x82\xd6\xf5\xb8\x9d\x30\x0f\xce\x34\x5c\x9b\x70\xf7\x67\x26\x4b\xcc\x3c\xdd\xb2\xf8\x1b\x2c\xbc\xe4\xe9\x2c\x01\x80\x7b\x68\x24\x86\x41\x17\x6a\x96\x64\xf4\x6a\xbf\xe4\x96\x5d\xe1\xb6\x81\x20\x78\x8a\x97\x79\xbf\x01\xb3\x72\xf1\xc3\x0d\x5a\x61\xb5\x21\xe2\xff\xfd\x9f\xff\x2b\xd1\x0a\x17\x34\xbf\xd1\xf1\x7c\xb4\x24\x94\xad\x3b\x08\xda\x44\x9a\xf0\x4e\xb5\x86\x27\x2c\xde\xd1\x4b\x5a\x42\x00\x36\xe6\x62\x9d\xc0\xb7\xe4\x03\x2d\x3f\x8f\xfe\xa7\x20\x58\x7d\x7e\x73\x5d\x92\x14\x2c\x21\x67\x72\x1c\x2c\x3e\x50\x21\x68\x09\xc6\xef\x08\x61\x96\xa1\xe2\x06\x9d\x6d\x04\x95\x8a\x62\x66\x2a\xfe\xb0\xa1\x39\x2d\x8f\xa0\x84\xb2\x15\x66\x0a\x29\xce\xd6\x5b\x82\x52\xbe\xcd\x33\x54\xe0\x4b\x82\xf8\x0a\x2d\xb9\xda\x68\x04\x89\x18\x57\x1b\xca\xd6\x28\xe7\x6c\x4d\x04\xe2\x02\x15\x10\x67\x27\xd7\x10\x1d\xa1\x0a\xa9\x0d\x66\xe8\x03\x2d\x63\x74\xce\x8f\xd0\x5b\x94\xe2\x3c\x27\x50\xb5\x24\xf9\x0a\x0a\x0c\x2b\x29\xd4\xae\x38\x5a\x12\x0b\xd1\x6b\x3d\xd0\xe8\x3c\x9c\x25\x65\x47\xf2\xbd\xfe\xba\xbf\x03\x57\x9c\xab\xbe\xea\xef\xd3\xdc\xa5\x62\x68\xa9\x58\x24\x49\xca\x59\x86\xc5\x8d\x5f\x8b\x17\x5a\x73\x7d\x6a\xd5\x66\x03\x48\xad\x05\xdf\x96\xa8\xfe\x14\x19\x0f\xc9\xd2\x35\x5f\x2a\x36\x64\x87\x55\x78\xcf\xb4\xc1\xea\xf2\x47\xd9\x8a\x7b\x80\xbb\xf6\x3d\x00\x9f\x90\xd7\xad\x13\x04\x67\x51\x49\x04\x34\x45\xe7\x6f\xa0\x9f\xe7\x01\xd7\xd9\x9c\xc6\xe6\x43\xf7\x05\x7a\xa1\x96\xf2\xa2\xcc\x89\x22\xf3\x80\xaf\x56\xc1\xe2\x9c\xe4\xab\x3e\x83\x89\xe6\xf0\x2f\xc1\xf9\x4a\x50\xc2\x32\xe9\x65\x1e\x7d\x67\x0a\x7f\x8f\x06\x20\x58\x4c\x5f\x91\x3f\xac\x1d\xe5\x76\x99\xd3\xd4\xd7\x0c\x94\x6e\x48\x7a\x49\xb2\x05\xfa\xa0\x61\x1e\xd6\x9a\x9e\xb1\x7b\xe0\x30\x28\x05\x2d\xf4\x20\x80\x79\x41\x6e\x97\x05\x55\x91\x9d\x1e\x82\xc5\xb9\xfe\xfe\x00\xdb\xda\xfa\xea\x7c\x71\x3f\xc2\x2c\x4f\x58\xf6\xbb\xf8\x00\x20\xd3\x87\x39\x00\x35\xe4\xe0\xec\xbf\x38\xe8\x8f\x6a\xcf\x84\x6e\xbe\x44\x29\x81\xb5\x0b\xc9\x7e\xd5\xec\xde\xc0\xfc\x2e\xd3\x78\xa7\x6d\x0b\xed\x5c\x43\x3f\x6a\xc8\x1f\x71\x51\x05\xa7\xb5\x57\x5d\x77\x3d\xce\xe9\x9a\x45\x05\xcd\xb2\x9c\x20\x27\x40\x19\x2c\xfe\xc6\x96\xb2\x6c\xaf\x51\x7c\x3f\x66\xc2\x6e\x55\x04\x95\x05\x8b\x1f\xb0\xb8\xb4\xb3\x35\x9a\x2d\xc5\x62\x26\x0b\x58\x42\xd9\x7a\xc1\xf9\x8e\x8a\xad\x22\x59\xed\x34\x3a\x8b\xb9\x57\x93\x7f\x39\x0d\xda\x44\x8d\xfe\x7f\x4f\x6e\x82\x05\xc6\x32\xcb\x2f\x57\xbf\x60\xf9\xfa\x97\xd5\xeb\x67\xbf\xac\xf0\x2c\xd1\xc4\xff\x4c\xc7\xe5\xf7\x72\x5e\x7e\x67\x07\xa6\xdf\x3b\x67\x95\xfe\xf5\xb9\xf9\x43\xe7\x58\x30\xa4\xc1\xe2\x3b\x9a\x2b\x22\x7c\x0d\xfc\x35\x36\x6a\xc5\xf3\x9c\xef\xbe\xd1\x68\xc1\xe2\x3b\xfd\xed\x37\xd0\xce\x30\x78\x3e\xc1\xe2\x9b\x9c\xa7\x97\xbf\x81\xce\xaf\x76\x27\x3a\x1d\xd0\xfa\xea\x7c\x71\x3f\x3a\x3d\x24\xf8\xae\xee\x18\xf7\x79\xca\x73\x58\xf8\xbe\x46\x76\xd9\x4f\x21\x09\x66\xe1\x2a\x50\x90\x26\x04\x2f\x24\xe8\x85\xa6\x5e\x03\x68\x73\x9c\xc4\x1a\x19\x40\x6a\xa3\xec\x8d\x2a\x94\x78\x4d\x99\xf6\x53\x5b\x4a\x32\xc3\x5d\x21\xf1\xad\x82\x48\x42\xd3\xa1\xc6\x23\x7e\x12\x2c\xde\xc3\x62\xbc\xb3\xb0\x1f\xc6\xaf\x85\x8d\x32\x2a\xc1\x41\xcf\x1c\x52\x3f\x92\x5d\x87\x94\x5d\xb5\x23\xd4\x11\x64\xbb\x9d\x7a\x41\x0f\xed\x3c\x38\xd0\x8f\xa1\x99\x0b\xe4\xb4\x7c\x86\x21\xe4\xd7\x91\xf0\x33\x54\xe8\xbf\x30\x8f\x1b\x61\xdb\xb8\x20\xea\x06\x1a\xdd\x58\xcb\x81\xdb\x57\x16\xd0\xca\x4e\xd7\xfd\xb3\x24\xa2\x32\xcd\x0e\x07\x08\x79\xf0\xaa\x00\x64\xfb\x6b\x44\x99\xb4\x7b\x52\x9a\xd7\xcc\x69\x7a\xf3\x53\x4f\x1b\xfd\xf0\x7c\xad\xe6\xbe\x22\x3d\xab\xe8\x20\x4d\xd2\xad\xc7\x95\xf1\xde\xaa\x37\x2f\x9a\x9a\x61\x3a\xf3\x2e\x44\x1f\x4c\xcd\xcc\x08\xbd\xe7\xf0\x36\x73\x09\xba\x24\x37\x53\x6f\xf9\x2c\xe5\x19\x71\xa4\xa0\xc1\xa3\x4b\x98\x7b\x66\x09\x94\x79\xaa\xb3\x33\xd0\x43\x9a\xee\x50\x36\x42\xac\xc7\x5c\x17\xb2\xfd\xc4\xb5\x3f\x9d\xc1\x20\x0b\xfd\x47\xe7\xc2\x9d\xf4\x0f\x6c\x36\x63\x6b\x62\xa4\x89\xb4\xb7\x30\x0f\xce\xf4\x33\x0d\xa6\x9f\xf7\x2b\x81\xd7\x8c\x56\x75\xac\x30\x2c\x69\xab\x9c\x5b\x84\x73\x05\xfc\x52\x0f\x6f\x5e\x8b\xe9\xe1\x1a\x96\xd2\x55\xdb\xd1\x3d\x4d\xa8\xd9\xfe\xb9\x84\xf4\x6b\x35\x10\x3a\x4a\x36\xc8\x35\xb4\x32\x4a\xa9\x48\x73\xf2\x7b\x70\xad\xdd\x91\x47\xb0\x9c\x73\x9c\xd5\x63\xd7\x0e\x15\xb3\x12\x6c\x35\xca\x2d\x29\x4d\xfc\x6f\x1e\x18\x62\x11\x96\x92\xae\x59\xe2\x82\xc0\xe2\xdd\xba\x91\xf3\xe0\x5b\x81\xd7\x7a\xe1\x0d\xf1\x2a\x04\xb3\x3d\x40\xc6\x71\xfc\x60\x11\x69\x2e\x1f\x25\x9d\x8e\xba\xda\xaf\xb5\xc9\x8a\xc1\xb5\xef\x99\xad\x8e\x45\xab\x12\x94\x0f\xb4\x68\xba\x23\xc1\x87\x70\x25\xdd\x5d\x41\x03\xfe\xc2\xac\xe3\x16\x5f\x1b\xfa\xd5\x02\xa9\xc3\x32\xbc\x67\xbc\x76\x47\x21\x3b\x10\x6d\x99\xf6\x44\xbd\xa1\xea\xdc\x23\x1b\x3b\x2f\xd9\xc9\xe6\x8b\xa0\xa7\x28\xed\xbe\xfe\x77\x41\x15\x41\x18\xf2\x5a\xaa\xdb\xd7\x72\x83\x05\x89\x54\x3b\xd8\x7c\x6f\xcf\x95\x84\xa5\x34\x6f\x46\x24\x3a\x07\x32\x7e\x3e\xdb\xd3\x69\xf5\x10\x9a\xf5\xdb\x1a\x8b\xb3\x4c\x12\x71\xd5\xf3\x09\xf7\x32\x5e\x61\x68\xa6\xbf\xce\x32\x64\x1e\x3c\x96\xf3\xea\xdb\xaf\x62\x5c\xde\xb0\x74\xeb\xe6\x3a\xee\xe7\xfa\x86\xa5\xae\xb0\x6f\x58\xfa\x9b\x39\x86\xe1\xf0\x28\xb6\x89\xc0\x92\xd8\x61\xf5\x18\xe6\x95\xc0\x72\xe3\x70\xff\x06\xe8\x54\xe3\xf3\x91\xcd\x68\x8d\x59\x78\xcf\x12\x9e\xf7\xcc\x41\xdf\x1a\xb8\x43\xde\x3b\xe6\x9b\xb4\x64\xa7\x69\x7b\x0c\x83\x59\x03\xd0\x5e\x66\xa8\x8b\xe6\xe4\x01\x3d\x90\x5d\x99\x81\xc8\x52\x2c\x88\x32\xf9\xfb\x01\xeb\xd8\x74\xa1\x17\x15\xd2\x24\xde\x9c\x88\xed\x83\x28\xf2\x93\xfc\xae\x6a\x12\x1a\xe9\x25\xe5\x62\x62\xd7\x6a\xe3\x1e\xb8\xc7\xac\x75\x1b\xde\xb2\x6d\xa8\xd9\xd4\xe1\xe5\xec\xd4\xef\x8b\x78\x1e\xdd\xd7\x23\x44\xc8\x60\x3f\x63\x7f\x54\x8f\x58\xf9\x11\x21\xef\x97\x9f\xaf\x69\x7f\xbe\x00\x6d\x5c\x73\x3f\x5b\x7f\x9c\xf8\x4c\xf5\xff\x45\x85\x97\x72\xc6\x88\xd7\x3b\xf8\xa7\x09\xf0\xac\x61\xe1\xbf\xa8\x10\x97\x10\x01\x21\x59\xb0\x9f\xad\x3f\x4c\x80\x3a\x00\x43\xb2\xbf\xaa\xf0\xec\xd7\x83\xd6\xe3\x59\xa2\x43\x02\xbe\x68\x82\x15\x6e\x3d\x6d\x3a\x78\x16\x4c\xf0\x9d\x2d\x9d\x25\x10\x7b\xa8\xcf\xa6\x24\x71\xb3\x9b\xc4\x02\x00\xc6\xcc\xe4\xba\xaa\x26\xea\x58\x43\x2b\x34\x37\x2b\x21\x8a\xb5\x6e\x0e\xb1\x2c\xb7\x34\x57\xfa\x70\x4b\x2f\xf9\xb8\x26\xaa\x39\xc3\x91\xf2\x22\x09\x16\xf5\x59\x1b\x88\x9d\xa0\xe5\x4d\x1f\x49\xed\x28\xec\x56\xd0\xfb\x8e\x8b\x8c\x07\x8b\x7f\x2d\x32\x0e\xd0\x71\x9d\xcb\x9b\xd5\x39\xbd\x1a\xfb\x49\xb0\xf8\x06\xa7\x97\x48\x71\xa4\x78\x59\x7b\x18\x16\x05\x76\x57\x70\xd5\x6c\x5a\xf1\x1d\xfb\xf9\x37\x7c\x85\xcf\xf5\x2e\x68\x4d\x7b\xfe\xe8\x1f\x97\xf6\x07\xd8\xfc\x91\x21\x0c\xc9\x4d\x82\x60\xd5\xc2\x57\xfa\x63\x15\xc9\x47\x92\xeb\xef\x25\x5e\x13\x89\x60\x9d\x84\x56\x58\xaa\xa6\x33\xdc\x0d\xd9\xd5\x56\x95\x5f\xfe\xbe\x25\xe2\x26\x7a\x16\x9f\xc4\xc7\xfa\xb0\x4d\xef\xa4\x4f\xf3\x11\xa1\x24\x41\x82\xfc\x7d\x4b\x60\x1f\x19\x88\x65\x43\xac\x37\x0c\x09\x57\x65\x57\xf0\x48\xf2\x82\x98\xf4\x2c\x23\x24\xd3\x50\x70\x20\x0c\x29\x7d\x22\xcc\x72\x0d\x5c\x6a\xa9\x1c\xc6\xf8\x17\x7c\x7d\x4e\xd4\xb6\x1c\xdd\x9a\x54\x82\x9c\xa2\xdb\xe0\x7f\x47\x80\x14\xe9\x63\x64\xc1\x14\x1d\x8e\xc2\x82\x28\xfc\xb1\x77\xf2\xec\x53\x38\x8e\xb1\x52\x62\x14\x54\xd9\x8a\xf1\xdd\x9d\xde\x86\xe6\x69\x46\xbb\xf1\x25\x87\x9d\x74\xfb\x9a\xdd\x86\x77\x4e\x0f\x3d\x14\xa5\x7f\x9a\xe9\x81\x68\x51\xb5\x77\x25\x96\x0a\xb3\x0c\xe7\x9c\x91\x87\xe1\xda\x73\x58\xfd\xba\x86\x10\xbe\x2e\xe9\xc3\x28\x7f\x80\xa0\xeb\xc3\x40\x61\xd9\x3c\x08\xba\x38\x38\xb8\xc2\x02\x7d\x4f\xbf\xa7\x67\xb9\xde\x7c\x3d\x47\xab\x2d\xd3\xb3\xd0\x08\x97\xf4\x48\x7b\x7e\x47\x3a\x27\x0f\xbb\x5b\xc6\x66\x5f\x1a\x9c\x74\x8b\x71\x49\xd1\x1c\xe1\x92\x9e\x36\xcf\x00\x1a\xcd\x35\x92\xf3\x14\xd6\xae\x12\xcd\xd1\xc7\x4f\xee\x43\x13\xc1\x45\x73\x74\x7b\xe7\x3c\x3e\x24\x39\x9a\xa3\xc3\x51\x60\xf6\xa6\x07\x76\x1b\xa3\x2e\x83\x83\x88\xa3\x9a\x95\xd3\x83\xbb\x83\x83\x86\xf3\xb8\x14\x5c\x71\x88\xb0\xc7\x29\xcf\x73\x92\x2a\xdd\xf2\xef\x04\x2f\x40\x5a\x6e\xc3\x80\xf2\x18\xdd\x9a\x6d\x55\xd0\xfe\xcf\x9c\x99\x98\xca\x99\x25\xee\x42\x13\x21\x8c\x18\xc6\xce\xae\x3c\xba\x42\xa3\xee\x33\xf7\x39\xfa\xdb\xdf\x50\x10\xa0\x2f\x8c\x2c\xe2\x35\x51\x3a\x91\x36\x1a\x77\x11\xe0\x75\x38\x0a\x3b\x3b\xfd\x3f\xf2\x1d\x23\xe2\x33\x84\xfc\xc2\xa7\x15\x85\x3a\x99\x35\x1a\x3f\x0d\x9b\xd1\x16\x4a\x91\x86\x47\x28\x4c\x68\xb1\x4e\xc2\xa7\xdd\xfa\xda\xc9\xb8\xbb\x83\x4e\xc5\xb0\x42\x68\xf6\xde\x3f\xb0\x5e\x08\x39\x8c\x5a\x94\xe0\x5d\xc1\x7f\x6b\x66\x46\x48\x1b\x8d\xda\x93\xaf\xc3\xcc\xdd\x81\xb3\x0b\x52\xf7\x41\x9a\x2f\x07\xe4\x7e\x7b\xe0\x13\xef\x04\xcd\xad\x74\x2b\x95\x90\x71\x4e\xd8\x5a\x6d\xba\x32\xd6\x40\x82\xac\x29\xd8\xe0\x76\x47\x8f\x7a\x5d\xdf\xe3\x11\xde\x3d\x28\x87\xbb\x53\xb7\x25\xb8\x2c\x63\xad\x77\xf1\x8a\xa8\x74\x03\x1f\xb5\xba\x81\x5c\xde\x83\x6c\xdf\x66\xa3\xf1\x91\x69\xac\xad\x09\x1a\xef\x6c\x17\x9f\xa3\x0a\xfe\xa7\xfa\xe1\xc8\x82\x42\xf3\x7d\xa5\x6e\x83\x61\xb2\x1e\x01\x4d\x3a\x9f\x9c\x22\x3a\x6b\x48\x5b\xe9\x9c\x22\xfa\xf4\x69\x57\x44\x3e\xbe\x1b\xcc\x8f\xf4\x53\x9b\x67\xb7\x07\x87\x46\x21\xf8\x0e\x19\x0c\xbd\x9f\xa9\x6f\xf0\x55\x4d\x87\x8d\x24\x68\xae\x07\xb8\xa1\x2d\x88\xda\x0a\x06\x26\x00\xfc\xb1\x45\x30\x8e\x71\x96\x9d\x81\xcb\x32\x0a\xe0\xa0\x0a\x3c\x28\x4b\xc2\xb2\x46\x03\x07\x61\x4d\x76\xb3\x8f\x60\x77\x8a\xab\xf8\x90\xe4\xf5\x43\xa3\xab\xe3\xd3\xfb\x5b\x24\xdb\x4d\xaa\x4d\x12\xba\x6d\x19\xb2\x18\x4e\x75\x8f\xc2\xd0\x0a\xad\xdb\x33\x8d\x6d\x1c\xea\x19\x90\x0f\xb0\x69\xe5\x63\x80\x3f\x52\x6b\x46\x5b\x55\xf9\x5a\xa8\x0b\x9d\x6e\x30\xb2\xaf\x41\x9c\xbe\xd4\x90\x3e\xb3\x69\x50\x4e\xef\xe9\x6a\x30\xcc\x5e\x81\xec\xe9\xe6\xc3\x51\x18\x37\xe1\xd9\xb8\x0e\x7f\x86\xe3\x98\xb3\x51\x98\xe6\x34\xbd\x0c\x8f\xb4\x62\xf2\x92\x30\xbd\x4b\xc1\x32\x02\xb3\x43\x2b\x89\x3b\x8e\x35\xf8\xa8\x66\xc0\x15\x22\xd4\x0c\x93\x55\x6c\x50\x40\xcb\x47\x87\x23\x68\xf1\x38\x86\x78\xea\xc8\xee\xa4\x81\x4c\x4d\x65\x2e\xef\xec\x5f\xa8\x1d\xc4\x60\x87\x85\xfe\xd8\xd4\xa2\x6d\x00\x08\x48\x76\x4c\x14\x11\xbd\x89\x41\x6d\xc0\x5b\x27\x42\xf8\xcc\x8b\x66\xb1\x9a\x25\xf5\xdf\x06\x4a\x97\xb9\x9a\x37\xea\xf0\x58\x09\x1b\x6c\x62\xf5\x19\x60\xee\x0e\x06\x7a\x6b\xab\x2d\x99\xdb\x5f\x63\xb4\xa7\xa3\xfe\xea\x32\x80\x76\x6a\x2f\x06\x7a\x76\xc0\x8b\xa9\xe4\x52\x71\x04\xbd\xaf\x3b\xbf\xeb\x7e\xf8\x9c\x1a\x9c\x65\xef\x60\xea\x60\x44\xc8\xd1\x3d\xfe\x48\xc3\xc3\x43\x86\xc7\x3e\xb1\x57\xec\x38\x06\x99\x6d\xf3\xfc\xa8\x21\x03\xa2\x17\xc4\x2b\x77\x8f\xa4\xa1\x5f\xab\x26\x0b\x22\xf5\xc7\xb8\x71\xd4\x6a\x10\xeb\xc2\x31\xb2\x43\x76\x16\x70\x60\x8f\xea\xd1\x34\xf6\xa0\x0d\xcd\xb1\x35\xc7\x0e\xab\x35\x22\xd8\x8e\x4a\xaa\xae\x5a\xd7\x00\xba\xdf\x81\x95\xa6\xdb\x07\xd5\x1e\x10\xda\xaa\xe1\xed\x10\xd7\xa9\x71\x3b\xa6\xea\x10\x3b\x05\xd5\x2e\x6d\xc7\x0b\x1a\x26\xec\xaa\xca\x83\x07\x58\x6d\x09\x4d\xa4\x3b\x76\xd2\xaf\x1d\x63\xe8\x13\xa4\xdc\xe1\x7c\xd4\x96\xab\x4e\x24\x4e\x51\xf8\xb3\x4d\xd6\x86\x47\xed\x62\x72\xad\xa6\x28\xfc\x77\xbd\xa5\xf7\x86\x6f\x51\x4e\x2f\xf5\xe6\x5b\xbb\x6a\xbc\xe1\x5b\x51\x27\x7a\xff\x47\x17\xf9\xa6\x24\x53\x14\xee\xb0\x60\x94\xad\x3b\x85\x7a\x6f\xe6\x14\x85\xe0\x1f\x76\x8a\xe4\x86\xef\xce\x30\x4b\x49\x6e\x6c\xf6\x14\xc1\xd6\xb9\x36\x4c\xca\xd9\x8a\x8a\xc2\x00\x5c\x18\x2e\xff\x83\xc8\x0e\xa5\xd4\xa1\x62\x81\x7e\xe4\x61\x0d\x72\x37\x8e\xd5\x86\xb0\xc6\x42\x09\x22\xb7\x79\xed\x72\x54\x2f\x18\x2a\xa6\x24\xd6\xfb\x42\xbb\xe5\xb5\xfa\xc1\xcc\x61\x04\xa3\x1d\x5a\x17\xa7\x33\x16\x11\x0c\x46\x0f\x99\xd6\xc8\xf4\x17\xd7\x1d\x19\xbe\x11\x82\x8b\x2f\xc0\x9f\xff\x9a\x21\x02\x5f\x10\x4f\xd3\xad\x10\x24\x9b\xa2\x10\x3d\x85\x67\x71\x41\xa4\xc4\x6b\x72\x84\x42\x0d\x51\x39\x18\xbe\x1f\xa3\xca\xfe\x72\x6b\xf8\xba\x2f\xc3\xc8\xf9\x36\x4d\x89\x94\x9a\x95\xff\x70\x35\x02\x6d\xb0\x44\x4b\x42\x98\x8d\x32\x64\x31\x80\x48\x03\x3e\xc4\x4a\x63\x23\xcc\xec\x33\xf2\xc0\xb9\x23\xbf\x3d\x37\x54\x25\xf0\xd7\x3b\x64\xdc\xbd\x01\x83\x63\xc6\xe9\x9a\x96\x57\x31\x0a\xdf\x64\x54\x55\x59\xad\xf0\xa8\xd3\x85\x36\xdd\x3a\x45\x9d\xdc\xba\x85\x0f\x7a\x3a\x0c\x1b\xe5\xa6\x8d\xbd\xad\xd6\x75\xfb\x5a\x13\xb8\x07\xce\x02\xdd\x80\x40\xbb\x34\x81\xdb\x80\x86\x2f\xaf\x1d\x81\x77\xe5\xd8\x48\xba\xcc\x29\x5b\xcb\xd1\x38\x96\x39\xcd\xc8\x85\x26\x3f\x3a\x79\x31\xf1\x13\xac\xc7\x44\x45\x80\xca\x51\x30\xbd\xa2\x40\x87\x04\xde\x55\xab\x9e\xb6\xe0\x82\x02\xbd\x5e\x07\x6e\xc6\xf1\x8a\xb2\x6c\x14\xc8\xab\x75\xb5\x6e\x6f\xfd\x1c\x8e\x6e\x33\x02\x17\x00\xdc\x8d\x63\xcc\x68\x81\x15\xb1\x4f\x5e\x4f\xee\x8e\xd0\x6d\xb6\xad\x8e\x17\x9f\x4c\x26\x47\x48\x2a\x52\x4e\x6b\x66\x19\xdf\x8d\x6f\xa1\xba\x38\x95\x72\x74\xab\x4f\xfb\xad\xb8\x28\xa6\x28\x14\x5c\x01\xa9\xf0\x29\xe3\xbb\xa7\x61\x46\xd6\xe3\xf0\x6e\x7c\x77\xd7\x5e\x7b\xa2\x3b\x44\x72\x49\x7e\xd7\x86\xbc\xee\xb7\xe4\x9f\xd1\x90\xfd\x9a\x64\x53\xd4\xf7\x2b\x51\x92\x20\x72\x65\xe6\x2f\xfd\xf7\x5b\xb2\xc2\xdb\xbc\x35\x19\x57\x0b\x10\x08\x21\xce\xd1\xa1\x5e\x80\x8c\x50\x90\x40\x1d\xc1\xd1\xbf\x9d\xbf\xff\x31\x96\x4a\x50\xb6\xa6\xab\x9b\xd1\x2d\x0a\x70\x96\x09\x22\x65\x30\x85\xe0\xc7\xdd\xb8\xb5\xe2\xb7\x74\xe2\x8c\x33\xd2\x58\x67\xbd\xa5\x01\x75\xf5\xcb\x46\xce\xac\x51\x19\x01\x4c\x63\xf4\x02\xed\x5e\x00\x4b\xdf\xd3\x4b\xea\x76\x50\x4b\x1e\xb5\x40\x9a\xcd\x06\x0f\x92\x09\xba\x57\x2a\x7b\xa6\xdc\x73\x5d\x91\x93\x4e\xf2\xcf\xbd\x6f\x60\x0b\x38\xc2\x75\xcc\x96\x57\x18\x48\xf1\xe9\x63\x66\xdc\x24\xa9\x27\xdd\xad\xc8\x1f\x3e\x1d\x6b\xa4\x0f\xcd\x29\xc6\x29\x0a\xab\x18\x3d\x6c\xc7\x8b\x19\x51\x3b\x2e\x2e\xff\x1b\x4c\xe2\xfb\x55\x18\xed\xd3\x61\x97\x34\xba\x1b\xbb\xca\x5c\xfd\x3c\x46\xa9\x7f\xa3\x72\x77\x95\xfc\x1e\x73\x30\xe4\xa2\xd6\xee\x34\xac\x69\xde\x2a\x52\xb8\x7e\xaa\x1b\xd2\xac\xe3\x30\x21\x6c\x35\x09\xfd\x41\x94\xc3\x51\x38\xc3\x8b\xde\x9c\x09\xef\x10\x52\x3f\xe1\x14\xc1\xbe\x9f\x76\xe7\xc3\x2b\x84\x31\x6d\x7c\xdc\xa9\x3f\x72\x38\x84\x53\xaf\xd6\x1d\x44\xc7\x39\x1f\x42\xb3\x13\xb3\x8b\x64\xe6\xe5\x4e\x8c\xf2\xce\x89\x21\x85\xfa\x08\x0f\x56\x04\xf8\xd4\xd1\x09\xd4\xd4\xee\x17\x48\x25\x14\x08\x47\x85\x4e\x38\x2a\x7c\x08\xa2\xb5\x58\xb0\x9d\x76\x11\x1c\xf5\x45\x5a\xfd\x48\x91\x4e\xd1\xc8\x67\xbf\x7c\xaf\x2a\x68\xea\x44\x85\x1f\x15\x9e\xee\xbe\xac\x62\x04\x3a\xe0\x1c\xa0\xa7\x5d\x1a\xa7\x07\xfb\xd0\xef\x0e\xbc\x8f\xdb\xb4\x61\xa5\x3b\x4c\xe6\x6e\xdc\xe9\x33\x7f\xdf\x0d\x1c\x52\x09\x3d\x9a\x55\xf5\x9a\xd9\x8e\xdb\xf4\x90\x57\x31\xfb\x75\xb7\x9f\xb8\x71\x44\xef\x10\x4c\x73\x82\x05\x94\xb4\xc3\x88\x95\xec\x21\xbc\xd5\xda\xe8\x3b\x36\x81\xc4\xa0\xb2\x06\x30\xcb\xd7\x1b\x98\xdc\x1b\x57\xf6\x40\xc2\xbe\x96\x7b\x21\xed\x16\x8e\xfb\xe0\x6c\x9e\x7f\x90\xde\xbd\xa6\xa7\xd3\xee\x3d\x6b\x63\xd0\xdd\x3a\x58\x53\xe7\x6c\xea\xf8\x88\x23\xc9\x51\xe5\x03\xb5\x45\xd8\xec\xc2\xb6\x69\x8b\x9a\x5a\x6c\x46\xe4\xe7\x4b\x72\xe3\x45\x85\x31\x5f\x21\x35\x01\xa3\x18\x1e\xa3\xff\xfc\x4f\xe4\xa3\xe3\xce\x10\x9e\x5e\xb4\x4a\xe5\xa2\xda\x9d\xae\x0e\x5e\xab\xc5\xb1\xde\xc0\xdb\x8c\xd6\x4e\x41\x77\xb4\x76\xeb\xb4\xfb\xec\x6d\xa2\x28\x90\x22\x0d\x8e\xaa\x71\xfb\xb4\x47\xec\xf4\x60\xaf\xab\xfc\x40\xe2\xe0\x3e\xb4\xa9\xb7\xe4\x5c\x51\xb3\xf9\x92\xb6\x92\x82\x3a\x43\x42\xaa\xdf\x57\x75\xb9\x0d\x95\x8f\x4f\x3d\xe8\xa0\xe3\xfb\xd0\x89\xa8\x33\x44\x1d\x74\xab\xf8\x03\xc8\xa6\xd4\x8f\x5a\x8d\x05\x3f\xaa\x2d\x6d\x50\x07\xd3\x34\xc3\x4d\xf5\xe6\x6b\x00\x13\x3a\xe3\x33\xcd\xec\x78\xe9\x20\xb7\xb2\x04\x43\x19\x1e\x4b\xe1\x68\x7f\xd2\xad\x17\xc3\xf0\x44\x17\xfb\x9d\xd1\x37\x4d\x56\xfd\x87\xe3\x03\x3d\xd7\xc4\xa4\x58\xef\x31\xb7\xae\x3f\x74\xf7\x60\xf9\x12\x31\x98\x75\xb9\x5f\xbe\x44\xc8\x3f\x5d\xbe\x7d\x83\xfe\x4f\x91\xef\x43\x04\xdc\x1a\x2f\x8f\x13\xaf\x41\xfd\x33\x85\xeb\x9d\x03\xff\x2a\xa2\x6d\xdb\x93\x47\x89\xd6\xa2\xfe\x89\xa2\xb5\x1c\xfc\x69\xa2\xd5\xf9\x4b\x9d\x29\xc2\x65\xa9\x13\x3f\x07\xad\x38\xa0\xeb\x93\xe8\x10\xfa\x11\xaa\xbc\x0e\xdb\x84\x27\xee\xb5\x27\xc1\x38\xd6\x4b\x82\xca\xe1\x85\xe1\x1b\x9a\x68\x48\x08\x5b\x13\xc0\xd5\xe3\x2b\x43\xa9\xe5\x57\x77\x09\x99\x73\xe5\xd5\xdc\xa1\xc1\x1b\xde\x61\xc0\xd7\x97\x88\x58\x27\xab\x2e\x84\x37\xb0\x18\xdb\xc5\x0d\xf8\x25\xf6\xc4\x88\x0e\xe2\xc3\xea\x14\xce\xf4\xd4\x08\x8e\x50\x80\x8b\xf6\x2d\x40\x63\x58\xe9\x9a\xe5\xa8\x80\x8b\x81\x3e\x2b\xae\x09\x06\x83\x58\xfa\x16\x20\x07\x6d\x45\x85\x54\x60\x32\x87\xd1\xaa\x8b\x8a\x1c\x2c\x1b\x60\xb5\xe0\x3d\xdf\x23\xe5\x4c\xf2\x9c\xc4\x39\x5f\x8f\x82\x8b\xf7\xdf\xbe\x47\x91\x3d\xfa\xaf\xf7\x31\x06\xe3\x87\x09\xb6\x0a\x6c\x42\x95\xa3\x50\xf7\x4a\x38\x1e\x16\xf4\x8a\xa7\x5b\x27\xcd\xe9\xf6\x5f\x2b\xce\x4a\x60\xa7\x91\xbb\xe6\x71\x15\xcf\x0d\xa8\xea\x7a\xe1\x00\x9f\xed\xab\x7e\x50\xd5\xd7\xd5\xd0\x24\x58\x62\xed\xa1\x62\x00\xc7\xe3\x7b\xbd\x37\x97\xb8\x16\x89\x4f\x55\xdc\x96\xec\x69\x87\x73\x98\xec\xa1\x0d\x19\x46\x7f\x58\x95\x5a\x27\x15\xf7\x56\xe7\xd5\xe4\x21\xf4\xd3\x7b\x25\xe5\x25\x17\x04\x8f\x60\xd4\x8c\x8c\xfd\x9c\x3a\xa3\x67\x90\xc0\xa3\x78\x75\x08\x0e\x30\x3b\x34\x0e\xdb\xf5\xdb\x01\x59\x57\x0f\x86\x13\x2c\xe5\xd7\x25\xb5\x99\x61\xd8\x57\xf0\x75\xa9\xf3\xb1\xf5\xfc\xe1\x24\x8d\xe5\x99\xd9\x4a\x02\x96\xd4\x22\x5a\xc8\x0f\x36\xc1\x0f\x44\xf4\xe7\x41\xc8\x9f\xdb\x79\x68\xb3\x88\xae\x60\x9c\x99\xc9\x99\x94\xa0\xf0\x67\x97\xc5\x2e\x92\xdd\xaf\x05\x43\x14\x36\x0d\x1c\x38\xd1\x38\x7b\xbb\x0a\x4c\x0b\xb5\xb4\xcc\x1d\x55\x26\x92\x19\x74\x63\x9d\x41\x46\x96\xdb\x75\x30\x45\xfa\x52\x2f\xe7\x39\x23\x3b\x22\xd5\x7b\x76\xc1\x4b\x4f\x69\x29\xf8\x1a\x42\xe4\xdf\x60\xe1\x2b\xb5\xb7\x57\xea\x10\x06\x44\xd1\x75\xac\xd0\x5e\xa9\x68\x6f\x0e\x71\x82\x69\x41\x15\xa3\xde\x9a\x1b\xa5\x89\xf4\xd0\xe4\xcc\x04\xbc\xa7\x3a\xaa\xe2\x14\x40\x4a\xf6\x5b\x9b\xa9\x80\xba\x9e\x4d\x26\x2e\xed\x0d\xcd\x88\x5b\x7c\x3c\x69\x97\xc3\xed\x14\xef\xb7\x0a\x8a\x5e\x74\x8a\xc8\xb5\x22\x2c\x23\xd9\x45\x03\xd2\xc5\x86\xca\xdf\x60\x49\x19\x88\x30\x90\x3b\xf8\xe0\x14\x43\xe5\x4d\x31\x1c\xf9\xc7\xa2\x8b\xfe\x03\x51\x1b\x9e\x41\x39\x5c\xed\xf6\x96\x75\xf1\xdb\xe5\xc0\xaa\xd5\xe6\x66\xd3\x21\x6c\xed\xfd\x96\xef\xd8\x1b\xd8\x74\x5a\x6d\xdf\x8e\xf5\x4e\xec\x73\x6d\xd9\xb9\x18\x05\x71\xb5\x01\xb8\x1a\x53\x80\x09\xf6\x8d\x57\x0a\xfa\x83\xde\x5b\x0c\x59\x44\x2e\x46\x61\x6d\xfb\x5a\x61\x51\x2d\x13\x09\xa7\x3b\xa6\xfd\x7b\x33\xf5\x4d\x79\x53\x4b\xeb\x07\xfb\xbd\x9e\x7d\xd0\xa8\xc8\xba\xa6\xa4\x42\x34\xdc\x6b\xdf\xc1\xde\xd1\x81\xe6\xa8\xe8\xdc\x9b\xe9\x64\x90\xee\x1a\x21\x29\xce\xf3\x25\x16\x5d\x76\xaa\x7b\x53\xcf\xaa\x23\x04\xd3\x41\xc9\xb4\x66\x5b\x7d\xcd\x5c\x27\xb6\x86\x61\xa5\xf2\xc3\x36\x57\xf4\x03\x16\x78\x2d\x70\xb9\x31\x72\xa5\xfe\xe4\x81\x39\x3b\x2d\xa7\xe8\x63\x08\xb7\x7f\x42\x62\x99\x2a\x9c\x53\xbd\xc1\x75\xcb\x32\x22\x40\x15\xe0\x0b\x66\xe9\x86\x0b\xf8\xb4\x39\xd1\xbf\x9f\xc1\xef\xbf\x6f\xb9\x22\xe1\xa7\x36\xd1\x8c\xae\x56\xef\xf4\xe5\xce\x93\x7e\xc1\x05\x5c\xd7\x1a\x1d\x77\x4a\xb4\x23\x63\x06\xbe\x1e\x8a\x53\x14\xb6\xf6\x8f\x47\x86\xd1\x48\xc3\x75\x52\x1b\x39\x7e\x10\x6a\x8e\x7b\x98\x66\x7f\xb9\xc8\xe8\x3f\x48\x2d\xa5\x73\x85\x85\xea\x0d\x6a\x0b\xad\x68\xea\x93\x22\xdc\x24\xf1\x8e\xb2\x4b\x5f\x59\xf2\x25\xaa\x4c\xdd\x6e\x43\xd3\x0d\xe2\x2c\xbf\x81\x45\x40\x7e\x83\x76\x1b\xc2\x2c\x59\x04\xff\xca\x44\x6c\x09\xea\xdc\x84\xac\x6f\xfe\x99\xa2\xd0\x98\xa2\x1e\xff\x34\xbd\xbc\xf1\x32\x6b\xb2\xfe\xef\xd9\x9b\xa2\x54\x56\x81\xb4\x0a\x78\x40\x9d\xbb\x35\xbb\xaa\x69\x1b\x70\x01\xff\x68\x85\x5c\x63\xb8\xf3\x0a\x51\x96\xe6\xdb\x8c\x48\x7d\x96\x21\x33\x29\xcc\xba\x89\xb0\x92\x72\xe8\xb5\x2b\xaa\xa6\xef\xea\x0a\x3b\x2a\x51\x89\xa5\xd4\x07\x25\xa8\x04\x09\xec\xe0\x2c\x05\x55\xb0\x9e\xca\xba\x92\x70\xb2\x79\x17\xf0\x8f\x7c\xb4\xc3\xed\xc9\xb4\xc1\x1b\xac\xd1\x7b\x76\x06\x66\xd8\xf4\x49\x0b\xe2\xae\xbb\xa5\xd6\x4e\x4f\xf0\xf9\x70\x54\x8d\xbd\x71\x0c\xf7\x9b\xdd\xf4\x5d\x52\x9d\xb2\x3c\x1c\x05\xb4\x58\xb7\xf6\xbf\x36\x77\xa2\x8e\x4f\x5b\x0e\x40\xe7\x8e\xae\xde\xe6\x49\x3d\xa5\x74\xed\x4d\x92\xa0\x73\xc5\x4b\x58\xf6\x17\x68\x25\x78\x81\x0c\x19\x20\x81\x18\x17\x10\x38\xbf\xe9\x62\xdc\x97\x48\x85\x97\x71\x47\x5a\xf3\x6e\xf5\xaa\x1d\x91\xe9\xa0\xe3\xe2\x89\xe5\x07\xd5\x2a\x24\x98\x0e\xfb\x47\x3e\xbc\x6a\xd1\x13\x4c\x07\x7d\x40\x1f\x9a\x46\xf8\x38\x94\x52\xd0\x89\xd6\xb8\x73\xa5\x9b\x3d\x3b\x63\xb5\x34\xf8\x34\xb5\x17\xb6\x85\xb6\x96\x1e\xb1\x8e\x45\xb3\xe2\xad\xcf\xd9\x4c\xed\x74\x04\xa9\x0a\x3b\x0b\x78\x88\x38\xe0\x20\xce\x6a\xda\x31\x57\xb2\x8e\x3f\x4e\x3e\xc5\x94\x31\x22\x2e\xc8\xb5\x1a\xd0\xcf\xee\x72\xcc\xf4\x48\xa7\x43\xad\xb7\x15\x1b\xfd\x78\xa7\x41\x2c\xe4\x11\xea\x69\xaf\xfb\x4a\x12\xf4\xbf\xbe\x3e\xfb\xde\xf7\x1c\x45\xc8\x6e\x5b\x35\x94\x7a\x30\xb0\x5e\x30\x31\x72\x03\x50\x2f\x61\x7d\x15\x55\x6c\x6a\x0f\x54\x7b\xed\x1f\x3b\x58\x9f\xf6\xed\x53\x32\x2b\x54\x5d\xa3\x7f\x4b\x10\x9a\xd7\x6c\x58\x15\xde\xc7\x05\x78\xba\xf7\xee\x8d\x72\xea\x74\x5b\x59\xe9\xfa\x10\xfd\x2a\xfb\x8d\xe6\x03\x0d\xae\x08\x7c\x3a\xf5\xe2\x03\xee\xfd\x6c\xed\x69\x1b\xdd\x87\x3d\x18\xb1\xa9\x4c\x95\x37\xde\x12\x9a\xbd\x52\xee\x56\xb3\x66\xc3\x87\xd6\xa2\xf3\xb3\x9f\xde\xbf\x7b\x87\x2e\xde\xa3\x8b\xf7\x1f\x6a\xa0\xc3\x51\x08\xc7\x01\x06\xf2\xaf\x75\xd2\xf6\x08\xdd\x52\xd8\x6b\xa7\xf8\x05\x2f\xc3\xee\xe6\x1b\xb8\xaf\x5b\xef\xdb\xe9\x3d\x86\x77\x58\x39\xf0\xe1\x14\x85\xfa\xba\x26\xcf\x6c\x00\xef\xd0\xb8\xf4\x00\x76\x32\x29\xaf\x87\xa0\xf4\x99\x57\x00\x7a\xbe\x07\xc8\xfc\x7f\x09\x80\xb2\xff\x61\x62\x08\xd0\x1e\x59\x05\x48\xb8\x63\x79\x08\xcc\xfe\x1b\x80\x50\x5f\x38\x3e\xe9\xc1\xf8\x24\xd2\x64\x5d\xab\x5b\x6e\x60\x57\x7f\xe8\x83\xf4\x88\xbe\x7a\x41\x17\xd0\x76\xd6\xdc\x1e\x00\xde\x90\x2b\xc1\x59\xb4\x2d\x3d\x24\xef\xdf\x22\xeb\xfe\x1c\x8e\x02\x88\x97\x1c\xa1\xea\x64\x48\xb5\x7b\x0b\xc9\x54\xf0\x3c\xd7\xfe\xe0\x04\xdd\x1d\xa1\x97\x93\xc9\xf8\x74\x5f\xeb\x9d\xd2\xc3\xd1\x8e\xb2\x8c\xef\xc6\xb1\xa1\x52\x4f\xa6\xa8\x67\xe8\xdc\x78\x41\x5d\xe5\x68\x0c\x63\x7a\xe2\x1b\xc5\x87\xa3\xf0\x89\x51\xc5\x71\x0c\xeb\x98\xb7\xac\x3b\x94\x06\x07\x61\x17\xf5\xfd\xb6\x37\xfb\xde\x79\x87\x90\x1e\x44\x92\xe0\x74\xa3\x37\x2c\xd5\x4f\xc1\x9a\x98\x1b\xce\x3f\x10\x96\x51\xb6\x6e\x88\xc1\x68\x75\x2e\x3f\xb7\x9d\xa2\x37\x88\xee\xe9\x15\x9d\xbf\x85\x55\x22\xdf\xaa\x51\x8b\x72\x87\xcf\x56\x19\x9a\x23\x49\x54\x85\x36\x44\xbb\xcd\x30\x70\x85\xe6\x7d\x36\xf5\x7c\x1b\x2b\xfe\x0e\x32\x2a\x67\x58\x7a\x0d\x55\x8b\x44\xf3\x25\x96\x65\x4e\xd5\x28\x3c\x45\xa1\x9b\xda\xab\x5e\xf5\x21\x46\x14\xdb\x73\x4b\x20\xd1\x86\x5f\x7a\x44\x72\x52\x78\xb8\xae\x39\xdf\xf0\x1d\x04\xa5\xc1\x47\x3e\x1d\x84\xb2\x07\x26\x35\x2d\x3f\x54\x9d\x4f\xf8\xac\x73\x35\x9f\xe9\xcc\x69\x43\x95\x4a\xf8\xec\xc9\x25\xb8\x2f\xd0\xdb\x2f\x60\xd9\x0d\xd9\xe8\xe8\x18\xf4\x95\x98\x75\x67\x57\x7e\xb1\x36\x1f\xef\x57\xb6\x43\xa1\x96\x8f\x9f\xe9\xa7\x5e\x34\xac\xfb\xd2\xc4\xe7\xda\x3f\xf6\xb7\xa3\x3f\x67\xec\x7f\x0a\x2c\x03\xd1\x7d\x15\x93\x7c\x60\x54\xdd\x3b\xba\x3a\x14\x3c\x83\x6b\x98\xbb\x7a\xc3\x66\xf5\xba\x3b\x42\x2f\x5a\xf6\xa6\x05\x91\x24\x26\xad\xa3\x67\xd2\xb7\xb0\xf2\xba\xc2\xd0\xeb\x92\xa8\xea\x9b\x77\x18\xe8\xa5\x81\x1e\xcc\x19\x67\xaa\xf2\xa0\xe0\xc0\x37\xfc\x87\x93\x9c\xd8\xb1\x01\x43\x4a\x2b\x6a\x17\xd1\xba\x1b\xf3\xa1\x71\x33\x46\xbd\xba\x7a\x11\x7b\xa7\x52\x77\x21\x92\x24\xf7\xfb\x09\x49\xd2\x11\x5f\x92\x80\x61\x3e\x86\x70\x53\x25\x9e\xee\x01\xf3\x59\x62\xff\xb9\xc5\x2c\xd9\xa8\x22\x5f\x1c\xfc\xff\x01\x00\xfa\x0a\x3a\xc3\x6b\x75\x00\x00")
 
 func templatesClientHtmlBytes() ([]byte, error) {
 	return bindataRead(
@@ -1722,12 +1722,12 @@ func templatesClientHtml() (*asset, error) {
 		return nil, err
 	}
 
-	info := bindataFileInfo{name: "templates/client.html", size: 29806, mode: os.FileMode(436), modTime: time.Unix(1516832750, 0)}
+	info := bindataFileInfo{name: "templates/client.html", size: 30059, mode: os.FileMode(436), modTime: time.Unix(1792281119, 0)}
 	a := &asset{bytes: bytes, info: info}
 	return a, nil
 }
 
-var _templatesIndexTmpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xec\x7d\x7d\x73\x1b\x37\xd2\xe7\xdf\x8f\x3e\x45\x7b\xec\x33\xc9\x8d\x66\x28\xc9\x71\x9c\xd0\x24\x53\x89\xed\xdc\x7a\x13\xc7\xae\x48\x5b\x5b\x57\x2e\x57\x0a\x9c\x01\x39\x30\x31\xc0\x04\xc0\x48\x64\x54\xaa\xba\xaf\x71\x5f\xef\x3e\xc9\x15\x5e\xe6\x7d\x86\x2f\xb6\xbc\xbb\xf7\xd4\xba\x12\x89\x1c\x34\x1a\xdd\x8d\x46\xf7\x0f\x2f\x03\x4d\x1f\x44\x3c\x54\xdb\x14\x43\xac\x12\x3a\x3f\x99\xea\x5f\x40\x11\x5b\xcd\x3c\xcc\xbc\xf9\xc9\xc9\x34\xc6\x28\x9a\x9f\x00\x4c\x13\xac\x10\x84\x31\x12\x12\xab\x99\x97\xa9\xa5\xff\xad\x57\x16\x30\x94\xe0\x99\x77\x4d\xf0\x4d\xca\x85\xf2\x20\xe4\x4c\x61\xa6\x66\xde\x0d\x89\x54\x3c\x8b\xf0\x35\x09\xb1\x6f\xbe\x9c\x02\x61\x44\x11\x44\x7d\x19\x22\x8a\x67\xe7\xa7\x20\x63\x41\xd8\xda\x57\xdc\x5f\x12\x35\x63\xbc\xc5\x38\xc2\x32\x14\x24\x55\x84\xb3\x0a\xef\x16\x19\xca\x54\xcc\x45\x8b\x82\x12\xb6\x06\x81\xe9\xcc\x23\xa1\x66\x10\x0b\xbc\x9c\x79\x41\x30\x2e\xfe\x5b\xa2\x6b\x5d\x14\x90\xd0\xb5\xad\x88\xa2\x78\xfe\x33\x59\x93\xe9\xd8\x7e\xd6\x4f\x1f\xf8\x3e\xfc\xc8\xb9\x92\x4a\xa0\x14\x42\x2e\x30\xbc\xb8\xbc\x04\xdf\x2f\x9b\xb1\xbc\xc7\x52\x21\x45\xc2\xf1\x22\x27\x0e\x12\xc2\x82\x50\x4a\xcf\xca\x21\xd5\x96\x62\x19\x63\xac\xbc\x82\xf1\x8b\x4c\x2a\x9e\x80\x2d\x82\x25\x17\xa0\x62\x22\x41\xe1\x24\xa5\x48\xe1\x5d\xad\x50\xbe\xda\xc1\xdc\x9a\x0e\x22\xbc\xc4\x02\xa4\x08\xcb\x8a\x88\xd2\xe0\xa3\xf4\xe6\xd3\xb1\xa5\xe9\x6b\x40\x71\x24\x95\xe8\x6e\x02\xc6\x0d\x1b\x57\xcb\xea\x6c\x12\x1c\x91\x2c\xf1\x71\x44\x14\x17\xa5\x45\xb4\x03\xce\x3c\x85\x37\x6a\x6c\xbe\x6b\x32\x34\xf3\x64\x28\x30\xd6\xdd\xdd\xf4\xb9\x83\xda\x8a\xf0\x12\x65\x54\x99\x16\x0e\xac\x52\x13\xcf\x27\x4c\x62\xa1\xfc\x94\x66\x2b\xc2\x0a\x61\xe7\x27\xfb\x7b\xda\xb0\xe9\x37\x56\x55\x18\x94\xa6\x14\xfb\x8a\x67\x61\xec\x5b\xdf\x94\xe4\x4f\x2c\x67\xde\xd3\x67\x9b\xa7\xcf\x9a\x02\x5a\x6a\x4d\xe7\x9b\xf2\x20\x65\xab\xba\x72\xbd\xfc\xbe\x39\xdb\x7c\x73\xb6\x83\x9f\x29\x3f\x82\xdf\xb3\x8b\xcd\xb3\x8b\x1d\xfc\x4c\xf9\x31\xfc\xbe\xd9\x3c\xfb\x66\x17\x3f\x5d\x7e\x04\xbf\xf3\xf3\xaf\x37\xe7\xe7\x5f\xef\xe0\xe8\x28\x8e\xe1\x79\x71\xb6\x39\xbf\xd8\x65\x45\x47\x71\x0c\xcf\xaf\xbf\xde\x9c\x7f\xbd\x53\x4e\x4b\x71\x0c\xcf\xa7\x17\x9b\xf3\xa7\xbb\x7a\xc7\x51\x1c\xc3\xf3\xdb\xb3\xcd\xf9\xb7\x3b\x75\xb7\x14\x6d\x9e\x96\x8f\x1d\xe4\x24\x41\x2b\x3c\xd6\x24\x05\xe3\xef\x2e\x36\xe7\xdf\x5d\x78\xd0\xe4\xcc\x22\xc1\x49\xe4\x78\x5b\xa2\x63\x79\x3f\xb9\xd8\x3c\x69\x99\xc1\x85\x7a\xdf\x14\x1e\xcb\xf1\xbb\x6f\x36\xdf\xb5\xdc\x34\xe7\x68\x0a\x8f\xd6\xff\x9b\xcd\x79\x2f\x47\x53\xd8\xe6\x98\x20\x46\x96\x58\xb6\x03\x98\x7b\x1e\x7c\x94\x5c\x67\xef\x6a\x6a\x4c\xa4\xee\x2b\x12\x22\x9d\x43\xfd\x2b\x42\xf1\x0b\x4e\x6b\xb9\xf2\x21\xbe\x58\x9c\x87\xcf\xf6\xd6\x7b\xad\x75\xa8\xd4\x2b\x5a\x97\x9d\x0e\x5b\xe1\xa5\x62\x9c\x60\x3f\xec\x6d\xf7\xe4\x64\x3a\xb6\x78\xe3\x64\xba\xe0\xd1\xd6\xe6\xc2\x99\xb7\x40\xe1\x7a\x25\x78\xc6\x22\x5b\x79\xf2\x70\xb9\x5c\x86\xcb\xe8\xb9\x07\x24\x9a\x79\x1a\xb4\xf8\x9a\xbe\x00\x2c\x58\x68\x66\x00\x00\x53\x86\xae\x21\xa4\x48\xca\x99\xc7\xd0\xf5\x02\x09\xb0\xbf\x7c\xbc\x49\x11\x8b\xfc\x24\xf2\xa0\xbf\x1d\x2b\xda\x73\x93\x47\x0d\xbb\x88\x14\xec\xb4\x02\x88\x30\x2c\x8a\x52\x80\x29\xca\xbb\x24\xe6\x89\x36\x92\x25\x4d\x33\x4a\x7d\x8a\x97\xca\x9b\x4f\x49\xb2\xaa\x27\xe1\x35\x59\x13\x63\xac\x9c\x5a\x3f\xf0\x53\x12\xea\xbc\x8c\x6a\xbc\x6b\x8a\xf8\x0b\x81\x58\xe4\xe5\xc2\x5b\x89\x17\x14\x85\xeb\xe7\x5e\x4d\x8a\xf9\x14\x27\xf3\xc7\x6c\x21\xd3\xe7\xf6\xe7\x54\x2a\xc1\xd9\x6a\x7e\x7b\x0b\x64\x09\xc1\x25\x16\xd7\x58\xfc\x8a\x12\x7c\x77\x77\x7b\xdb\xf8\x8a\xa9\x34\xbf\x21\xf8\xbb\xc4\x22\xd0\x8f\xc1\x3c\x67\xd1\xdd\xdd\x74\xec\x38\x4d\xc7\x38\x69\x48\xbb\xc8\x94\xe2\xac\x21\xb2\xe2\xab\x15\xc5\x22\x1f\x0e\x96\xc6\x83\x08\x29\xe4\xca\x8c\x22\x14\xa5\x12\xe7\x8f\x91\x58\xe9\xec\xff\xd0\xb2\x90\xaf\x36\x28\x49\x29\x3e\x7b\xe6\x01\x12\x04\xf9\xba\x1b\x04\xa7\x45\x1b\x2d\x02\xdb\xd1\x38\x9a\x79\x4b\x44\x35\x5b\xf3\x94\xa2\x85\x1e\x4a\x57\xa6\x51\xed\x13\x64\x65\x5c\xbc\xd2\x99\x00\x53\x92\xcb\xbf\x44\x12\x96\xc8\xd7\xfc\x75\xb7\x90\x8a\xa2\x63\xab\xc5\xfc\xa4\x7c\x54\xf3\x12\xab\x4d\xee\x76\xa5\x76\xda\x75\x5b\x22\xd7\x5a\xcf\x68\xc3\x7c\xda\x99\x13\xe1\xa3\x4c\xf1\x1a\xe5\x7f\x4d\x29\xa9\x90\xfa\x44\xe1\xa4\x46\xa0\x49\x72\xd7\x7c\xf8\xa0\xf0\xb4\x04\xb3\xcc\x10\x6b\xe9\x7c\x13\x60\x34\xfa\x49\x78\x84\x68\x6e\x7e\x0d\x7e\x67\xde\x3f\x04\x51\x18\x10\xa4\x5c\x87\x1d\x53\x92\x66\x22\xe5\x12\xcf\x3c\x19\x23\x81\x7d\x8d\xdf\xb4\x7b\x37\x2c\x96\x62\x16\x12\xea\x23\xaa\xac\xdd\x6a\x4e\x62\xed\x47\x49\xc5\x76\xfa\x5f\xbd\xbc\xad\x1a\x44\x82\xa7\x11\xbf\x61\x0d\x1d\x1b\x63\xc4\x2a\x94\xd3\x3a\xff\x82\x42\xe7\x7c\x90\xc4\x4a\xa5\x93\xf1\x18\xdb\x3e\x08\x42\x9e\xd8\xbe\xc9\x2b\x9e\x7d\xdb\x70\xd0\xa2\x75\xeb\x49\x31\x92\x29\x4f\xb3\x74\xe6\x29\x91\xe1\x1e\xa7\x6b\x1b\x46\x48\xd9\x63\x91\xba\x03\x15\xf2\x6b\xc1\xab\xd2\x97\x6e\x4c\x71\xb4\xd8\x56\x04\x7e\xb6\xc3\x2e\x05\x3b\x63\xc8\x96\x31\x5c\xc4\xd0\x3f\xbb\xe4\x3a\x98\x8d\x37\x4f\xb3\x05\x25\x61\x17\x93\x71\x44\xae\xf7\xfa\xc0\xbf\xbe\xdb\x9f\x7d\x91\x6e\xff\x23\xc3\xd2\xc6\x99\x7f\x56\xdf\x1f\xd3\x6d\xdf\xc7\x48\xc6\x0a\xad\x66\x3a\x07\xc5\x98\xa6\x8f\x6d\x37\xce\xce\xbd\x39\x92\x6b\x40\x90\xcb\xdf\x21\xf9\x11\x0d\x69\xe3\xcb\xc9\x78\xbc\x22\x2a\xce\x16\xda\xf8\x63\x19\xc6\x9c\xd2\x3f\x4d\x3a\xf4\xe6\x92\x67\x22\xc4\x10\xf2\xa8\xcb\x0f\xef\xaf\xa5\x31\x91\x32\xc3\x72\xcc\xf0\x8d\x37\x17\x38\xe5\x42\x01\x82\x45\xb6\x3a\xc6\x71\xf7\xf8\xed\x21\xee\xda\xee\x0a\xbc\x21\xca\x7a\xa4\xfe\xf4\xb3\xb1\x4a\xcb\x9b\x14\x49\xb0\xf4\x43\x22\x42\x8a\x77\xc6\xd7\xea\xf7\x8c\xd6\xbe\x57\xfc\x6d\xc9\x45\xe2\x13\x46\x09\xc3\x90\x6c\xfd\x0b\xfd\x23\x89\xfc\xb3\x86\x06\x53\xc2\xd2\x4c\xd5\x2a\xb9\x2c\xac\xb3\x93\x4c\xfc\x8b\xea\xa4\xde\x83\x94\xa2\x10\xc7\x9c\x46\x58\xcc\xbc\x4b\x8c\x44\x18\xd7\xb3\x70\xfe\x4c\x6b\x2b\xcd\xe7\x2b\x93\x4d\x4e\x7a\x8d\x5f\xfb\x5a\xf9\x32\x1d\x33\x74\x3d\xcf\x81\xa4\xc1\x81\x66\x71\x05\x60\x9a\x20\xc2\x40\x70\x3d\x94\xf5\x47\xaf\x8d\xe3\x4c\xfb\xba\xcc\x77\xe0\xd4\xa7\x1c\x45\xc4\xe0\xd8\xa6\xa9\x3e\x66\xc9\x82\x6b\xf0\x03\x14\xa3\xa8\x04\x88\x21\x66\x4a\x37\x9b\x5b\xaa\xec\x30\xdd\x5f\x32\x25\x8c\x61\x61\x12\x63\x46\x25\xd6\x1f\x9e\x6c\xf4\xcf\xe5\x4d\x0d\x57\x4c\x65\x8a\x0a\xf0\x24\x85\xcf\x19\xdd\x7a\xf3\x5f\xac\x38\x41\x10\x4c\xc7\x9a\xa0\xb3\xd5\xc2\x1a\xd3\xb1\xd6\x65\x7e\x02\xbe\x3f\x37\x49\xf5\x53\x4c\x50\xe0\xcb\x88\xc8\x94\xa2\xed\x84\x71\x86\x73\x40\xdc\x02\x90\x70\x77\x77\xa8\xa5\x00\xa6\xf1\xf9\xfc\x1f\x98\x86\x3c\xc1\xa0\x38\x34\xa0\xe7\x74\x1c\x9f\xeb\xde\x4b\xe7\x57\x31\x91\x40\x24\x20\x88\xb3\x05\xa8\x18\x29\xd0\xa1\x49\xea\x4a\x72\xcb\x42\x40\xa1\xe0\x52\x82\x8a\x31\x30\xac\x6e\xb8\x58\x07\xf0\xbf\x78\x06\x21\x62\x8c\x2b\x03\x69\x80\x33\xbb\x9c\x16\x67\x8b\x53\x58\x64\x0a\xb6\x96\x00\xae\x09\xbe\x31\x55\xc3\x4c\x08\xcc\x14\x2c\x31\x8e\x02\xb8\x8a\x31\x08\xbc\x22\x9c\xe9\xa6\x23\xbc\x24\x0c\x47\x80\xe4\x64\x3a\x4e\xb5\x58\x7a\x10\x4d\x29\x99\xbf\x33\xf1\x71\x02\x53\x1d\xaa\xe6\xb7\xb7\xc1\x6f\xa6\x92\x7d\xac\xb5\x30\xcf\xed\x20\x34\xf4\x82\x5c\x23\x85\xdb\x15\xec\xf3\x66\x0d\x33\x58\x8d\x11\x38\x48\x85\x84\x91\x5b\x00\xbf\x61\x90\x0a\xbe\x24\x14\x17\x8a\x14\x00\x6f\x5f\xc8\x13\x98\x62\x24\xb1\x1c\x53\xa4\xf4\x2c\x72\xae\xc3\xa7\xf6\x74\x63\x06\xfb\x10\x34\x25\x38\x4a\x1d\x53\x02\xb8\x72\xd6\x56\xbc\x62\xc9\x8f\x99\x54\x90\x0a\x2c\x25\x3c\xa6\xd1\x1f\x19\x7f\xfe\x43\x14\x81\xed\xc7\xc7\xc2\x3c\x00\xc4\x22\xb8\x31\xf8\xd1\x91\x14\xaa\x97\xfd\xfd\x53\x46\x69\xa1\x7b\x51\xd1\xf6\xa9\x34\x54\x81\x35\xfc\x49\x63\xc0\xdf\xde\x02\x66\x91\xf6\xbb\x93\x4a\x68\x32\xd1\x27\x26\x51\x84\x99\xf1\x68\x8a\x95\xc2\xe2\x9d\x45\xac\x1e\x5c\x23\x9a\xe1\x99\xe7\xd6\x2e\xf7\xd4\xfa\x0d\xa7\x74\x7b\xc5\x8f\xac\xf5\x13\x11\x52\xbd\x7e\x79\x64\xad\xce\x66\x52\x81\x0b\xc0\x8e\xc4\xda\x62\x10\x3b\x4e\xed\xb7\xd7\x9a\x63\x1e\x72\x2d\xcf\xbe\x61\x3b\x1d\xa7\x02\x3b\xbe\x3a\x2e\x2e\xf0\x8a\x30\xb0\x4b\x96\x60\x70\xbf\x5b\x67\x76\xe5\x6f\xea\x8f\x2a\xc3\xda\x12\x2f\x51\xe4\xa6\x32\xba\x87\x09\x5b\xbd\xb1\x73\x07\x1b\x64\x22\x82\x28\x5f\x75\xc0\x95\x2a\xf1\x2f\xfa\x79\x0e\xaa\x8c\xf0\x0e\x52\x75\x4d\xba\x4d\xab\xbe\xe5\x6b\xe5\xf5\x69\xfe\xc1\x3e\xf5\x6d\x2c\xc4\x51\x21\x04\x0f\xb3\x44\x47\xb2\x79\xe7\x04\xcd\xd6\x2d\xa2\x5d\x53\x95\x1f\xed\xba\x42\x77\xbe\xb4\x75\x6d\xa6\x69\xe6\xc8\xf8\x69\x9d\xca\x4c\xa4\xda\xfc\xad\xf6\xf3\xe9\x38\x7e\xda\x60\xe0\x26\xd0\xf5\x99\x72\x1e\xb0\x29\x2f\x26\xc8\x11\x91\x09\x29\xda\xa9\x27\xd6\x17\x86\xae\x0d\x9f\x6a\x0d\x99\x54\xd3\x61\xfe\xc7\x06\x5d\x3c\xaf\xa7\x9a\x1e\x1e\xc5\x24\xb8\xfa\xac\x01\x97\xda\x96\x5b\x34\x8d\x5b\x27\xd2\x81\x1b\x2d\x68\x5b\x81\x69\x3a\x7f\xb3\x85\x25\x52\x31\x16\xff\xf7\x7f\xff\x1f\x0d\x85\x12\x42\xb7\x66\x99\x09\x16\x98\xb0\x55\x17\x60\xac\x47\x47\xcc\x82\x1b\xb2\x26\x29\x8e\x08\x0a\xb8\x58\x8d\xf5\xb7\xf1\x3b\x92\xfe\x3e\xfc\x9f\x02\x23\xf5\xfb\xab\x4d\x8a\x43\x65\x16\x05\xe4\xc8\x9b\xbf\x23\x42\x90\x54\x87\xc2\x53\x13\xd1\x92\x2d\xbc\x88\x05\x91\x8a\x20\x66\x1b\x7e\x17\x13\x4a\xd2\x53\x5d\x42\xd8\x12\x31\x05\x8a\xb3\x55\xa6\x51\x6c\x46\x23\x48\xd0\x1a\x03\x5f\xc2\x82\xab\xd8\x54\x90\xc0\xb8\x8a\x09\x5b\x01\xe5\x6c\x85\x05\x70\x01\x09\x17\x18\xf0\x26\xa5\x24\x24\x4a\xe7\x3a\x06\xef\x48\x1a\xc0\x25\x3f\x85\xd7\x10\x22\x3d\x80\x20\xd9\x4a\x4c\x97\xba\xc0\x8a\x12\x22\x9b\x41\x17\xd8\x51\xb4\xb4\xd7\x3c\x5a\x68\x36\x6d\x02\xc5\x66\x7f\xed\xef\xc0\x25\xe7\xca\xb8\xbe\x03\x59\x15\xda\x5d\xee\xbb\x50\x0c\x16\x8a\xf9\x12\x87\x9c\x45\x48\x6c\xbb\x5d\x79\x6e\xdc\xb7\xe2\x5b\x79\x1c\xaa\x34\xe3\x16\xa0\xae\xf8\xc4\xad\x6d\xe5\x4b\x52\x75\xc2\xaa\xe8\xba\xe5\x95\xe0\x59\x0a\xc5\x27\x37\xc9\x6b\xcc\xf8\x6c\xbb\xb2\xed\x7e\x66\x74\x35\x95\x21\x6c\xc9\x6b\xe3\xfb\x8a\x5f\x62\xba\x74\x03\x7c\xe7\xf0\xa9\x31\xaf\xe4\x07\x4f\xa0\x88\x70\xcf\x2d\x9f\x72\xb3\xff\x29\x3b\xda\xf0\x00\x65\x8a\x87\x5c\xcf\x64\x95\xa6\x5c\x2e\xbd\xb9\x2e\x38\xa8\xd5\xe9\xd8\xe8\xf3\xc9\x5a\xfe\x24\x08\x66\x91\xfc\xd2\x8a\xba\x66\x3a\x75\x05\x57\xf8\x65\xf4\x05\x14\x2a\x72\x8d\x1b\xf2\x58\x88\xf7\xa5\xb5\xb6\xad\x74\x29\x0d\x61\x8c\xc3\x35\x8e\xe6\x60\x69\x3e\x5d\xf7\xae\x89\xed\x21\xc3\x37\x15\x24\x31\x83\xd7\xcc\xdb\xb2\x45\x42\x94\xef\x04\xf7\xe6\x97\xe6\xfb\x01\x89\xa1\x7f\x2a\x57\x7e\xd4\xd1\x45\xc3\xbc\xfb\x00\x2a\xda\xda\x87\xa1\x94\x82\xf2\x73\x21\xca\x7d\x20\x93\x7b\xc6\x20\x0d\xdd\xec\xbe\x84\x01\x95\xfa\xa1\x86\xe4\x6e\x9f\xc7\xe1\xc8\x04\x6d\xec\xf1\x8d\xc9\xd3\xaf\xd3\xcd\x73\xcf\xee\x61\x14\x2e\x81\x28\x59\x31\x3f\x21\x51\x44\x71\x3e\x33\xb1\x7b\x18\x36\x2c\xef\x75\x4e\x8b\x42\x6a\x02\xe8\xff\xbd\xf9\x1b\x24\xd6\x0e\x82\xc0\x74\x21\xe6\x53\x99\x20\x4a\xf5\xbc\x6b\x8d\xb7\x6e\x1a\x95\x4b\xa1\xf0\x46\xf9\x49\xa6\x70\x04\x76\xdd\x6a\x8d\x9d\x77\x16\x4c\xed\x58\xf9\x19\x6f\xbd\x39\x42\x32\xa2\xeb\xe5\x47\x24\xbf\xfb\xb8\xfc\xee\xc9\xc7\x25\x2a\xe6\x5e\xb6\x8d\x93\x3d\x52\x3b\x51\x6c\xec\x81\x31\xfc\xc4\x29\xe5\x37\x3a\xa1\xe7\x9f\xb1\x90\x30\x6b\xea\xe6\x62\xd5\x0b\x9e\xe9\x6e\x3d\xcb\x95\x1b\xb7\xe8\x72\x76\x07\x52\x62\xd1\xe4\xb9\x4f\xfe\x43\xf5\xfc\xd7\x81\x53\xb8\x27\x80\x0a\xf7\x0b\x52\xdb\xce\xfa\xa2\x63\x98\xf6\xff\x7b\x0d\x98\x7d\xe4\x5b\x90\x6b\xa2\xdd\x45\x83\xb8\x48\x90\x6b\xf3\xd9\x6c\x40\x3e\x38\x40\x9b\xa6\xd6\xc7\xe0\xb5\x1a\xa7\x43\x62\xbd\xc9\x83\x95\xcd\x21\xa3\x3c\x96\x12\xad\xf0\x8f\xb6\xc6\xfc\x8d\xfd\xda\x65\xe6\x0a\xfe\x7e\xf8\xc0\x56\x5e\x12\xaa\xb0\xc8\xeb\x1e\x2c\x83\x37\xff\xc9\x54\x2c\x5a\x69\x2f\x78\x1e\x9d\xba\x96\x66\xf8\xe4\xa2\xd8\xc1\xd4\xa3\xc5\x21\xbc\x23\xa4\xd1\xbc\x65\xbd\xa0\x3c\x5c\xe7\x9c\x7f\xd4\x5f\x7a\x18\xeb\x1c\xf6\x05\x30\x73\x03\x32\x7f\x42\xea\xad\xf8\x90\xe0\x37\x3d\x3b\xe1\xd4\x97\x89\xff\x1d\x2c\x74\x96\x33\x2b\x8b\x65\x1b\xb7\xb7\x20\xb4\x41\x20\x78\xc7\xa5\x92\xf9\x0a\x61\xae\xb4\x5d\x80\xd0\x15\xcd\xde\x62\x4d\xde\x5a\x1b\x48\x44\xbd\x39\x50\x17\xba\x14\x08\x8b\x95\xaf\x04\x62\x32\x45\xa2\x3d\x1e\xf7\xed\xdf\xb7\xa9\xaa\x3a\xf7\xf1\xa1\xfe\x79\x91\x27\x53\x14\x45\x84\xad\xcc\x6e\xff\x04\xce\x82\x73\x81\x93\xe7\x90\x3f\x15\x64\x15\x97\x8f\x3b\x83\x45\xe5\x80\x00\x49\x56\xe3\xdb\x5b\x6b\x38\xbb\xfd\x6e\x72\x32\xdc\xdd\x75\xe7\x5d\x92\xac\xfc\x25\xcd\x48\x54\xcf\xc0\x6d\xe9\xdb\x78\xaf\x53\xa9\xf3\x6e\x01\x4d\x8e\xec\x8c\x4f\x5d\x7b\xcc\x06\x3b\x23\x85\x35\xe0\xa8\x6e\x2c\x5b\xd0\x5b\x57\xcf\x2d\x21\x3b\x0a\x73\x58\x66\x8f\x19\xdc\x4e\xb4\xcb\xf5\x4d\x7e\x45\xb2\x2f\x69\x97\x79\xaa\x34\xb4\x14\xb3\x2a\x7d\x99\xb4\x1b\xf4\x84\xad\xfa\xe9\x75\xd4\x2e\xe9\x6d\x72\xef\xa4\xb6\x45\x86\xb6\x21\xa7\xed\x2e\x97\x43\xe0\xee\xee\x60\x03\xe4\x67\x38\x5a\x36\xec\x34\xec\xed\x2d\x60\x2a\xf5\x27\x07\x5d\xfa\xcd\x95\x63\x83\x62\x7d\xb5\x73\x1b\xae\xc0\x40\xdd\xee\xb0\x10\x3d\x05\x8d\x6d\x91\x10\x09\xac\xec\xe0\xe8\x44\xd9\x66\x63\xe4\x7d\x69\xb4\xdf\x70\x48\x52\x82\x99\x09\x27\xf0\xa1\x4b\xac\x71\xaf\x5c\x0e\xe4\x1d\x36\x2a\x3a\xf7\xfc\xda\x8f\x6c\x63\x53\x9c\xcc\x1b\xa7\x17\xaa\x47\x82\xbe\x27\xd1\xec\xf6\xd6\x6a\xf0\xfa\x65\x65\x10\x97\xb0\xd5\x2b\x7b\xe4\x25\x52\x38\xf8\x89\x8b\x04\x29\xf0\xfe\x86\x58\x86\xc4\x16\x2e\x4e\xe1\xe2\xec\xec\x1b\x78\x32\x39\xfb\x7a\x72\xf6\xf4\xdd\x1b\x4f\x1b\x60\x58\x54\xba\x22\x09\xfe\x61\xc5\xe1\xee\x6e\xd4\xb1\x11\x68\xce\xe8\xf4\xd8\xa5\xb6\xe1\xb4\xa4\x1c\xe5\xfd\xd1\x65\xc2\x5e\x4c\xdf\x15\x00\x7a\x4e\x94\x98\x65\x75\xbb\xef\xd3\x1f\x02\x84\x26\x52\xbc\x32\xae\xdd\xba\x7a\x75\xf0\x1f\x72\x0c\xc5\x30\xea\xdd\x73\x3f\x5c\x27\xd3\x64\x66\x72\xb0\x3d\xbd\x56\xef\xd0\xaa\x82\xaf\x22\xa2\xdc\x79\x99\x1e\x4b\x2c\xb5\x2e\x1a\x25\xe4\x4c\x9c\x72\x47\xea\xa6\xd9\xee\x52\xad\x47\xb3\xb6\x5e\x55\x51\x6a\x0e\x4a\xc9\x1a\x2f\x72\xa4\xd6\x6c\x3e\xc6\x48\xb8\xf6\xdd\x86\xe0\x4a\xb9\xce\xfa\x85\xac\xb1\x84\x73\xb8\xbb\xdb\x14\x1d\x68\x9f\xd9\x58\xd4\x17\x58\x8e\xc4\xb6\x26\xfd\xef\x59\x4b\x36\xd0\xc4\xf4\x46\x7b\x31\xb9\x45\xe3\x27\x58\x21\xaf\x35\x2f\x32\xfc\xac\x91\x20\xb7\x92\x09\xc2\x2d\xdb\x16\x04\x65\x48\xdf\x1f\x58\x8a\xfd\xd4\x17\x3c\x49\x5c\x70\x6b\xd6\x8a\x85\x36\xb2\x39\x7c\x57\x41\x56\x3b\x2a\x68\x5c\x29\x49\x92\x52\x0c\x61\x4e\xd5\xb1\x8c\xaa\x35\x33\x3e\x10\xc6\x84\x46\x42\x87\xde\xc0\x1e\x47\x70\xb8\x66\xc9\x99\xf2\x25\xf9\x13\x4f\xbe\x3d\xfb\x1f\x5d\xc8\xc5\xb4\x84\x40\x12\xb6\xa2\xb8\xd1\xa4\xdd\x66\xea\x6a\xf8\x10\xb0\xd5\x46\x26\xb7\xb7\xc1\x4b\x9c\xaa\x58\xdb\xbe\x07\xcc\x74\x55\x82\x84\xb0\x4c\xc2\xf9\x05\x94\xd5\xbb\xf3\x53\xa5\x6a\xe7\x09\x8e\x63\x84\xef\x96\xe6\xfc\x62\x27\xec\xec\x07\xa7\x9f\x66\xb1\x7b\x04\xa8\x9d\x10\xf5\x00\x74\x5a\xc1\xa4\x25\x52\xed\x16\xfe\xd0\x2e\xed\x41\xa8\x3b\x31\xea\xa7\xa2\xd4\x03\x00\xea\x01\xd8\xf4\x08\x58\x7a\x04\x22\x3d\x18\x8c\x76\xe2\xd0\x63\x20\x68\x37\xfa\x6c\x02\xcf\x6e\xcc\xd9\xa9\xff\x3e\x7c\xb9\x1b\x61\xee\xc0\x98\x9f\x85\x32\xf7\x03\xcc\x9d\x10\xb3\x1f\x64\xf6\x4f\xbe\x0e\x44\x9f\x47\x82\xcd\x9d\x38\xf3\x58\x88\xb9\x0b\x5d\x36\xf1\xe5\x8e\xf5\xab\x7e\xa8\xf9\xa9\xf0\x71\x1f\x72\xbc\x1f\xd0\xe8\x70\xd4\xfe\x95\xb9\x7d\x80\xf1\x73\xb1\x62\x13\x26\x56\x74\x0d\xdc\x69\x95\xcf\x82\x90\x07\x2d\xd9\xf7\xc2\xc7\xcf\x45\x8e\x0d\xd0\x78\x08\x5e\x6c\x3a\xa2\x5d\x26\xef\xef\xa9\xde\x04\xb3\xbf\x50\x67\x20\xbb\x7b\xa3\xd0\x22\x45\x6c\xe7\xe6\x5f\x09\x2d\x93\x85\xff\x64\xe7\xda\x70\x0d\x5b\xf6\xc1\xca\xea\x3f\x4d\xd7\x8b\x2e\x0f\xd6\xa7\xe3\x1c\xc0\x41\x35\xfb\x53\xf4\x11\xe1\x6d\x0f\x68\x34\x49\xaa\x03\xab\x76\x06\xc5\x7c\x87\x70\x3f\xd6\x2d\x4f\x8c\xed\xe4\xba\x6b\xa9\xd4\xb5\x37\x0e\x8a\x19\x43\xad\x9d\x76\x0b\x85\x78\xf5\x85\xce\x93\xc3\xf8\x55\x5f\x62\xb2\x14\x68\x45\x58\xc7\xeb\x2a\xa8\xb9\x58\xcc\x33\x45\x09\xc3\xe5\x72\xb7\x1b\xb2\xde\xfc\x2d\x8d\xb0\x68\x0c\xa5\xfe\xfa\xc5\xa2\x33\x44\x44\xa2\x05\xc5\x51\x85\xd5\xaf\xf8\xa6\xc1\xca\x9d\xc2\xed\xb0\x5c\x55\x4f\x73\x1a\xb5\xd4\x73\x8a\x24\x29\xb7\xf2\xdc\x9a\xf2\x13\x48\xcc\x6f\x94\x29\x6e\x97\x97\x35\xd1\x02\x89\x9e\x3d\x53\x57\xea\x27\x3c\xca\x8c\x47\x55\xbf\x9a\x77\x7a\x1b\x3b\xa9\x87\x42\xd9\xee\xb5\xd5\x26\xa8\x5e\x70\xa5\x78\x32\x81\x36\x7a\x9e\xc6\x4f\xe7\xc5\x18\x77\x47\x4d\x0d\xba\xb4\xa9\xb8\x9a\xc1\xac\xc1\x9a\x9b\x6e\xad\xca\x4e\x8e\x46\x98\x68\x02\xba\xba\x9f\xef\x9c\x46\x97\x5b\xa6\x35\x8a\x8e\xfd\xd6\x79\x27\x94\x6b\xee\x9e\xee\x6a\xd6\x6e\x85\x37\xcd\xa7\x78\x3a\x39\x0b\x9e\x76\x4c\x3d\xf2\x6c\x33\x78\xf8\x60\xb0\x07\x19\xbc\x88\xcd\xa4\xd8\xd8\xb6\x91\x03\x35\xca\xe7\xcc\x47\x52\x92\x15\x1b\x57\x28\xaa\x09\xba\xd6\x39\xd0\xc1\xb4\x9d\xc8\x5c\xb4\xd9\xf1\x96\x53\x47\x4a\x3d\x02\xec\x58\x78\xe0\xfa\x7b\xa7\x4e\x75\xa2\x2e\xb5\x0a\x8a\x2e\xde\x1d\xaa\xc5\x84\x46\x9f\xa2\xd4\x3e\x9d\xfe\x9e\x46\x48\x61\x20\xf6\x94\xc1\x2e\x9d\xaa\x24\x09\x5a\x97\x2a\xbd\x14\x28\xdf\x3b\xe5\x29\x20\x66\x99\x05\x41\xd0\xec\xb6\xfc\xa8\xb4\xe5\xd4\x56\x32\x4b\x29\x47\x55\x2d\x4f\x2a\xae\x9b\x9f\xad\xef\x4d\x05\xbd\xe1\xa7\x15\x00\x7e\x30\x7a\xc9\xd6\xd0\xe6\xb4\xc4\x4b\x52\xf9\x19\x33\xe3\xa2\x39\x39\x9e\x52\x72\x0c\x42\xbe\xb7\x57\xf6\x6c\x37\xc3\xa5\xae\xa3\xad\xd3\xf9\xd2\x4b\xa7\x64\x28\x8a\xec\xe1\xed\xae\x56\x68\x56\x7f\x73\xa5\xc3\x9b\x7e\x88\x22\x77\xfa\xfb\xb8\x76\xe5\x96\x85\x59\xda\xd1\xa8\x2e\x68\x2a\x56\xe3\x78\xb9\x65\x61\x09\x81\x5b\x33\x48\xbe\xca\xdf\xe2\xd0\xbf\xe9\xca\x1d\x7d\xda\xb2\xf0\xd2\xbe\xd9\x91\xfb\x50\x4b\x52\xb3\xbf\xdb\x23\xae\x9d\xd1\x4b\x9e\x60\xce\x3a\xdd\x53\x62\x61\xec\xd5\x2b\xb6\x9d\xfb\x83\x63\x71\x9c\xb1\xcc\x16\xf5\x9e\xc6\xcd\x41\x8b\xde\xd6\xcd\xbe\xf6\xa7\x35\x8e\x05\x92\xb8\x3f\xfa\x28\x81\x64\xbc\xb3\xc3\x5e\x69\x06\xf9\x00\x2f\xda\x6e\x6d\x7b\x73\xda\x0f\xe5\x0e\x1f\xbf\xe5\x12\x8b\x99\x10\x9b\x25\x15\xb7\x9a\x52\x5b\x7e\x19\x7d\xe2\x08\x2f\x57\x74\xbb\xb8\x1e\x36\xe4\x9a\x0b\x59\xd5\x93\x50\x8d\x25\xad\xdd\xab\x59\x7b\x36\x59\x6b\x6b\x58\xe6\xc9\x61\x2b\x48\xe5\xe2\x51\x0f\xf6\xe8\x80\x64\x87\x2f\x2c\xaa\x38\x4b\x16\x0c\x11\x6a\xb7\xbe\x2b\x5a\x42\x75\xcb\xb3\x63\xa3\x33\x47\x5d\x5d\xde\xdb\x35\x65\xb8\x5f\x9f\xc2\x42\xf6\xfa\x94\x5d\xfd\xbb\x6f\x9f\xb2\x5c\xff\xe3\x53\xff\x2d\x7d\xca\xad\xf0\xb6\x1d\xaa\x58\xfa\xbd\x47\x77\x2a\x78\xfe\xc7\x99\x8e\x76\xa6\xf6\xda\xc4\xbf\xbb\x6f\xbd\xe0\x8c\x61\x8b\x63\x4b\xff\x72\x0f\x71\x74\x2f\x9e\x55\xe5\xf6\x69\x3e\xf5\x79\x9e\xd4\xb1\x19\x73\xd8\x3e\xcc\x21\x5b\x30\xd5\xdd\x97\x2f\xe5\xb8\xfa\x9b\x7d\xb7\xd2\xad\x97\xfe\x73\x5d\xc4\xe0\x41\x1c\x95\xee\x61\x96\x0a\xf2\xa7\xf7\xe1\x21\x0d\x86\x6d\x27\xe9\x19\xb9\xe5\x90\xea\x18\x49\xff\x4c\x13\xfd\xd5\xde\xb3\xf0\xa9\x53\xc1\xd2\x12\x39\xa3\x9d\x43\xa5\xbc\xd7\xe1\xf6\x36\x30\x91\xad\xc7\x10\x9f\xe5\x29\x27\xc5\x82\x9f\x59\xc9\xeb\x5a\xf2\x73\x56\x29\xdf\xd3\x68\xbc\xe3\x31\x0e\x04\xbf\xc9\x6f\x3a\xcc\x5f\x5f\xcf\x8b\x8a\x3d\xf1\x9c\xc0\x9e\x66\xae\xad\x8c\xd6\x0e\x38\x4f\xd3\x72\x6d\xb1\x5c\xae\xfc\x11\x85\x6b\xf3\x12\x33\x4f\x8b\x15\x0d\xb7\x08\x3e\x1d\xdb\xfa\xf3\x9e\xcb\x1e\xff\x86\xae\xd1\xa5\xb9\x29\xd1\xd4\x9a\x1d\xfd\x2f\x97\x5c\xf3\x7e\x47\x51\x88\x23\x40\xca\xbc\xe4\xac\x4d\xcc\x97\xe6\x63\xfe\x5a\x08\x48\x6e\xbe\xa7\x68\x85\x25\x98\x17\xb4\x97\x48\xaa\x52\x7f\x77\xb1\x63\xed\x36\xa9\x8f\x7f\x64\x58\x6c\xfd\x27\xc1\x45\x70\x6e\x6e\x2d\x6c\xdd\xef\xd8\x55\x2b\xe5\x69\x8a\xc5\xe1\xf4\xf5\x7b\x2d\x0f\xaa\xd2\xbe\xfc\xf1\xc0\x6a\x7e\xfe\x9a\x73\x20\x15\x62\x11\xa2\x9c\xe1\xc3\xea\xba\x6b\x2b\xdb\x6d\x75\x52\xc7\x88\x45\x54\x7b\xa7\x0c\x44\xc6\xf4\x8c\xf7\xd0\x9a\xce\xe6\x92\x0b\xf3\xba\xaa\x7f\x54\xb5\x20\x23\xc1\x0d\x89\x56\x58\x1d\x51\x87\x2c\x05\x4a\xb0\x3b\xe8\xcc\xc5\x31\x55\x75\x26\xb1\xeb\x5d\x87\x54\xda\x77\x27\x66\x4f\x3f\xd8\x51\xb5\xcc\x98\x01\x0a\x60\xdf\x0d\xfb\xc5\xbc\xe2\x3e\xb4\x6f\xba\x9f\x9a\x57\x55\x17\x28\x5c\x8f\xe0\x36\x8f\x1c\x45\x05\xce\x5e\x09\xc1\xc5\x50\xe7\x39\x5d\x9e\x47\x1a\xdb\xa3\xef\x3d\xac\x4b\xbd\x0f\xa6\x3c\x30\x5f\x4e\xc1\x7b\xc7\xa5\xd2\xb9\x57\x71\xf8\x99\xfc\x4c\xbc\x91\xab\x76\x97\xb3\x7f\x14\xa0\x8f\x68\x33\x2c\xd9\x65\x82\x4e\xc0\x1b\x5b\x89\xbc\xd3\xe2\x79\x82\x55\xcc\xa3\x09\x78\xef\xde\x5e\x5e\x55\x9e\xeb\xe6\x26\xf0\xb7\xcb\xb7\xbf\x06\x52\x09\xc2\x56\x64\xb9\x75\xfa\x8c\x4a\x2a\xb7\x3e\x79\xb5\x4d\xf1\x04\xbc\xca\xf5\x79\x63\x73\x2f\x5f\x49\x68\x04\x9f\x14\x5a\x0f\x37\xb1\x38\x05\x6d\xf8\x4c\x9e\xea\xc2\xaa\xe6\x00\x4a\x6c\x6b\xdf\x01\xae\x91\xd0\x64\xbf\x6b\xb6\x30\x83\x4d\x2c\x02\x81\x65\xca\x99\xc4\x46\x46\xc3\xbf\xbe\x5c\xd2\x34\x60\x5e\xbd\xcb\x7c\xf5\x9a\x79\x77\xc1\xe3\xc7\xc5\xe7\x21\xc3\x37\x60\x7b\x2a\x67\x34\xaa\x55\xbb\x83\x10\xa9\x30\x86\xa1\x69\x6f\xd4\x90\xbf\x43\x98\x4f\x94\x43\x1b\xab\xd6\x6e\xf1\xf9\xae\x34\xb7\xcc\xc2\x10\x4b\x59\x31\xb8\xee\xcf\xd2\xe2\x9b\xb8\x21\x21\x59\xc2\xd0\xe3\x6b\x0f\x1e\xcc\x4c\xd7\x07\x96\xb2\xa9\xc6\x78\x0c\x71\xac\x52\xb0\x2f\x20\xc8\x63\xac\x56\xfa\xef\xa8\xa1\xa6\xc0\x2a\x13\x8d\x81\xd0\xad\x61\x69\x47\xa7\xa0\x1b\x17\xef\x07\xee\xfd\x9c\xc1\x87\xfd\x56\xcd\x1b\x5d\x0a\x2c\xe3\x77\x68\x85\x87\x35\x82\x6e\x35\x32\x4a\x4f\xa1\x21\x59\x2e\xd7\xdd\xc8\x9d\x94\xb8\x73\xb8\x40\x3b\xab\x6e\xf9\x87\x94\xc0\xac\xec\x82\x11\xdc\xba\xf1\xe9\x0a\x83\x54\x70\xc5\xd5\x36\xc5\xc1\x12\x6b\xdf\xa9\x10\xeb\xe1\xfb\x76\xf1\xb1\x1e\x3b\x6c\x7b\x6e\x6c\x3b\x0a\x27\x50\x2f\x67\x67\xd6\x43\x05\xe1\xec\xd2\x9a\xf6\xd0\x0a\xd5\x80\x57\xad\xd3\x1b\xfb\xee\x4a\x23\xa1\x34\x85\x59\xa1\xd7\x0f\x29\x99\x80\x76\x18\xd7\xc8\x70\x54\x58\xd5\x56\x71\x49\xce\xbd\x3d\x5c\xa9\x69\xdf\xc3\x73\xef\x1e\x4d\x40\x89\x0c\xe7\x63\xc1\x8b\xf0\x22\x5b\x79\x13\x30\xb7\x94\x15\x4f\x19\xbe\xc1\x52\xbd\x65\x57\x3c\x6d\x95\xa5\x82\xaf\x04\x96\xf2\x47\x24\xda\x65\x5c\x12\xdd\xfa\x0b\x0d\xc4\xbc\x09\x78\x46\x26\xb7\x19\xea\xde\x7a\xf5\x2a\x9c\xf0\x35\x66\xea\x65\x66\xe3\x22\x96\x2d\x7e\x9c\x85\x1a\xb1\x7b\x13\x30\x2e\x96\x3f\x96\x31\xbf\x79\x99\x09\xbb\xef\x3d\x01\xef\xc9\xd9\x59\xc9\x35\x26\x11\xae\x16\x9e\x9f\x55\x4b\x75\x36\x7f\x9b\x29\x5d\xf0\xb4\x56\x80\x37\x0a\xb3\x08\x47\x57\x25\x41\xbd\xa6\x6e\xf4\x15\x92\x84\x69\x73\x79\x52\x4f\xee\xea\x8d\x96\x85\x94\x30\x8c\x44\xbd\xea\x1b\x93\x49\x74\xe9\x12\x45\xf8\x35\xab\xd7\xad\x97\xea\xf6\x4f\xca\x31\xa4\x7d\x41\x43\x9f\x97\xfc\x86\xbd\xa2\x30\x2b\x70\x61\x60\x12\xf9\x25\xa6\x38\x54\x5c\x0c\xbd\xa0\xb8\x15\xc6\xb9\xbd\xc9\x0b\x26\x63\xc3\xcc\xf8\xce\x1b\x93\xc5\x5f\x99\x47\xc3\x41\x90\xdf\xab\x31\x38\x2d\x9c\xc5\xd8\x41\x6a\x0f\x9a\x34\xa2\x9b\xfe\x97\x37\x30\x71\xdc\xde\xb8\xef\xc3\x22\x61\x0f\x93\xa8\x19\x16\xab\x95\xad\x0e\x81\xc2\x1b\x95\xcf\x62\x67\x90\x44\xed\x18\x74\x37\x6a\x3c\x2a\xe2\xb7\xe2\x9c\x2e\x90\xa8\x8a\x27\x30\x45\x8a\x5c\x9b\x89\xb1\x99\x18\x4c\x7a\x6d\xf4\xb0\x75\xa7\x4b\x25\x61\x23\x3d\x6b\x7f\x93\x51\x45\xde\x21\x81\x56\x02\xa5\xb1\xad\x49\x38\xab\x0f\x1c\x00\x77\x27\xc4\x04\xde\x0f\x16\x9c\x46\x83\x53\x18\x10\x85\x28\x09\xf5\xa7\x8c\x45\x58\x68\x37\xd0\x5f\x10\x0b\x63\x2e\xf4\xa7\xf8\xc2\xfc\x7c\xa2\x7f\xfe\x91\x71\xa5\x23\x72\x89\x29\xc8\x72\xf9\x8b\x3d\x8b\x5b\x7f\x78\xc5\xd3\x09\xf8\xe7\x95\xa7\xe6\x0c\x98\x1d\xd0\x66\xa8\x4d\x60\x50\xc7\x67\x56\x38\x7b\x56\x6c\x50\xd6\xa3\xe8\xa0\x6a\x9a\xac\x52\xcb\xa2\x6d\x11\x91\x3f\x71\x61\x8d\x4b\x85\x84\x6a\x0c\x57\x43\xa9\x48\xd8\xb4\x14\xca\x14\xff\x85\xb0\x75\xf3\xf9\xf8\x2f\x90\x07\xab\x9b\x98\x84\x31\x70\x46\xb7\x3a\xea\xd1\x2d\xdc\xc4\x98\x39\x76\x40\xa4\xa9\x08\x7f\x19\x57\x3a\x8a\xac\xd8\x04\x06\x36\xa4\xd4\x64\x25\xe1\x7a\xdb\x12\x2c\x33\x7b\xcc\x6f\xd9\xab\x24\x55\xdb\x4a\x97\x36\xc8\x2a\xf7\xd3\xd5\xbd\x7f\xfc\x17\x30\x97\x8f\xb9\x4b\x22\x81\xb0\x90\x66\x11\xb6\xd7\x52\xb9\x1b\xe6\x0b\x65\x96\x5c\x54\x39\x9d\xd6\x1d\x99\x2c\x8b\xdb\x5e\x88\x84\x14\x49\x89\x23\x7b\x8f\x16\xd1\x76\x40\x0a\x88\x82\x4c\x3f\xac\xe8\x0b\xa0\x47\xcc\x04\x06\x1a\x4b\xda\x9b\xbf\xf4\x03\x40\x2c\x1a\x73\x01\x91\x40\x2b\x1f\xb1\xc8\x6f\xee\x7f\x0f\xaa\x8d\xeb\x40\xf3\x96\xbd\xd0\x11\xd5\x76\x45\x3b\x57\xdb\xa8\x93\x67\xec\x62\x50\x57\xe0\x00\x0c\xab\xc3\x5b\x07\x18\x3d\x2b\xfd\x7d\x5b\x0d\x4b\x2b\xac\x5e\x51\x6c\x8e\x5d\xfd\xb8\xbd\x42\xab\x5f\x51\x82\x87\x9e\x79\x2f\x63\xf4\xfe\xec\x43\x20\x43\xc1\x29\xbd\xe2\x95\x1d\xbc\x1b\xc2\x22\x7e\x13\x50\x6e\x01\x72\xa0\x27\xe9\x30\xeb\x7c\x1c\xc8\x94\x12\x35\x1c\x7c\x3f\xd0\xcc\xe0\x2b\x18\x7c\x6f\x45\x98\x0d\xe0\x2b\x27\xcd\xf3\x4a\xf8\x7c\x34\xcc\x05\x1b\x05\x02\xa3\x68\x3b\xac\x66\xee\x42\x82\x47\xd5\x78\x38\x72\x97\xf7\xbf\x36\xf3\x9c\x61\x3d\x9e\xd9\xc1\x32\x71\xbf\x4b\x23\xe6\x66\xfb\x2f\x0d\x17\xbb\x45\x27\x2c\xc2\x9b\xb7\xcb\xe1\xc0\x8a\x39\x18\x69\x44\xe9\x9f\x43\x33\x64\xda\x90\x6f\x41\xcf\x3e\x1b\x9c\x7f\x70\xdf\xbc\xc7\xce\xbc\xf6\xdb\xac\x09\xed\x8e\xef\x1f\x1d\x9b\xb5\x14\xef\xcf\x3f\xb4\x81\xdd\xa3\xa1\xf7\xb0\xba\xe1\x3d\x0a\xb4\x83\x95\x70\x71\x3c\x76\x16\xd2\x20\x48\xcf\x06\x17\x78\x58\xdc\xe4\xf4\x33\xde\xea\xbc\xf1\xca\x22\x82\xd2\xd3\x86\x06\x12\x9c\x02\xb6\xf2\x95\x76\x19\x8f\xf3\xd1\x63\x49\x82\x35\xde\xbe\xe0\x11\x86\xd9\x0c\xce\x9f\xb4\xe8\x4c\x3f\x19\xba\x1c\x65\xd8\x41\x3a\x1c\xb5\xe9\xb4\xb1\x99\x61\x55\xcb\x8f\x81\xcc\xe3\x84\x36\x58\x3d\xf2\x0d\x9d\x66\x6e\xc8\x07\xfc\x86\x61\xf1\x32\x77\xb3\x76\x13\x35\xbe\x99\x22\x34\xb0\x33\xe8\xbf\x5e\xbd\xf9\xe5\x05\x4f\x12\xc4\xa2\x9d\x2c\x4f\xc1\x9b\x2e\xc4\xdc\x6b\xb0\xbe\x2b\xbf\x16\xf9\xf2\xd1\x70\xf0\xbe\x76\x79\x91\xce\x97\x8a\xa4\xde\x87\xc1\x28\x70\x9f\x87\xb7\x11\xa6\x68\x3b\x81\x5b\x0b\x4e\xbc\x09\x3c\x3d\x3b\x3b\xb5\x58\xc4\x9b\xc0\xf9\xd9\x19\xdc\x55\x38\x7a\x24\x59\x79\xa3\x00\x45\x91\xc9\x1b\xc3\xf2\x10\x5c\xe9\x63\xda\x1d\x8a\x0b\x4a\x47\x81\x01\x6e\xe5\x48\x33\x7d\x50\xf5\xf2\xee\xce\x29\xbd\xec\x91\x36\xfa\x10\xf2\xeb\x4f\x4b\x34\x10\x44\x9c\xe1\x92\x31\xe4\x13\x5d\x68\x4f\xd9\xf2\x22\x37\x5d\xd3\xae\x32\xe0\xeb\x41\xc7\xb4\xed\xea\xed\xcb\xb7\xdd\xb3\xc6\x42\xa4\xaa\x39\x1e\x36\xee\xb8\xd9\xaf\xee\x78\x0c\x97\x8a\xa7\x3a\x37\x24\xb0\x14\x3c\x71\x4b\x21\x66\x2a\xc6\xb8\x48\x10\xa5\xdb\x83\x8d\x43\xf3\xf9\x44\x55\x11\xcf\x1d\xea\xf1\x26\x46\xc4\xfa\x4d\x87\xa3\xe0\x1a\xd1\xe1\xa8\x9a\x0e\x3c\x83\x0e\x7e\x27\x51\xad\x42\x7e\x5d\x61\x57\x05\x73\xbc\xfc\x77\xc5\x6b\x15\xf2\x5b\x11\xbb\x2a\x18\xd2\xf7\x9e\xc4\x74\xe9\x7d\xa8\x95\xe4\x97\xd9\x58\x4e\xf5\xfb\x0b\x4d\x0c\x32\x31\xe5\x0a\x6f\xd4\x69\x2b\xf0\xd8\xce\x7d\x54\x62\xb9\xe2\x72\xa4\x51\x40\xe4\xd0\x9b\xb8\xfb\x90\xbc\x91\xee\x71\x9d\xe8\xea\x3d\x6e\xe5\x7e\xaf\xa5\xfb\x00\x33\x78\xef\x36\x06\xbc\x8e\x18\xd7\xd1\x54\x7e\xfb\xd4\x27\xb6\xe5\xb6\x81\x76\x37\x56\xdc\x01\xe9\x8c\xaa\xb3\x84\xe7\xed\xe6\xdc\x55\xaf\xb3\x91\x90\x33\xc9\x29\x0e\x28\x5f\xe5\x4b\x56\xcf\x2b\x4b\x23\xad\x05\xba\xea\xa0\x6c\xe0\x67\x6f\x14\x98\x5d\xb6\xe1\x40\x07\x8e\x41\x65\xac\x54\xa2\x42\x50\x1e\xfe\xea\x19\x27\x87\x47\x05\x1d\xaa\x2d\x2f\x98\x41\x2a\x78\x92\xaa\xa1\x67\x92\x08\xa0\xbc\x40\x71\xad\x22\xc3\xa1\x02\xc5\x3d\x1d\xd4\xdc\x45\x7f\xe6\xef\x2b\xb8\x7b\x61\xab\xb9\x51\x5b\xdd\xd5\x7d\x30\x33\x33\xcd\xba\xa5\xf5\xd8\xc5\xcc\x5e\x8a\xaa\x23\x2b\x64\x7a\xa2\x67\x8e\xd3\x55\xa8\xda\xe9\x50\x47\xd6\xda\xea\x89\x41\x4b\x6e\xf9\x65\x06\x8f\x02\xfd\x59\x87\x38\x5d\xcd\x3b\x6d\x2e\x27\xde\x82\x87\xa2\x48\x4f\xb5\xbd\x49\xae\xdb\xdd\x08\xaa\x0c\xc7\x63\x78\x97\xd9\x6d\x03\x81\x65\x46\x95\x04\xc2\x00\x41\x44\xae\x4b\x22\xd7\x62\x33\x6e\x1a\x4d\x1a\xa1\x70\x5f\x4a\x6f\xf9\x4f\x6b\x35\xea\xa0\x25\x28\x73\xe2\x53\x1b\xc1\xe6\x8a\x5a\xfd\xbe\x95\xa7\xbb\xce\xa5\xa5\x8a\x97\xb9\xa3\x7e\x9f\xed\x62\xfb\xfb\x71\x77\x37\xee\xea\x45\xcf\xd3\x3d\x58\xed\xc2\x23\x3a\xe7\xe0\x1e\xfa\x42\x5d\xd0\x9d\x0a\x83\xf2\xd0\xe9\x3d\x24\xfd\xa1\xf7\xb0\xbc\x95\xad\x27\xb8\xec\x08\x43\x15\x9a\xaa\x97\x3e\x1a\xea\xf9\xd5\x28\xd0\x66\x18\x0e\xcc\xa9\xd8\xc1\x68\x07\x3f\x7b\x13\xda\xc8\xac\x52\x1c\x52\xb9\x98\x37\x78\xa3\x60\xc9\xc3\x4c\x76\xcf\x30\x34\xad\xe5\x65\x91\x65\xb7\x71\x77\x88\xee\x95\xc7\xb8\xbd\x51\x23\x80\xed\x20\xac\x89\x90\x43\x72\x9c\xaf\xbf\x98\x84\xe3\xc1\x57\xb0\x83\x43\x10\xab\x84\x0e\xeb\x2b\xd3\xe3\x71\x53\x75\x43\x74\x04\xb7\x52\x79\xfb\x62\xe8\x6e\x31\x3d\x6f\x4f\xf3\xa6\xb7\xdc\x61\x67\x33\x43\x36\xe7\x9d\x3b\xc2\x46\x97\xc1\x2a\xc7\xc9\x0f\xb1\x58\x7f\xd5\x83\x4c\x74\x48\xf5\x5d\xd2\xba\xf7\xfc\x9a\x92\xf6\x81\xb2\xbe\xca\xcf\x77\xf5\x40\x1f\x33\xef\x50\x93\xba\x17\x15\xfb\x85\xac\x43\xcd\xbe\xca\x07\x0a\x59\x67\x76\xb0\x90\xb6\xf2\x2e\x53\xf6\x58\xb1\x52\xf1\x79\xa3\x5e\xc7\xad\xaf\x5d\x61\xba\x0b\x55\x1e\x48\x5d\xbd\x71\xb4\x4d\xbc\xd3\x4e\xdd\xfd\xb8\x43\xf0\x16\x8a\xd9\x29\xf8\x1e\xea\xba\xe0\x4d\xe2\xbb\x93\xb6\xbc\xf5\x09\x4c\xbd\x0f\xf2\x09\xcf\xa8\x0f\x78\xb6\x4e\x83\x7d\x7e\x8a\xea\x0f\xce\xe6\x95\xa0\x66\x5a\xa9\xdf\x61\xd9\x95\x52\xf6\xd4\x2b\xaf\xa9\xec\xac\x5c\x9e\x69\xea\xe5\x90\x5f\x48\xd8\x19\x7d\xba\x22\x4f\xad\xb6\xbd\xfc\x73\x14\x20\xa5\xc4\xd0\x93\x42\x03\xd5\x3a\x0b\xfb\x2e\x4d\x27\x83\xda\x05\x97\x9d\xf2\xe7\xd3\xa1\xee\xea\xf5\xdb\x2c\xbb\x19\xe4\x34\xbb\x58\x14\x57\x67\xee\x60\x61\xe6\xf1\x7b\xb1\x48\x83\xa0\x76\x61\x60\x6e\xa4\xfa\x59\xbe\x96\xbd\xfa\xbb\xac\x7a\x47\xe0\x67\x33\xab\x5d\xab\x98\x73\x8b\x05\x5e\xea\x29\xd1\xf8\xfb\x4c\x62\x31\x6b\x27\xea\x1d\xfe\x54\xbb\xe3\xf1\xb3\xc5\xdb\xc1\xce\x0c\x88\x26\xa7\xee\x51\xd2\xcf\xa4\x88\xcf\xf7\x22\x92\xbd\x2f\xf7\x14\x3c\x77\xb5\xa5\x9e\x64\xb6\x8d\x77\xac\x8c\x79\xfc\x3a\x85\xea\x7b\x58\xdd\xc1\xac\xe9\x6a\x5f\x2e\x8e\x75\x1b\x68\xdf\x92\x53\xfe\xaa\x9e\x15\xd3\xeb\x5a\x06\xca\x97\x59\x7a\x17\x82\x7a\xa4\xa8\xac\x00\x1d\xb3\x50\xb1\x77\x22\x51\xb7\x6f\x7d\xf0\xfd\xbb\x9a\xd7\x48\xf9\xff\x9f\x75\x83\xca\xf5\x0f\x5f\xd2\xb8\x0d\xc4\x78\xa8\x55\xb5\x74\x9f\x6f\x54\xdd\xf8\xd1\xd6\xac\x9a\x69\xf0\xb0\xfc\x9b\x57\x83\x51\xb0\xc6\x5b\xf3\x07\x75\x2a\x9b\xeb\xb5\xd5\x45\xb3\x17\x12\xd8\xad\xd3\xc6\x2e\x48\xdb\x4a\x5e\x85\x77\xbe\x3c\x58\xdd\x62\x6f\xee\xbc\x79\xe3\xef\x6d\x85\x99\xf7\x15\x66\x21\x8f\xf0\xdf\x7f\x7b\xfd\x82\x27\x29\x67\xf9\xa4\xb1\x83\x61\xf7\xf1\x1f\xfb\xe9\x1a\x09\x10\x58\x2a\x41\x42\x65\x16\x7a\xab\xe7\x61\x5a\x3e\x60\x37\x1d\x26\x8c\xab\x61\x50\xec\x36\x8c\xf6\x6d\x42\xe4\xad\xe6\x7b\x29\x6c\xd8\x7b\x56\xa2\x9c\x95\x9d\xc2\x20\x37\xf5\xe0\xb4\x2e\x62\x75\x33\x4b\xf7\x4f\xd7\xdf\x04\x1b\x8c\x02\x77\x68\x63\xe8\x2d\x51\x75\xca\xd9\xac\xe1\x28\x5f\xb3\xa1\x27\x75\x90\xcc\x0f\x27\x99\xdf\x95\xf3\x97\xe3\x05\x8f\xb6\xf6\x6f\x97\x99\xbf\xc6\xff\xff\x02\x00\x00\xff\xff\xd6\x91\x4e\x2b\x9e\x7f\x00\x00")
+var _templatesIndexTmpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xec\xbd\xff\x72\x1b\xb7\xb2\x20\xfc\xf7\xd5\x53\x74\xc6\xfe\x22\xea\x44\x9c\x91\xec\x38\x4e\x68\x92\xa9\xc4\x76\xee\xf5\x49\x1c\xbb\x22\xa5\xee\xb7\xe5\x72\xa5\xc0\x19\x90\x84\x35\x03\x4c\x00\x8c\x24\x1e\x5d\x55\xed\x6b\xec\xeb\xed\x93\x6c\x35\x80\x99\xc1\xfc\xa2\x48\x5b\x3e\x7b\xb2\x75\x65\x97\xc4\x01\xba\x1b\xdd\x8d\x46\xa3\xd1\xc0\x80\xd3\x2f\x12\x11\xeb\x4d\x4e\x61\xad\xb3\x74\x7e\x30\xc5\x3f\x90\x12\xbe\x9a\x05\x94\x07\xf3\x83\x83\xe9\x9a\x92\x64\x7e\x00\x30\xcd\xa8\x26\x10\xaf\x89\x54\x54\xcf\x82\x42\x2f\xc7\xdf\x06\x75\x05\x27\x19\x9d\x05\xb1\x92\xcb\xb1\x16\x17\x94\x07\x10\x0b\xae\x29\xd7\xb3\xe0\xe6\x06\xc2\xe7\x67\xbf\xfd\x74\x8e\xe5\x70\x7b\xdb\xc1\xba\x64\xf4\x2a\x17\x52\x7b\x38\x57\x2c\xd1\xeb\x59\x42\x2f\x59\x4c\xc7\xe6\xe1\x18\x18\x67\x9a\x91\x74\xac\x62\x92\xd2\xd9\xe9\x31\xa8\xb5\x64\xfc\x62\xac\xc5\x78\xc9\xf4\x8c\x8b\x0e\xe1\x84\xaa\x58\xb2\x5c\x33\xe1\xf3\xd3\x01\x23\x85\x5e\x0b\xd9\x81\x48\x19\xbf\x00\x49\xd3\x59\xc0\x62\x24\xb0\x96\x74\x39\x0b\xc2\x30\xaa\xfe\x2f\xc9\x25\x56\x85\x2c\x76\x6d\x6b\xa6\x53\x3a\xff\x99\x5d\xb0\x69\x64\x3f\x63\x53\x5f\x8c\xc7\xf0\xa3\x10\x5a\x69\x49\x72\x88\x85\xa4\xf0\xfc\xec\x0c\xc6\xe3\xba\x19\x4b\x3b\x52\x9a\x68\x16\x47\x8b\x12\x38\xcc\x18\x0f\x63\xa5\x02\xcb\x87\xd2\x9b\x94\xaa\x35\xa5\x3a\xa8\x08\x3f\x2f\x94\x16\x19\xd8\x2a\x58\x0a\x09\x7a\xcd\x14\x68\x9a\xe5\x29\xd1\x74\x5b\x2b\xa9\x58\x6d\x21\x6e\x55\x07\x09\x5d\x52\x09\x4a\xc6\x35\x22\x49\xd3\xf0\x83\x0a\xe6\xd3\xc8\xc2\x0c\x35\xa0\x05\x51\x5a\xf6\x37\x01\x51\x8d\xd5\xa9\x6b\x92\xc9\x68\xc2\x8a\x6c\x4c\x13\xa6\x85\xac\x35\x82\x66\x3b\x0b\x34\xbd\xd6\x91\x69\x01\xc1\xc8\x2c\x50\xb1\xa4\xc6\xfc\xda\x96\xba\x53\x5b\x09\x5d\x92\x22\xd5\xa6\x85\xf9\xc1\xfe\xec\x8d\x19\x57\x54\xea\x71\x9e\x16\x2b\xc6\x2b\x66\xe7\x07\x77\xf7\xb4\x21\x33\xac\x2c\x9f\x19\x92\xe7\x29\x1d\x6b\x51\xc4\xeb\x31\x1a\x60\x00\x8a\xfd\x83\xaa\x59\xf0\xe4\xe9\xf5\x93\xa7\x6d\x06\x2d\x34\xc2\x8d\x4d\x7d\x98\xf3\x55\x30\xdf\x89\xde\x37\x27\xd7\xdf\x9c\x6c\xa1\x67\xea\xf7\xa0\xf7\xf4\xd1\xf5\xd3\x47\x5b\xe8\x99\xfa\x7d\xe8\x7d\x73\xfd\xf4\x9b\x6d\xf4\xb0\x7e\x0f\x7a\xa7\xa7\x5f\x5f\x9f\x9e\x7e\xbd\x85\xa2\x83\xd8\x87\xe6\xa3\x93\xeb\xd3\x47\xdb\xb4\xe8\x20\xf6\xa1\xf9\xf5\xd7\xd7\xa7\x5f\x6f\xe5\xd3\x42\xec\x43\xf3\xc9\xa3\xeb\xd3\x27\xdb\x7a\xc7\x41\xec\x43\xf3\xdb\x93\xeb\xd3\x6f\xb7\xca\x6e\x21\xba\x34\x2d\x1d\x3b\xc8\x59\x46\x56\x34\x42\x90\x8a\xf0\x77\x8f\xae\x4f\xbf\x7b\x14\x40\x9b\x32\x4f\xa4\x60\x89\xa3\x6d\x81\xf6\xa5\xfd\xf8\xd1\xf5\xe3\x8e\x1a\x9c\xab\x1f\x9b\xca\x7d\x29\x7e\xf7\xcd\xf5\x77\xdf\x0c\x51\x34\x95\xfb\x52\x3c\xfd\xe6\xfa\x74\x90\xa2\xa9\xec\x52\xcc\x08\x67\x4b\xaa\xba\x0e\xcc\x95\x87\x1f\x94\xe0\x88\xe2\x4d\x8d\x99\xc2\xbe\x65\x31\xc1\x39\x74\x7c\xce\x52\xfa\x5c\xa4\x8d\xb9\xf2\x01\x7d\xb4\x38\x8d\x9f\xde\x89\xf7\x0a\xfb\xd0\xc3\xab\x5a\x57\xbd\x06\xeb\xd1\xd2\x6b\x9a\xd1\x71\x3c\xd8\xee\xc1\xc1\x34\xb2\x51\xca\xc1\x74\x21\x92\x8d\x9d\x0b\x67\xc1\x82\xc4\x17\x2b\x29\x0a\x9e\x58\xe4\xc9\x83\xe5\x72\x19\x2f\x93\x67\x01\xb0\x64\x16\x60\xa8\x33\x46\xf8\x2a\xcc\xa1\x72\x7e\x80\x8e\x1a\x60\xca\xc9\x25\xc4\x29\x51\x6a\x16\x70\x72\xb9\x20\x12\xec\x9f\x31\xbd\xce\x09\x4f\xc6\x59\x12\xc0\x70\x3b\x96\xb5\x67\x66\x92\x36\xe4\x12\x56\x91\x43\x01\x08\xe3\x54\x56\xb5\x00\x53\x52\x76\xc9\x5a\x64\x34\x28\x41\xf3\x22\x4d\xc7\x29\x5d\xea\x60\x3e\x65\xd9\xaa\x39\x09\x5f\xb0\x0b\x66\xfa\xb8\x84\xc6\x82\x71\xce\x62\x9c\x97\x49\x83\xb6\x03\x70\x12\x2c\x24\xe1\x49\x50\x32\x6f\x35\xb3\x48\x49\x7c\xf1\xac\x32\x0c\xc3\xc5\x7c\x4a\xb3\xf9\x97\x7c\xa1\xf2\x67\xf6\xf7\x54\x69\x29\xf8\x6a\x7e\x73\x03\x6c\x09\xe1\x19\x95\x97\x54\xfe\x4a\x32\x7a\x7b\x7b\x73\xd3\x7a\xa4\xa9\x32\x7f\x21\xfc\x5d\x51\x19\x22\x14\xe0\x33\xe5\xc9\xed\xed\x34\x72\x94\xa6\x11\xcd\x5a\xdc\x2e\x0a\xad\x05\x6f\xb1\xac\xc5\x6a\x95\x52\x59\x0e\x07\x0b\x13\x40\x42\x34\x71\x75\x46\x90\x94\xe4\x8a\x96\xc5\x44\xae\x70\xf6\x7f\x60\x49\xa8\x97\xd7\x24\xcb\x53\x7a\xf2\x34\x00\x22\x19\x19\x63\x37\x48\x91\x56\x6d\x74\x00\x6c\x47\xd3\x64\x16\x2c\x49\x8a\x64\x4d\x69\x4a\x16\x38\x94\xce\x4d\xa3\x68\x13\x6c\x65\x86\x86\xd7\x99\x00\x53\x56\xf2\xbf\x24\x0a\x96\x64\x8c\xf4\xb1\x5b\x98\x27\x68\x64\xa5\x98\x1f\xd4\x45\x0d\x2b\xb1\xd2\x94\x66\x57\x4b\xc7\x92\x1e\x96\x1b\xad\x17\x69\x4b\x7d\x68\xcc\x99\x1c\x93\x42\x8b\x06\x9f\xff\x36\x4d\x2b\x4e\x39\xb9\x1c\x33\x4d\xb3\x06\x00\x82\x94\xa6\xf9\xe0\x8b\xca\x2e\x33\xca\x0b\x03\x8c\xdc\x8d\x8d\x83\xc1\xe8\x27\x13\x09\x49\x4b\xf5\x63\xf0\x3b\x0b\xfe\x53\x32\x4d\x81\x40\x2e\xd0\xed\x98\x9a\xbc\x90\xb9\x50\x74\x16\xa8\x35\x91\x74\x8c\xf1\x1b\x9a\x77\x4b\x63\x39\xe5\x31\x4b\xc7\x24\x45\xdb\x8f\x58\xd3\x48\xf0\xdf\x34\x4a\x99\xa7\x3b\xfc\xdf\xac\xef\x8a\x06\x89\x14\x79\x22\xae\x78\x4b\xc6\xd6\x18\xb1\x02\x95\xb0\xce\xbe\xa0\x92\xb9\x1c\x24\x6b\xad\xf3\x49\x14\x51\xdb\x07\x61\x2c\x32\xdb\x37\x25\xe2\xc9\xb7\x2d\x03\x2d\x2b\x9c\x25\xad\x89\xca\x45\x5e\xe4\xb3\x40\xcb\x82\x0e\x18\x5d\x57\x31\x52\xa9\x01\x8d\x34\x0d\xa8\x6c\x6d\x8c\x8c\xfb\xdc\xd7\x66\x9c\xd2\x64\xb1\xa9\x21\x4f\x9e\x6e\xd1\x4b\x45\x0e\x89\x74\x95\xe1\x3c\x06\xfe\xee\xe3\x6b\x67\x32\xc1\x3c\x2f\x16\x29\x8b\xfb\x88\x44\x09\xbb\xbc\xd3\x06\x3e\x7b\xb7\xe3\x30\xc0\x6e\xe6\x42\xb3\xa5\x9b\xdf\xd4\x6b\xca\x8b\xcf\xd2\xdb\x0b\x9a\xa6\xb6\xbb\x61\xaa\x72\x52\xf9\xc5\x05\x49\x56\x14\xcc\xef\x71\x2e\x59\x46\xe4\xa6\x87\xad\x1f\xb1\x3e\x28\xfd\xf5\xef\x5c\x52\x92\xfc\xea\x03\x80\x73\xd1\x43\x35\x94\x27\x60\xdc\x75\x4e\xf8\xfd\x19\x5c\x57\x75\x6d\xba\x00\x37\x37\x20\x09\x5f\x51\x08\xdb\x6c\x75\x40\x77\xb6\x2e\xa7\x87\xb7\x42\xe9\x57\x2f\xe0\xf6\x36\xfa\x9e\x25\xb3\x9b\x1b\xaf\xe4\xe6\x06\x70\xe2\x32\x75\x85\xa2\x72\x56\x4e\x60\xbe\x3a\x4a\x85\x72\xa1\x21\xfc\x8d\x12\x2c\xf3\xa6\x46\xa7\x33\x95\x91\x34\x45\xc8\x72\xee\x43\x91\xd8\x12\xe8\x9f\x10\x9e\x63\xc2\x25\x90\x34\x4f\x37\x01\xdc\xde\xe2\x07\x46\x93\x09\x42\x84\xcf\x6d\x96\xc2\x63\xa6\x81\x94\xb2\x0b\x8a\x38\xf8\x37\x81\x8d\x28\xa4\xf1\xad\xbd\xb0\x4b\x91\xa6\xe2\x0a\xa1\xed\x27\x8b\x50\x82\xde\xde\x66\x94\xa3\x52\x6d\x71\x5f\xeb\x65\xef\x97\xa2\xb4\x85\x8e\x3a\x52\x77\x4d\x04\xa0\x6e\xb0\xdb\x75\xbe\x4d\x0f\xf5\xde\xdc\xe9\xf2\x57\x01\x0d\xcb\x29\x19\x73\xe6\xd9\xdb\x2e\x4f\xba\xcd\xfe\x8b\x38\x92\x3b\xe7\x8f\xa7\x9f\xc5\xa3\xfc\x59\x50\x85\xbd\xfe\xcf\x9b\x44\xf6\x18\xa1\xd1\xf7\x6b\xa2\xd6\x9a\xac\x66\x18\xcc\xae\x69\x9a\x7f\x69\xe7\x83\xd9\x69\x30\x27\xea\x02\x08\x94\xfc\xf7\x9a\xda\xce\x0d\xa1\xf2\xd5\x24\x8a\x56\x4c\xaf\x8b\x05\x2a\x3f\x52\xf1\x5a\xa4\xe9\x3f\x22\x6c\x39\x98\x2b\x51\xc8\x98\x42\x2c\x12\xfa\x59\x5b\x8a\x98\x52\x05\x55\x11\xa7\x57\xc1\x5c\x52\xcc\x82\x02\x81\x45\xb1\xda\x67\x06\xbc\xc3\x6e\x77\x31\xd7\x0e\xdf\x11\xbd\x66\xda\x5a\x24\x7e\xc2\x94\x66\x8f\x35\x69\x96\x51\x35\x8e\x99\x8c\x53\x3a\x60\x51\x6d\x16\xa7\x51\x91\xce\x0f\xfa\xed\x6d\x29\x64\x36\x66\x3c\x65\x9c\x42\xb6\x19\x3f\xc2\x5f\x59\x32\x3e\x69\x49\x30\x65\x3c\x2f\x74\x03\xc9\x85\xf3\x90\xc9\xb1\xca\xc6\x8f\xca\x95\x82\x89\x2e\x21\x4f\x49\x4c\xd7\x22\x4d\xa8\x9c\x05\x67\x94\xc8\x78\xed\x9b\x70\x5d\x86\xd2\x2a\x53\x7f\x8e\x88\xf3\x83\x41\xe5\x37\x1e\xbd\x87\x69\xc4\xc9\xe5\xbc\x5c\x91\x9a\x05\xa5\xc9\xd2\x02\x4c\x33\xc2\x38\x48\x81\x61\x31\x7e\x0c\xba\x0b\x42\xa3\x6d\xac\x1b\xbb\x55\xee\x38\x15\x24\x61\x66\x1d\xdf\x56\xd5\x87\x22\x5b\x08\x74\xbf\x90\x52\x92\x54\xac\x4e\x63\xca\x35\x95\xd5\xa3\xd7\x61\x18\x3d\xaa\x9c\x71\x4e\x25\x7e\xcc\x0b\x74\xca\x4b\x32\x7e\x7c\x8d\x8f\xcb\xab\xc6\x02\xa5\xe1\x99\x95\x1c\x0b\x9e\x6e\x82\xf9\x2f\x96\x9d\x30\x0c\x9b\x4e\xb7\xd1\x6a\xa5\x8d\x69\x84\xb2\xcc\x0f\x30\x05\x6d\xa2\xf3\x8f\x51\x41\xb5\x50\x4d\x98\xca\x53\xb2\x99\x70\xc1\x69\xb9\xb2\xee\xac\x44\x4b\x6f\xbf\x83\xa6\x00\xa6\xeb\xd3\xf9\x7f\xd2\x34\x16\x19\x05\x2d\xa0\xb5\x86\x9d\x46\xeb\x53\xec\xbd\x7c\x7e\x8e\xf9\x74\xa6\x80\xc0\xba\x58\x80\x5e\x13\x0d\xe8\x9a\x14\x22\xa9\x0d\x8f\x81\xc4\x52\x28\x05\x7a\x4d\x81\x53\x7d\x25\xe4\x45\x08\xff\x43\x14\x10\x13\x8e\x41\x02\xae\x8d\x40\x70\x9b\x97\x5f\x17\x8b\x63\x58\x14\x1a\xe7\x5c\x04\x00\xdc\xfc\x30\xa8\x71\x21\x25\xce\xbd\x4b\x4a\x93\x10\xce\xd7\x14\x24\x5d\x31\xc1\x81\x29\x4c\xc2\x33\x4e\x13\x20\x6a\x32\x8d\x72\x64\x0b\x07\xd1\x34\x65\xf3\xb7\xc6\x3f\x4e\x60\x8a\xae\x6a\x7e\x73\x13\xfe\x66\x90\x6c\x31\xc6\x6e\xa6\xdc\x0e\x42\x03\x2f\xd9\x25\xd1\xb4\x8b\x60\xcb\xdb\x18\x66\xb0\x1a\x25\x08\x50\x9a\x48\xc3\xb7\x04\x71\xc5\x21\x97\x62\xc9\x52\x5a\x09\x32\x25\x3b\xba\x3c\x49\x53\x4a\x14\x55\x11\xee\x4f\x28\x1d\xcc\xd1\x7d\xa2\xa5\x1b\x35\xd8\x42\x40\x48\x70\x90\xe8\x0b\x43\x38\x77\xda\xd6\xc2\xd3\xe4\x87\x42\x69\xc8\x25\x55\x0a\xbe\x4c\x93\x3f\x0b\xf1\xec\x87\x24\x01\x9b\x8b\xf8\x52\x9a\x02\x20\x3c\x81\x2b\xb3\x10\x75\x20\x95\xe8\x75\x7f\xff\x54\xa4\x69\x25\x7b\x85\x68\xfb\x54\x19\xa8\xd0\x2a\xbe\x69\xe2\x7e\x94\x71\xe0\xb9\x26\xe3\x7d\xd6\x2c\x49\x28\x37\x83\x3a\xa5\x5a\x53\xf9\xd6\x2e\x7d\x03\xb8\x24\x69\x41\x67\x81\xdb\x04\xb9\x03\xeb\x37\x8c\x13\xcf\xc5\x9e\x58\x3f\x31\x89\x41\xed\x9e\x58\xbd\xcd\xe4\x92\x96\x63\x29\x23\xf2\xc2\xc6\x20\x2c\xa9\x9f\x5e\x21\xc5\xd2\xe5\x5a\x9a\x43\xc3\x76\x1a\xe5\x92\x3a\xba\xe8\x17\x17\x74\xc5\xb8\xd9\xba\x29\x32\x30\x09\x04\xb7\x61\xe5\xea\x5f\x37\x8b\xbc\x61\x6d\x81\x97\x24\x71\x39\x11\xec\x61\xc6\x57\x06\x21\x70\x4e\x26\x61\x24\x15\xab\x9e\x70\xc5\x07\xfe\x05\xcb\x1d\x8c\x65\xde\x85\x54\x95\x77\x6b\xb7\x3a\xb6\x74\x2d\xbf\xe3\xb4\xfc\x60\x4b\xc7\xd6\x17\xd2\xa4\x62\x42\xc4\x05\x86\xda\xbe\xe7\xe9\x50\xac\xbc\x5d\x5b\x94\x1f\x6d\x82\x12\x60\x18\x17\x73\x9f\x8d\x74\x22\xfe\x9f\xae\x9f\x34\xa1\xcc\x76\x64\x97\xbe\x95\x7e\x3e\x8d\xd6\x4f\x5a\x04\x5c\x26\xae\x99\x72\x73\x24\xe3\x54\x54\x99\xb6\x84\xa9\x8c\x55\xed\xf8\xca\x9e\x05\xcf\x0d\x5c\x93\x70\xfb\xc7\x4e\x35\x3d\xea\xff\xd2\x44\x17\xcf\x86\xe2\x7b\xff\xa7\xce\xa6\x95\x25\x8d\x41\x3a\xa4\x39\x97\xfd\x05\xe8\x07\x42\xc7\x4d\x16\x69\x57\x80\x69\x3e\x7f\xbd\x81\x25\xd1\x6b\x2a\xff\xf7\xff\xfc\x5f\x18\x0a\x65\x2c\xdd\x98\x7c\x35\x2c\x28\xe3\xab\x16\x02\x74\xbd\x23\xe5\xe1\x15\xbb\x60\x39\xee\x5a\x86\x42\xae\x22\x7c\x8a\xde\xb2\xfc\x8f\xd1\xbf\x4b\x4a\xf4\x1f\x2f\xaf\x73\x1a\x63\xae\x57\x70\x75\x14\xcc\xdf\x32\x29\x59\x8e\xae\xf0\xd8\x78\xb4\x6c\x03\xcf\xd7\x92\x29\xcd\x08\xb7\x0d\xbf\x5d\xb3\x94\xe5\xc7\x90\x6d\x80\xf1\x25\xe1\x1a\xb4\xe0\xab\x02\xa3\xd8\x22\x4d\x20\x23\x17\x14\xc4\x12\x16\x42\xaf\x0d\x82\xc2\xf5\xd4\x9a\xf1\x15\xa4\x82\xaf\xa8\x04\x21\x21\xc3\xcd\x69\x7a\x8d\x29\x7f\xa6\x71\xae\xe3\xf0\x96\xe5\x21\x9c\x89\x63\x78\x05\x31\xc1\x35\x3c\x64\x1b\x45\xd3\x25\x56\x58\x56\x62\x6c\x5d\x0b\x58\x50\x07\xd1\x91\x1e\x69\xb4\x0a\x8d\x3b\x05\xd8\xd6\x5f\x77\x77\xe0\x52\x08\x6d\x4c\xdf\x05\x59\xf5\xbf\xad\xe6\xbb\xd0\x1c\x16\x9a\x8f\x15\x8d\x05\x4f\x4c\xf2\xa4\xcf\x94\xe7\xc6\x7c\x3d\xdb\x2a\xfd\x50\xfd\x53\x2e\xfc\xcf\xc5\xc4\x25\xc9\xcb\x45\xf1\x01\x0c\x99\x15\xb6\x8c\xfb\x05\x39\x54\x9f\xdc\x22\xaf\xb5\xe2\xb3\xed\xaa\xae\xf9\x99\x6c\x4a\x5b\x18\xc6\x97\xa2\x31\xbe\xcf\xc5\x19\x4d\x97\x6e\x80\xb7\x48\x0c\xff\xf3\xe7\x87\x40\x92\x84\x89\xc0\xed\xc3\x08\x73\x90\x42\xf5\xb4\x11\x00\xe6\x96\x63\x81\x2b\x59\x8d\x90\xcb\x65\x30\xc7\xc6\x77\x6a\x75\x1a\x19\x79\x3e\x5a\xca\x9f\x24\xa3\x3c\x51\x9f\x5b\x50\xd7\x4c\xaf\xac\xe0\x2a\x3f\x8f\xbc\x40\x62\xcd\x2e\x9b\xf3\xdc\xb9\xb0\x21\xde\xe7\x96\xda\xb6\xd2\x27\x34\xc4\x6b\x1a\x5f\xd0\x64\x0e\x16\xe6\xe3\x65\xef\x0c\xf4\x1d\x87\x6f\x23\xf3\xa9\x8a\x45\xc6\xf4\xd8\x31\x1e\xcc\xcf\xcc\xf3\x0e\x13\x43\xe3\xd1\x7b\xf0\x3f\xa2\x77\xc1\xc4\xde\x7d\x04\x2a\xa8\xed\xdd\xa2\x94\x0a\xf2\x53\x43\x94\xfb\x88\x4c\x6a\x98\x7b\x89\x41\x5a\xb2\xd9\x0d\x4e\x54\x8f\x81\xc4\x90\xdc\x6d\x18\xbb\x38\x32\x23\xd7\xf6\x1c\xd8\xe4\xc9\xd7\xf9\xf5\xb3\xc0\x6e\x86\x56\x26\x41\x52\xb6\xe2\xe3\x8c\x25\x49\x4a\xcb\x95\x89\xdd\x0c\xb5\x6e\xb9\xc1\x57\xdf\x3f\x1b\x85\x34\x18\x40\x26\x82\xf9\x6b\x22\x2f\x5c\x08\x02\xd3\x85\x2c\xb3\x91\x6f\x8b\xc5\x05\xdd\xb8\x65\x54\xc9\x05\xa6\x1b\xc6\x59\xa1\x69\x02\x36\x6f\x75\x41\x37\x41\x93\xa8\x1d\x2b\x3f\xd3\x4d\x30\x27\x44\x25\xe9\xc5\xf2\x03\x51\xdf\x7d\x58\x7e\xf7\xf8\xc3\x92\x54\x6b\x2f\xdb\xc6\xc1\xc1\x20\xbb\x8e\x67\x03\x66\x7d\x0f\x44\xf0\x93\x49\xf3\xe2\x84\x5e\x7e\xa6\x52\xc1\xac\x2d\x9b\xf3\x55\xcf\x45\x81\x41\xe9\x49\x29\x5c\xd4\x81\x2b\xc9\xed\x08\x49\xa5\x6a\x41\xde\xc5\xff\xae\x72\xfe\xdf\x0b\x4e\xef\x2b\x40\xbd\xe7\x20\xb5\xa9\x7e\xb4\x2b\x97\xb9\xdf\x41\x1c\xfc\xff\x0a\x28\xff\x20\x36\xa0\x2e\x18\x9a\x0b\x06\x71\x89\x64\x97\xe6\xb3\x39\x31\xf1\xc5\x9d\x64\xba\x52\xef\x13\xaf\xed\xdc\x99\x8d\x79\xd0\xdb\x65\x36\xb6\x47\x95\x22\x2b\xfa\xa3\x41\x0f\xe6\xaf\xed\x63\x9f\x9a\xbd\xf8\xbb\xdc\xc0\x5b\xb2\x54\x53\x59\xe2\xee\xcc\x43\x30\xff\xc9\x20\x56\xad\x74\x13\x9e\x7b\x4f\x5d\x76\x7b\xa6\x64\xc5\x8e\xdc\x01\x29\x76\xa1\x2d\x0a\x8d\x19\xd4\x66\x1b\x05\x6f\xb6\xf2\x3b\x5f\x7e\x6a\x3b\x09\x6e\xd0\xb9\x94\xdd\x22\x15\xf1\x45\x49\xfb\x47\x7c\xb8\x07\x01\xfc\x06\x0a\xde\x68\xe2\x77\xbe\xd8\xd2\x08\x4e\xc8\x9f\x61\x01\x50\x4d\xef\xbd\xf6\xde\x78\xf4\x1e\xfc\x8f\xde\x80\x90\xe2\xaa\x1a\x07\x7e\x79\x2c\x52\x4c\x61\x7f\x07\x8b\x54\xac\xc6\x26\x53\x3c\x3f\xe8\x6e\x8b\xe2\x9e\x65\x63\x3b\x14\x57\x40\x36\x9b\x82\x88\x66\x57\xb0\xc1\x6f\xa3\x0d\x22\xfd\x44\x68\xb7\xd2\xcd\xe7\xb0\x58\x8d\xb5\x24\x5c\xe5\x44\x76\x9d\x4b\x03\xa9\xca\xe0\x36\x61\x86\x65\xee\x87\x40\xe9\x4f\xab\x49\x3f\x27\x49\xc2\xf8\xca\x9c\x81\x9a\xc0\x49\x78\x2a\x69\xf6\x0c\xca\x52\xc9\x56\xeb\xba\xb8\x87\x30\x80\x77\x6c\x8a\x65\xab\xa8\xdc\xec\x35\x7b\xba\xa1\x09\x30\xf0\x3c\x7a\x6f\x10\xc1\xb2\xd5\x78\x99\x16\x2c\x69\x86\x13\x5d\xee\xeb\xee\xdd\x2a\xd4\x69\x3f\x83\x66\xc2\xef\xa9\x68\x79\xac\x92\x43\x5c\x08\x10\x4d\x31\x7a\x72\x8e\xd0\xd8\x2d\x3e\xcf\x82\xa6\x78\x2e\x1f\xee\x20\xcc\x11\xc2\x3b\xd4\xe0\xce\xe7\xb8\xc0\xa5\x4d\xaf\x8a\x5c\x6a\x58\xb7\xaf\x2c\x95\x81\x4d\x29\xf7\xe1\xeb\x08\xa4\x05\xcf\xf8\x6a\x18\x1e\xa7\xa0\x1a\xde\x46\x2a\xbd\xd0\xb6\xca\xf0\xd2\xe2\xd3\x76\x57\xbd\x95\xbd\xb3\x02\xdc\x1e\x77\x57\x87\x37\x37\xfd\x85\x6e\x5f\xdb\xc5\x61\xc3\xea\x2a\x03\x9d\x2a\x59\xdc\x99\x34\x5c\x97\xbb\x78\xa8\x2a\xf0\xfe\x61\xec\xd9\x6f\x27\xad\x3d\x9e\x98\x48\xaa\xed\xe0\xe8\x5d\x32\x98\x5d\x9e\x77\x50\x71\xfb\x1b\x8d\x59\xce\x28\xd7\xd8\x51\xf0\xfe\x60\x4b\x9c\x56\x16\xd4\x3f\x2e\x62\xdd\x6d\x54\xf4\x14\xf6\x15\xb9\x6d\x7d\x9a\xcd\x5b\x67\xba\xbc\x31\xe1\xce\x6e\xd8\xae\x7a\xf5\xc2\x1b\xc4\x75\x0c\x6e\x0e\x6a\x58\x88\x17\x44\xd3\xf0\x27\x21\x33\xa2\x21\xf8\x3b\xe1\x05\x91\x1b\x78\x74\x0c\x8f\x4e\x4e\xbe\x81\xc7\x93\x93\xaf\x27\x27\x4f\xde\xbe\xc6\xa3\x12\x30\xaa\x90\xce\x59\x46\x7f\x58\x09\xb8\xbd\x3d\xea\x4e\xf2\xf6\xe4\xe2\x80\x5e\x1a\xbb\x67\xcb\x54\x90\xb2\x3f\xba\xa0\x00\x83\x0b\x94\x3e\x07\x30\x70\xce\xce\xec\x11\xe0\x7e\x54\xaf\xa5\x3a\x58\x3c\x67\xb2\xd1\xc2\x1b\xd7\x6e\x93\xa0\x06\xd9\xed\x70\x9e\x21\x34\x78\x80\x60\x77\x99\x0c\x57\x85\xa2\xde\x9b\x42\x7e\x87\xfa\x02\xbe\xc4\x3d\x34\x9c\xd3\x06\x35\xb1\x44\x59\x58\x52\x13\x71\xc2\xed\x29\x1b\x2a\x78\x9b\x68\x03\x92\x75\xe5\xf2\x59\x69\x18\x28\x1e\xdd\x71\xe1\x48\xb7\xf9\x35\x25\xd2\xb5\xef\x76\x37\x57\xda\x75\xd6\x2f\xec\x82\x2a\x38\x85\xdb\xdb\xeb\xaa\x03\x6d\x59\xe3\xb4\x4e\x87\xe9\x3d\x03\x75\x33\xfd\xf7\xad\x39\x3c\x18\x8c\x30\xc6\xa6\x37\x9a\x30\xb8\x59\xd4\x81\x19\xe3\x51\xee\xa0\xb3\xc8\x33\x13\xa4\x55\x92\x13\xc6\x1c\xc1\xea\x19\x22\x15\x40\xed\xd2\xdb\xb4\xba\x5e\xa4\xda\x1c\x7e\x2e\xb2\xcc\x39\xb7\x36\xd6\x5a\xce\xc1\x1d\x49\xf6\x22\xab\x2d\x08\x18\x57\x2a\x86\x49\x30\x88\x4b\xa8\x66\x4c\x58\x49\x66\x4c\x2e\x5e\xb3\x34\x91\x98\xad\x09\xed\xb9\x19\x17\xd7\x2c\x05\xd7\x63\x7c\x93\x61\xf2\xed\xc9\xff\xf7\x2c\x68\x13\x70\x2d\x11\x50\x8c\xe3\xd9\xa1\x66\x93\x60\xa2\xbc\xbe\x86\x9b\x9d\xd4\x1f\x6c\x35\x61\x30\x32\xb9\xb9\x09\x5f\xd0\x5c\xaf\x71\x02\xec\x55\x64\x3f\x12\x64\x8c\x17\x0a\x4e\x1f\x41\x8d\xde\x83\xd8\x44\xed\x3d\x8e\xd2\x07\x38\xc4\x7c\x17\x12\xb9\x39\x7d\xd4\x01\xde\x2d\x38\xdd\xad\xd1\xcf\x18\xa0\xf6\x86\xa8\xad\xb0\xac\x2f\x3a\xf5\x62\xd2\x3a\x52\xed\x67\x7e\xd7\x2e\x1d\x88\x50\xb7\xc6\xa8\x2d\xc7\xb7\x73\x94\xba\x43\x80\xda\x52\x42\x5f\x6c\xba\x47\x58\xba\x47\x44\xba\x73\x30\xda\x1b\x87\xee\x13\x82\xf6\x47\x9f\xbe\x6e\x6e\x6e\x3a\xcf\x2e\xe6\xec\x95\xff\xae\xf8\x72\x7b\x84\xb9\x25\xc6\xfc\xa4\x28\xf3\xee\x00\x73\x6b\x88\x39\x1c\x64\x0e\x1a\x77\x6f\x71\x7f\xe1\x3e\xc1\xe6\xd6\x38\x73\xdf\x10\x73\x5b\x74\xd9\x8e\x2f\x5b\x95\xbb\x85\x9a\x1f\x1b\x3e\xfa\xf6\xd6\x17\x39\xde\x4f\xd0\xe8\xe2\xa8\x2d\x92\xb5\x3a\x61\x28\x60\xfc\xd4\x58\xb1\x1d\x26\x7a\xb2\x86\xee\xe8\xcd\x9e\x92\x36\x43\xc8\x81\x80\xf1\x2e\x29\x1d\x77\x9f\x1a\x39\xb6\x82\xc6\x5d\xe2\xc5\xde\xe8\x11\x86\x6d\xb0\x77\x5c\xed\x56\x89\xf3\xaa\x3d\x24\xa3\xc9\x22\x27\x7c\xeb\x4e\x66\x1d\x5a\x66\x8b\xf1\xe3\x2d\x80\xad\xd8\x72\x28\xac\xf4\x7f\x9a\x67\xdf\xb7\x51\xde\x26\x4f\xcf\xa1\x86\x9d\x30\x07\xab\x06\x2a\x86\x8a\xb7\x05\x8d\x66\x92\xea\x89\x55\xfb\x48\x55\xdb\x9d\x4d\x1a\x7d\xf8\x95\x21\x1d\x6c\x65\xb0\x55\xd0\x7e\xc4\xf6\xa2\xb0\x5a\x31\x34\xda\xe9\xb6\x50\xb1\xd7\x4c\x74\x1e\xec\x46\xcf\x7f\xb5\x13\x09\x8c\x73\xb2\x62\xbc\xe7\x25\x3e\xd2\x4e\x16\x77\xf2\xea\x6e\xc8\x06\xf3\x37\x69\x42\x65\x6b\x28\x0d\xe3\x57\x49\x67\x48\x98\xc2\xf3\x45\x89\x47\xea\x57\x7a\xd5\x22\xe5\x8e\x14\xf7\x68\xce\x97\x13\x13\xc5\x9e\x9c\x53\xa2\x58\x42\x5b\x39\xe5\xc7\x90\x99\xdc\x32\xee\xe5\xdb\xf4\x32\x02\x2d\x88\x1f\x17\xfb\x51\xa1\xab\x1d\x67\x22\x29\x8c\x45\xf9\x8f\xe6\xa6\x83\xe6\x02\x70\xe7\x50\xb6\x3f\xb7\xda\x0e\xaa\x17\x42\x6b\x91\x4d\xa0\x1b\x3d\x4f\xd7\x4f\xe6\xd5\x18\x77\xe7\x66\x4d\x74\x19\xcc\xdb\x33\x98\x55\x58\x7b\x07\xb1\x83\xec\xf8\x68\xb4\x02\x9d\x80\xae\x69\xe7\x2d\x2b\x6e\xea\xae\x0a\x55\x9b\x8c\xf7\x6c\x1e\xcf\x7b\x43\x39\x17\xf3\xf4\xc4\x45\xdd\x66\xed\x89\xc3\xb6\xfa\xb4\xc8\x27\x27\xe1\x93\x9e\xa5\x47\x39\xdb\x1c\x3e\xf8\xe2\x70\x68\x8a\x74\x73\xe9\xf3\xb5\xd9\x6e\x30\xba\x6d\xcd\x81\x78\x28\x45\xf0\x31\x51\x8a\xad\x78\xe4\x41\xf8\x13\x74\xa3\x73\xa0\x87\x68\x77\x22\x73\xde\x66\xcb\xbb\x9f\x3d\x53\x6a\x63\x02\xdd\x2e\x92\x0d\x0f\x5c\x7f\x6f\x95\xa9\x09\xd4\x27\x56\x05\xd1\x47\xbb\x47\x34\xcc\x07\x7c\x8c\x50\x77\xc9\xf4\x7b\x9e\xe0\x5d\x2f\x66\x81\xb1\x5d\x26\x1f\x04\x4f\x04\x56\x22\xbd\x90\xa4\xdc\x08\x16\x39\xe0\xf6\x32\x42\x86\x61\xd8\xee\x36\x27\x9e\x6b\xac\x2b\x64\x91\xe3\x59\x6e\x4f\xca\x5a\xa6\x69\x54\xbe\x28\x70\x30\x60\xcd\xc3\xee\xa7\x39\x8e\xd6\x4f\xe6\x3f\x98\xbe\x52\x9d\xa1\x2d\xaa\x33\x54\x29\x53\x7a\x5c\x70\x33\x2e\x9a\xfb\x5d\x78\xda\x8a\xed\x13\x21\xdf\xdb\x8b\xcc\xd6\x76\xe1\x0c\xf3\xab\xa8\x9d\xde\x37\x78\x7a\x39\x23\x49\x62\x4f\xa2\xf7\xb5\x92\x16\xcd\xd7\x70\x7a\xac\xe9\x87\x24\x71\x47\xd9\xf7\x6b\x17\x0f\xdd\x17\x79\x4f\xa3\x58\xd1\x16\xac\x41\xf1\x6c\xc3\xe3\x3a\x04\xf6\xf1\xd1\x4e\x62\xb1\xc2\x3f\xf8\x4a\x0a\xfe\x4d\x57\xee\x1c\xd7\x86\xc7\x67\xf6\x35\x95\xd2\x86\x3a\x9c\xe2\xbc\x37\xc4\xae\x5d\xd1\x2b\x91\x51\xc1\x7b\xcd\x53\x51\x69\xf4\x35\xc8\xb6\xdd\x88\x02\x47\x62\x3f\x65\x99\x5d\xe9\x3b\x1a\x37\xa7\x46\x06\x5b\x37\x9b\xe7\x1f\xd7\x38\x95\x44\xd1\x61\xef\xa3\x25\x51\xeb\xad\x1d\xf6\x12\x09\x94\x03\xbc\x6a\xdb\x0b\x2b\xf0\xff\x34\x12\xe9\xfc\x1e\xc6\x6f\x9d\x62\x31\x0b\x62\x93\x52\x71\xd9\x94\x46\xfa\xe5\xe8\x23\x47\x78\x9d\xd1\xed\xa3\xba\x93\x42\x3b\x89\x2c\xff\x58\x57\x2b\xa5\xb5\x3d\x9b\x55\x87\x40\xce\x77\x38\x32\x2e\xfd\xed\x4d\xfc\x6e\x3b\x73\xb7\x0c\x52\x45\x76\xee\x2b\xbe\x66\xd2\xbf\x37\xa4\xcb\x4a\x29\xe5\x50\x62\x51\xaf\x8b\x6c\xc1\x09\x4b\xed\xd6\xb7\xff\x32\xb3\x0b\x64\xda\xcc\x97\xe1\x4a\x19\x75\xf5\x59\x6f\xdf\x92\xe1\x7e\x6d\x0a\xf7\x9c\x87\x6c\x0a\xeb\xee\xdf\xa6\x2c\xd5\xff\xb6\xa9\xff\x27\x6d\xca\x65\x78\xbb\x06\x55\xa5\x7e\xef\xd1\x9c\x2a\x9a\xff\x6d\x4c\x7b\x1b\x53\x43\x65\x00\x9e\xd0\xff\xaa\xb6\xf5\x5c\x70\x4e\x6d\x1c\x5b\x3b\x2c\x57\x48\x93\x7b\xb1\x2c\x9f\xda\xc7\xd9\xd4\xa7\x59\x52\xcf\x66\x8c\xef\x33\x87\xf7\x61\xfc\xd9\x7a\x68\x0b\xa6\x1e\x82\x01\x7c\x2e\xc3\xc5\x27\xfb\xa2\xa8\xcb\x97\xfe\x73\x4d\xc4\xc4\x83\x34\xa9\xcd\xc3\x24\x24\xca\xd2\xfb\xb0\x90\x16\xc1\x06\x94\x09\x35\x07\x46\x6e\x3d\xa4\x7a\x46\xd2\x3f\x53\x45\xaf\xf8\x42\x5c\x97\xfb\xfd\xf6\x9a\x1b\xe4\xe8\xae\x0b\x73\xe6\xf5\xdd\x37\xf5\x2d\x37\x15\x9f\xf7\x31\xf0\x2e\xa9\x54\xfd\x17\xd7\x34\x06\x5f\xf4\x7d\xec\xc1\xce\xbc\xa4\x75\xed\xb6\x5a\xa2\x55\x17\xaf\x7c\x10\x8c\x5b\x37\xa7\x20\x38\x86\xc0\xbf\x97\x05\x3e\x5e\x05\xd5\x26\x63\x0f\x7d\x4f\x45\x65\x9f\x93\x39\xf4\xb9\x92\xf6\x62\xda\xbe\x06\xed\x36\x92\x2c\x65\x6f\x73\x30\x38\x0e\xea\xa1\xee\xd6\xdd\xee\x4c\xb7\xdb\x9b\xea\x61\x66\xeb\x62\xdc\xb1\x77\xd7\x9a\xdc\x97\xe3\x9f\x6b\xb9\xff\x8e\x2f\xff\x79\xae\xdf\x3d\xdf\xc7\xa8\xae\x48\x6d\xb3\x3b\xf3\x1a\x62\xbf\xc1\x95\x5e\xfe\x13\x3a\xd9\x50\x9f\xd4\xd4\xb7\xf4\x6c\x6b\x4e\xf9\x8b\x76\xe7\x7f\xd8\xdb\x6b\xd4\x27\x77\x5e\x49\xe8\x8e\xee\x2b\x6f\xcb\xb9\xb9\x09\x5b\x9d\xd7\xea\xb8\x4f\x50\x43\x99\xaa\x9b\x46\x66\x4b\xa1\x6f\xef\xc1\x69\xa5\xca\x13\x78\x0a\x74\x60\x52\x5c\xb9\xda\xea\x52\x90\x92\x42\x75\x38\xa7\x04\xb0\xef\xf4\x96\xfa\x32\xdb\x14\x8d\xd7\x46\xa6\xd5\x16\x5b\x6d\x8d\xc1\xfc\x47\x12\x5f\xa0\x2d\x69\x91\x57\xa9\x55\xb7\x1b\x37\x8d\x2c\xfe\xd0\x5d\xdc\x7f\x27\x97\xe4\xcc\x5c\x64\x6d\xe8\xce\xf6\xfe\x29\x39\x47\x81\xde\xe2\x5d\x33\x09\x10\x7c\x79\x9a\x1a\x15\x8b\xa5\xf9\x58\xbe\x6c\x07\x0a\xef\xaf\xa0\x90\x93\x15\x55\x80\xa9\x52\x58\x12\xa5\x6b\xf9\xdd\xbd\xdb\x8d\xcb\x3e\x3f\xfc\x59\x50\xb9\x19\x3f\x0e\x1f\x85\xa7\xe6\x52\xe9\xce\xf5\xdb\xf5\x47\x80\x28\x02\x49\xcd\x45\x49\xe6\x8e\x92\xfa\x0e\x0b\x7c\xa1\x5b\xe3\x1d\xee\x38\x41\x61\x8a\xc9\xbe\xfe\xcd\x29\x4d\x0c\x4f\x78\x4b\x3b\x98\xeb\xdb\x4b\xae\x91\x4b\xa3\x95\x87\x21\xf9\x40\xae\xcf\xa8\x2e\xf2\xd1\x8d\x7d\x3b\x40\x4d\xe0\x26\xf8\xff\xc7\x88\x34\x36\x77\xbb\x07\x13\x78\x38\x3a\xc4\xe3\x7e\xef\x3a\xd7\xc1\xbf\x3f\x3c\x0a\x89\xd6\x72\x14\xb8\x8c\x73\x70\x74\x7b\x7b\x84\xf9\xee\x1e\x31\x9a\xc2\xe7\x22\xcf\xa9\xdc\x26\x76\x13\xbe\x79\x7b\xfa\x4e\x28\xdd\x2b\xc6\x77\x44\x1b\x97\x77\x60\x84\x4a\x13\x9e\x90\x54\x70\xba\x1b\xae\xbb\x1c\xbd\xdb\x56\x2f\xf4\x9a\xf0\x24\xc5\x99\x44\x85\xb2\xe0\x98\x41\xdc\x15\xd3\x99\x8e\x12\x52\xe3\x5e\xe3\x78\x2f\xb4\xb0\x60\xe1\x15\x4b\x56\x54\xef\x81\xc3\x96\x92\x64\xd4\xbd\x38\x22\xe4\x3e\xa8\x18\x99\xdb\xfd\x83\x5d\x90\xee\xba\x79\x7d\xa0\x1f\xf0\x23\xc0\xb2\xe0\x66\xe1\x05\xf6\xc5\xe1\x5f\xcc\xac\x35\xb2\x11\xca\xb1\xb9\xc7\x00\xef\xf5\x3d\x82\x9b\xd2\x01\x56\x08\x82\xbf\x94\x52\xc8\x11\xce\x54\x58\x5f\x3a\x4c\xdb\xa3\xef\x02\x8a\xb5\xc1\x7b\x53\x1f\x9a\x87\x63\x08\xf0\x00\x2b\x8e\x35\x2d\xe0\x67\xf6\x33\x0b\x8e\x1c\x9a\xbb\x37\xa6\x1c\x60\xa3\x9a\x5c\x21\xd3\x09\x04\x91\xe5\x28\x38\xae\xca\x71\xd4\x8a\x64\x02\xc1\xdb\x37\x67\xe7\x5e\x39\x36\x37\x81\xbf\x9f\xbd\xf9\x35\x54\x5a\x32\xbe\x62\xcb\x8d\x93\xe7\xa8\x86\x72\xa3\x0f\xaf\x20\x9c\x40\xe0\x5d\xd2\x1c\x99\xdb\x9f\x6b\x40\xc3\xf8\xa4\x92\x7a\x74\xbd\x96\xc7\x78\x05\x90\x2e\xd4\x31\x50\x29\x7d\xc9\x01\xb4\xdc\x34\x9e\x01\x2e\x89\x44\xb0\x3f\x90\x2c\xcc\xe0\x7a\x2d\x43\x49\x55\x2e\xb8\xa2\x86\x47\x43\xbf\x99\x7e\x6e\x2b\xb0\x44\xef\x53\x5f\x13\xb3\xec\x2e\xf8\xf2\xcb\xea\xf3\x88\xd3\x2b\xb0\x3d\x55\x12\x3a\x6a\xa0\xdd\x42\x4c\x74\xbc\x86\x91\x69\xaf\x29\x4f\x2f\x33\x1f\xc9\x07\x2a\xab\xd1\x6e\xf5\xf9\xb6\x56\xb7\x2a\xe2\x98\x2a\xe5\x29\x1c\xfb\xb3\xd6\xf8\xf5\xba\xa5\x71\xb6\x84\x51\x20\x2e\x02\xf8\x62\x66\xe2\x2a\x74\x40\xba\x50\x6d\x31\xa2\x08\xd6\x6b\x9d\x83\x73\xd9\x77\x73\x5b\x6b\xad\xb6\xdf\xa6\xde\x00\x24\xd5\x85\x6c\x0d\x84\x7e\x09\x6b\x3d\x3a\x01\xdd\xb8\x78\x77\x98\xd9\xf8\xef\xf0\xfd\xdd\x5a\x2d\x1b\x5d\x4a\xaa\xd6\x6f\xc9\x8a\x8e\x1a\x00\xfd\x62\x14\x69\x7a\x0c\x2d\xce\x4a\xbe\x70\xe2\x39\x70\xa3\xef\xa0\x34\x56\x6c\xf9\x87\x9c\xc1\xac\xee\x82\x23\xb8\x71\xe3\xd3\x55\x86\xb9\x14\x5a\xe0\x2b\x85\xe1\x92\xa2\xed\x78\xc0\x38\x7c\xdf\x2c\x3e\x34\x7d\x47\x63\x6c\x3b\x08\xc7\xd0\x20\x65\xa7\xd6\x5d\x19\x11\xfc\xcc\xaa\x76\x57\x04\xdf\xe1\xf9\x38\x83\xbe\xcf\x51\xc2\x11\x4d\xf2\x1c\x66\x95\x5c\x3f\xe4\x6c\x02\x68\x30\xae\x91\xd1\x51\x09\xef\xf4\xea\x26\x39\x77\xb5\x84\x87\x69\x5f\xd2\x76\x6f\x73\x4e\x00\xcf\x8c\x96\x63\x21\x48\xe8\xa2\x58\x05\x13\x30\x57\x20\x57\xa5\x9c\x5e\x51\xa5\xdf\xf0\x73\x91\x77\xea\x72\x29\x56\x78\x07\xd8\x8f\x44\x76\xeb\x84\x62\xd8\xfa\x73\x8c\x27\x83\x09\x04\x86\x27\x77\xb8\xc4\x5d\x89\x50\x79\xd1\x20\x97\xf4\x92\x72\xfd\xa2\xb0\x7e\x91\xaa\x0e\x3d\xc1\x63\xcc\x80\x04\x13\x30\x26\x56\x16\xab\xb5\xb8\x7a\x51\x48\xe3\x4a\xb1\x95\xc7\x27\x27\x35\xd5\x35\x4b\xa8\x5f\x79\x7a\xe2\xd7\xe2\x6c\xfe\xa6\xd0\x58\xf1\xa4\x51\x41\xaf\x35\xc5\xdb\xa0\xcf\x6b\x80\x26\x26\x36\xfa\x92\xe0\x81\x2f\x44\x56\x98\x3f\xab\x2b\xb1\xd1\xba\x12\x5f\xa8\x25\xf5\x34\x62\x50\x5f\x9b\x99\x04\x6b\xf1\x76\x8a\x57\xb5\xf7\x37\xb8\xcd\x5a\x6c\xdf\x75\x6e\x69\x0b\x18\xfa\xbc\x10\x57\xfc\x65\x0a\xb3\x2a\xbc\x0d\xcd\x44\x7e\x46\x53\x1a\x6b\x21\x47\x41\x58\x06\x48\xe5\xa0\x46\x4c\xcc\x0b\x08\x09\x33\x63\x3b\xaf\xcd\x2c\xfe\xd2\x14\x8d\x0e\xc3\xf2\xd2\xa5\xc3\xe3\xca\x58\x8c\x1e\x14\xa6\x51\x26\x55\x59\xfd\xaf\x6c\x60\xe2\xa8\xbd\x76\xcf\xa3\xd2\xb0\x61\x94\x25\x6d\xb7\x58\xfe\xd4\x32\x84\x98\x33\x28\xb3\x82\x33\xc8\x12\xdf\xc5\x94\x6e\xa3\x55\x54\xf9\x6f\x2d\x44\xba\x20\xd2\x67\x4f\xd2\x94\xe0\xad\x2d\xcf\xcb\xf5\xcd\x64\x50\x47\x0f\xdc\xa5\x25\xf5\x85\x5f\xde\x84\x4d\x30\x0b\xfa\xba\x48\x35\x7b\x4b\x24\x59\x49\x92\xaf\xad\x76\x99\xe0\xcd\x81\x03\x78\xaf\xa0\x36\x5a\x7a\x77\xb8\x10\x69\x72\x78\x0c\x87\x4c\x93\x94\xc5\xf8\xa9\xe0\x09\x95\x68\x06\xf8\x40\x78\xbc\x16\x12\x3f\xad\x1f\x99\xdf\x8f\xf1\xf7\x9f\x85\xd0\xf4\xf0\x7d\x4d\x30\x61\xcb\xe5\x2f\xf6\xe5\xdb\x66\xe1\xb9\xc8\x27\x30\x3e\xf5\x4a\xcd\x99\x5a\x3b\xa0\xcd\x50\x9b\xc0\x61\x33\x3e\xb3\xcc\x8d\x0d\xdc\x61\x8d\x97\x92\x9d\xd0\x52\xd2\xc0\xb2\xd1\xb6\x4c\xd8\x3f\x68\xa5\x8d\x33\xbc\x9f\xb0\x35\x5c\xf1\x4b\x1a\x30\xc0\x6c\x6b\x0a\x8f\xc0\xfd\xc2\xf8\x45\xbb\x3c\xfa\x1b\x94\xce\xea\x6a\xcd\xe2\x35\xe0\xc5\x97\x80\x61\xd2\x06\xae\xd6\x94\x3b\x72\x78\x27\x23\x22\xc2\xdf\xa2\x9a\x24\xee\x52\x4c\xe0\xd0\xba\x94\x06\xaf\x2c\xbe\xd8\x74\x18\x2b\xcc\x99\x9d\x37\xfc\x65\x96\x6b\x67\x0c\xa6\x4b\x5b\x60\xde\xe5\xa5\x4d\xeb\x8f\xfe\x06\xe6\x66\x4a\x77\x83\x30\x30\x1e\xa7\x45\x42\x95\x59\xc9\xb9\xef\x31\xaa\x84\xc1\xef\x85\xf2\x28\xd5\xe4\xcb\x58\xa2\xbc\x0a\x8c\x29\xc8\x89\x52\x66\x41\x68\xaf\xbd\xbc\xc2\x35\x23\xd3\x50\x60\xa1\x27\x2f\x80\xa6\xd7\x7a\x02\x87\x18\x4b\xe2\x2d\x90\xd2\x14\xe0\x19\xa2\x48\x48\x48\x24\x59\x8d\xf1\xab\x32\xda\xe7\x89\x3c\xcd\x00\xa0\xa3\x79\xc3\x9f\xa3\x47\xb5\x5d\xd1\x9d\xab\x0f\x1a\x33\x76\x35\xa8\xbd\x70\x00\x46\xfe\xf0\x46\x07\x83\xcb\xd6\x3f\x36\xbe\x5b\x5a\x51\xfd\x32\xa5\xe8\xa1\xd4\x8f\x9b\x73\xb2\xc2\x7c\xd3\x28\x30\xef\xb9\x1d\xbd\x3b\x79\x1f\xaa\x58\x8a\x34\x3d\x17\xde\x89\x88\x2b\xc6\x13\x71\x15\xa6\xc2\x5e\x3e\x1e\x62\xe6\x0b\x66\xbd\xc5\xa1\xca\x53\xa6\x47\x87\xdf\x1f\x22\x31\xf8\x0a\x0e\xbf\xb7\x2c\xcc\x0e\xe1\x2b\xc7\x4d\x39\xeb\xe3\xef\x87\xa3\x92\xb1\xa3\x10\x73\xba\x9b\xca\x59\x35\x44\x79\xe8\xfb\xc3\x23\xf7\x15\x51\xaf\xcc\x37\x4c\x79\x0b\x05\xfc\x8f\x5e\x53\xc8\x89\xfb\x5b\x55\x55\x6a\xfb\x37\x0c\x17\xfb\x59\x67\x3c\xa1\xd7\x6f\x96\xa3\x43\xcb\xe6\xe1\x11\x46\x94\xe3\x53\xf0\x19\x29\xf5\x9a\x99\x80\xf9\x6e\x1d\x9c\xbe\x77\x4f\xc1\x97\x01\x6a\xa4\x7c\x9a\x95\xb3\x40\xf9\xb3\x7f\xff\xc0\xcc\x72\xf1\xee\xf4\xfd\xb3\x8e\xb1\x3c\x1c\x05\x0f\xfc\x03\x44\x47\x21\x1a\x58\x1d\x2e\xba\x44\xba\x9f\x9a\xaf\x53\x60\x51\x64\x86\x8e\x9f\x8b\x47\xfb\xc7\xfe\x01\xc1\x63\x0a\x4c\xe3\x33\xce\x9c\xdc\xa1\x3c\x0c\xf1\x40\xd8\x28\x88\x48\xce\xa2\xcb\xd3\xc8\xc7\x55\x91\x3b\x55\x5e\x53\xbb\xbd\x8d\x90\x5a\xad\x84\x76\x16\xce\xb1\xd0\xb8\xe7\x1c\x88\xa4\x1e\x13\x7a\x4d\x37\xa6\xa8\xc1\xc7\x28\x78\xd0\x40\x32\xdf\x48\x70\x14\x0a\x3e\x0a\x6c\xb8\x72\x0c\xbd\x16\x86\x66\xd1\x41\xb6\xdf\x1b\x70\x64\x66\xc4\x91\x31\x87\x20\x68\x5a\x43\x5b\xee\x06\xba\x95\x71\xa0\xc1\x5e\x6e\x1b\x0d\x06\x4d\x1b\xb9\xf5\x9e\x6e\x0f\xda\x65\xa8\x30\x9a\xa6\x40\x16\xa2\xd0\x26\x00\x40\xc6\x14\xa6\xaf\x04\xde\xce\xa8\xdc\x25\xae\xa8\x32\xc9\x2e\x4b\xf7\x82\x62\x3b\x1b\x7e\x79\x49\xb9\x3e\x33\xd7\x7d\xfb\x7c\xa2\xb5\x9b\x50\x50\xb9\x30\xc5\x83\xab\xe5\xb6\x10\x3e\xc3\xef\x30\xe0\xd4\xb8\x39\xe1\xce\xb1\x06\xef\xc3\xa5\x90\x2f\x49\xbc\xae\xc7\x38\x86\xf9\x4d\xa5\x58\x42\x21\x49\x12\xd3\xce\x2f\x4c\x69\xca\xa9\x34\x90\x9e\x26\x5b\x58\x1e\x9b\x30\xb3\x4b\xff\x9c\x48\x45\x47\x34\xec\xac\xc9\xac\xd0\x06\x36\xc4\x63\x68\xa6\x5b\x7b\x8f\x1e\xb7\xfa\xda\x5f\xc4\xe1\x2d\x3c\xc1\xfb\x51\x60\x1c\x36\x2e\xd7\xd0\x08\x81\x19\x79\x7f\xa5\x57\x10\xc0\x57\xe6\xce\x98\x63\xb8\x71\x71\xf2\xc4\x5f\xb7\xf9\x7d\xe9\xf7\x67\xa7\x9f\xbd\xcf\x43\x9a\x69\x7c\x8d\x44\x30\xac\x23\xd4\x50\x61\x77\xb8\x66\x60\xb4\xf3\x8a\xeb\x3b\x6d\xfe\xbf\xfe\x0b\x82\x93\xa6\x21\x6e\x47\x71\x4d\x7c\x05\xa7\x1e\x52\x2d\x53\x29\x69\x14\x39\x17\x1d\xaa\x62\x81\x99\xa9\x05\x1d\x55\xf7\x8c\xfe\x4c\x37\x18\xb8\xbe\xc4\xf8\xc1\x93\xc8\xf5\xda\x31\x50\x3b\x81\xd5\xf2\x45\x51\xab\x63\x2f\xe8\xe6\x39\xde\x47\x36\x9b\xc1\xe9\xe3\x0e\x5c\xa5\xce\xb0\x5c\xe6\xd8\x28\x61\x74\xd4\x85\x43\xb5\x71\x43\xaa\x11\xa0\x87\xaa\x0c\x54\xd0\x63\x37\x43\xaf\x91\x93\xcc\xc5\x1c\xa1\xb8\xe2\x54\xbe\x28\xe7\xb9\x6e\x13\x0d\xba\x85\x66\x69\x68\x53\x78\xff\x71\xfe\xfa\x17\x7c\x09\x9b\xf0\x64\x2b\xc9\x63\x08\xf0\x85\xc5\xa0\x45\xda\x53\x75\x15\xb0\x3f\x1c\x1d\xbe\x6b\x5c\xad\x89\x01\xbb\x66\xb9\x49\x4b\xbb\xcf\xa3\x9b\x84\xe2\x05\xe3\x70\x63\x17\x56\xc1\x04\x9e\x9c\x9c\x1c\xdb\x85\x54\x30\x81\xd3\x93\x13\xb8\xf5\x28\x06\x2c\x5b\x05\x47\x68\x98\x26\x70\x1d\x05\xf5\x7b\xb8\x55\xb7\xa3\xcd\x54\xd7\xe7\x1f\x85\x66\x44\xd4\x6e\x80\x5e\x36\x7a\x73\xa8\x73\x4a\x62\xe8\x76\x57\x54\x8f\xa0\xbc\x9c\xbf\xe4\x05\x20\x4c\x04\xa7\x35\x61\x28\x33\x6d\xad\x49\x1c\x3d\x40\x59\xe5\xf2\x45\x30\x9b\xc1\xa1\xb8\x38\x6c\x02\x1a\xed\x9d\xbf\x79\xf1\xc6\x2b\xbb\xed\x33\x6b\x4f\x1d\x0f\x5a\x37\x30\xde\x2d\x6e\x14\xc1\x99\x16\x39\x2c\x85\xcc\x60\x29\xf1\x5b\x4c\x0d\x09\x44\x07\x8e\xaf\x52\xa6\xe9\x66\x67\xe5\xa4\x65\x42\xc3\x17\x24\x70\x3b\x89\x66\x6b\x22\x78\xd0\xbc\x87\xfb\x28\xbc\x24\xe9\xc8\x5b\x6b\x01\x04\x66\x79\xf2\x07\x4b\x1a\x08\xee\x1d\xc1\x5e\x04\xf3\xbe\xe0\x1f\x5a\x34\x10\xca\x3b\xbb\xfb\x10\x0c\xe8\xbb\x40\xe1\xbd\xa9\xde\x52\x0b\xa0\xda\x19\xb1\x94\xca\x75\xad\xbd\x5d\xdb\x04\x41\x26\xa8\x39\xa7\xd7\xfa\xf8\xa0\xdb\x2b\xe5\x54\xde\xbe\xba\xf3\x28\x64\x6a\x14\x4c\xdc\x6d\x9d\xc1\x11\xf6\x38\x46\xda\xcd\x1e\xb7\x9a\x79\x17\x68\x11\xbc\x87\x19\xbc\x73\x27\x3d\x82\xf7\xcf\x76\x69\xca\x9d\xc3\xf9\xd8\xb6\xdc\xb9\x9e\xed\x8d\x55\x37\x94\x3b\xa5\xf6\xc5\x25\x2d\xca\x7d\x68\x65\x28\x7a\x1c\x1c\xf5\xb5\x16\x0b\xae\x44\x4a\xc3\x54\xac\xca\xe4\x79\x0d\xd6\xb3\x55\xe0\xd5\x7a\x1a\x31\x2b\xf9\xe0\x28\x34\x87\x1f\x46\x87\xe8\x41\x0e\x2b\xc8\x7a\xf8\x3c\x1c\x05\x61\x7d\xac\x7f\x60\xc0\xdc\xec\x3c\x02\xd0\x67\xbb\x8d\xbe\x19\x9e\xe2\xce\x72\x3d\x0a\xcc\x6c\x02\xa4\xda\x01\x14\xf8\x15\x8c\x78\xe8\x0c\xb4\xc0\x69\xbb\xbc\x8f\x1a\x2f\xdc\x0f\xdd\xd7\x17\xf8\xaa\x41\xf5\x3b\xdc\x2f\x66\x26\xe7\xd5\x54\x39\x0e\x62\xdc\xe6\xc4\xd0\x19\x5d\x2c\x14\x98\x72\x32\xaf\xe5\x79\x50\xdd\xc0\x1c\x5d\xac\xcf\xbc\x09\x65\x72\x97\x08\x9e\x95\x11\x26\x04\x11\xa2\x05\xc7\xed\x8d\x8d\x1b\x08\x48\x92\x60\xd2\x2f\x98\x94\xb2\xdd\x1e\x81\x4f\x30\x8a\xe0\x2d\x5e\x75\x6b\xbe\x49\x41\x15\xa9\x56\xc0\x38\x10\x48\xd8\x65\x0d\xe4\x5a\x6c\x3b\x50\x23\x49\x53\xd0\x3b\x17\x17\x1d\xfb\xe9\xc6\x60\xbb\x24\xc3\xcd\xbb\x3c\xa8\x04\x3b\x69\x34\xf0\xbd\x58\xaa\xd1\xee\x6d\x8f\x2d\xfb\x53\x55\xe8\x5e\xe2\xf8\x64\x13\xbb\xbb\x1f\xb7\x77\xe3\xb6\x5e\x0c\x02\xb8\x3d\x02\xbf\x0b\xf7\xe8\x9c\x9d\x7b\xe8\x33\x75\x41\xdd\x03\x0d\xbd\xd7\x87\x63\x06\x54\x0f\xfb\xe9\xbe\xba\x36\x77\xc8\xb9\x6c\x71\x43\x47\xcf\x7a\xbd\xdc\xc3\x91\x5e\x33\x75\x64\x56\x0c\xa3\x43\x73\x3a\xe7\xf0\x68\x0b\x3d\x7b\x19\xb1\x0b\x7b\x77\x40\xae\x32\x18\xc1\x51\xb8\x14\x71\xa1\x6a\x15\x34\xfb\xb0\xa4\x65\x43\xcc\x7e\xe5\x6e\x61\x3d\xa8\x5f\xd0\x0b\x7c\x16\xd0\x81\x6d\x01\x6c\xb0\xe0\xc2\x4d\x45\xcb\x4c\xb0\x99\xe6\x70\x55\xb3\x85\x42\x88\xdf\x24\x3b\xf2\x9b\x34\xbe\xa7\x25\xba\x01\xda\x83\x5a\x45\xec\xd6\x7e\x7d\xda\x76\x36\x83\xe0\x8e\xe6\x4d\x6f\xb9\xd7\xd8\xaa\xaf\x8d\x0b\x7a\xdc\x46\x9f\xc2\xbc\x17\x05\x77\xd1\xd8\x30\xea\x4e\x2a\xda\x05\x7d\x1b\xb7\xee\x06\x87\x36\xa7\x43\xd1\xd9\x10\xf2\xb3\x6d\x3d\x30\x44\x2c\x08\x76\x64\xd2\x5d\x41\x31\xcc\x64\x33\xe6\x1c\x42\xde\x91\xc9\x26\xb1\x9d\x99\x2c\x8f\xf2\x0d\x73\x39\xa0\x45\x0f\xb1\x6e\xa9\xe1\x4a\xfc\x2f\x27\xe8\x73\xd3\x7d\xe1\xe5\x8e\xd0\xfe\xc5\xf8\x5d\xe0\xad\x7a\xea\xef\xc7\x2d\x8c\x77\xa2\x98\xad\x8c\xdf\x01\xdd\x64\xbc\x0d\x7c\x7b\xd0\xe5\xb7\xb9\x92\x69\xf6\x41\xb9\xf2\xa9\xbb\xa0\x76\xa3\xe8\x1c\xba\xe7\xfc\x3f\x7d\x8a\x1a\x76\xce\xd8\x4a\xc3\x1a\x50\x88\xea\xf6\x6a\xcc\xc6\xf7\x4e\x29\x77\xe0\x55\x69\xab\x7e\xe4\xfa\xb4\xfa\x20\x05\xe7\xb5\xfa\xbd\x4f\x9f\xe7\x69\x60\x9b\xb7\x04\x30\x09\x60\x4e\xb6\x29\x19\x07\xc7\x2d\xdf\x6e\x76\x3d\xfa\x09\x38\xe3\xb0\x77\xa6\xf7\xf2\x5f\xae\x8b\xfa\xd1\xcb\xb7\x28\xb6\x11\x28\x61\xb6\x91\xa8\x6e\x78\xdf\x42\x02\x77\x96\x8f\xee\x8c\x45\x5a\x00\x8d\x1b\xa7\x4b\x25\xb9\xf3\xbd\x65\xc7\x1c\xc3\xae\x5d\x56\xf0\xfb\xa5\xe7\xdf\x27\x7d\x0f\xcc\xdd\x2b\xb9\xc6\xe5\xe4\x25\x35\xdc\x64\xc1\x15\x9b\xfb\x6e\xda\x6e\x1c\x31\x4c\xcf\x05\xb7\xf7\xc5\xde\x16\x72\x68\x14\x1d\x4a\xfd\x83\x78\x98\x48\x35\x7d\xdc\x0b\x4b\x26\x2a\x45\xc5\x79\x47\xce\xbb\xca\xdb\x97\xc7\xd2\xbd\x1e\x83\x7f\x48\xbd\xdf\xd7\x3e\x68\x59\xee\xe7\x73\xb3\xfd\x0a\xba\x2b\x35\x56\xde\x11\x61\xd9\x0c\xfa\xd2\x55\x65\x3a\xa8\x51\x57\xfa\xc7\xc9\x70\x37\xd5\xf0\xb7\xfb\xe4\x51\xee\x5c\xe7\x34\xf5\x5b\xf0\xbf\x88\x86\x0b\xfe\x97\xd5\x71\xd3\xc1\xfd\xab\x2a\xd8\x70\xf9\x97\xb4\xe0\xbf\x86\x7e\x0b\xfe\x17\xd5\x70\xe8\xdd\x9e\xf7\x39\xd5\xcb\x92\x8f\xd2\x2b\x72\xf7\xe9\x4a\xc5\xc6\xf7\xd6\xa6\xaf\xa6\xc3\x07\xf5\xf7\x1f\x1f\x1e\x85\x17\x74\x63\xbe\x5c\xb5\xd2\x14\x8c\x1a\xb9\x7c\x5c\x7c\xd3\xd0\x9e\x94\x6a\xed\x39\x76\xb5\x14\x78\xb4\xdd\x8a\xc5\x4b\x73\x00\x34\x4e\x93\xc0\x0c\x03\x1d\x8b\x30\x0b\xbe\xa2\x3c\x16\x09\xfd\xfd\xb7\x57\xcf\x45\x96\x0b\x5e\x66\x66\x7a\x08\x76\x17\x4c\xb5\x7c\x98\x9b\x94\x14\x93\xc8\xb1\x36\xdb\x2a\xfe\xf1\xd7\x8e\x0d\x3c\x34\x5b\x7a\x13\x2e\xf4\x28\xac\xf6\xf6\x8e\xee\xda\xf2\xbb\x3d\x68\x24\x46\xf0\x20\xf7\xc0\xb1\x3f\x2f\xf5\x71\x0c\x87\xa5\xaa\x0f\x8f\x9b\x2c\x56\x84\x4d\xbe\xe4\xf0\x41\xdf\xf7\x43\x1f\x1e\x85\xee\x8c\xe6\x08\xdf\x71\xf4\xc2\x90\x36\x86\x83\x7c\xc5\x47\x81\x4a\xc5\x55\x09\xd8\x7e\x13\x67\x1a\xe1\xc9\xa8\xf9\xc1\xc1\x34\x5a\xeb\x2c\x9d\x1f\xfc\x9f\x01\x00\xbe\xbe\x5e\x7f\x29\x92\x00\x00")
 
 func templatesIndexTmplBytes() ([]byte, error) {
 	return bindataRead(
@@ -1742,7 +1742,7 @@ func templatesIndexTmpl() (*asset, error) {
 		return nil, err
 	}
 
-	info := bindataFileInfo{name: "templates/index.tmpl", size: 32670, mode: os.FileMode(436), modTime: time.Unix(1517496560, 0)}
+	info := bindataFileInfo{name: "templates/index.tmpl", size: 37417, mode: os.FileMode(436), modTime: time.Unix(1792283977, 0)}
 	a := &asset{bytes: bytes, info: info}
 	return a, nil
 }
@@ -21,6 +21,7 @@ var (
 	RegionPrivate      = "btbsjnjTtgi3aL9z2X8bqb1URVnCo3zqg4fC4co2JEu"
 	GenerateRegion     = false
 	ExposeInternalPort = false
+	Ephemeral          = false
 	ServerName         = ""
 	SyncAddress        = ""
 	// Location defines where to open up the kiki database
@@ -29,6 +30,10 @@ var (
 	keyLocation    = ""
 	searchLocation = ""
 	Alias          = "default"
+	// StampDifficulty and ActionStampDifficulty are the bits of work that the stamps
+	// of envelopes need in the region, or -1 to keep what is in the settings
+	StampDifficulty       = -1
+	ActionStampDifficulty = -1
 )
 
 func main() {
@@ -36,13 +41,14 @@ func main() {
 	homeDir = path.Join(homeDir, ".kiki")
 	if err != nil {
 		panic(err)
-		os.Exit(1)
 	}
 	flag.StringVar(&ServerName, "hub", ServerName, "specify server name and include hub message")
 	flag.StringVar(&PublicPort, "port-external", PublicPort, "external port for the data (this) server")
 	flag.StringVar(&PrivatePort, "port-internal", PrivatePort, "internal port for the data (this) server")
 	flag.StringVar(&RegionPublic, "region-public", RegionPublic, "region public key")
 	flag.StringVar(&RegionPrivate, "region-private", RegionPrivate, "region private key")
+	flag.IntVar(&StampDifficulty, "stamp-difficulty", StampDifficulty, "bits of proof-of-work that envelopes need in the region (-1 keeps the setting)")
+	flag.IntVar(&ActionStampDifficulty, "action-stamp-difficulty", ActionStampDifficulty, "bits of proof-of-work that envelopes of actions need in the region (-1 keeps the setting)")
 	flag.StringVar(&SyncAddress, "sync", SyncAddress, "address to sync with")
 	debug := flag.Bool("debug", false, "turn on debug mode")
 	versionPrint := flag.Bool("version", false, "print version")
@@ -51,6 +57,7 @@ func main() {
 	flag.StringVar(&Alias, "alias", Alias, "alias for this instance")
 	flag.BoolVar(&GenerateRegion, "generate-region", GenerateRegion, "generate keys for a new region")
 	flag.BoolVar(&ExposeInternalPort, "expose", ExposeInternalPort, "expose the internal port instead of binding to localhost")
+	flag.BoolVar(&Ephemeral, "ephemeral", Ephemeral, "keep everything in memory instead of on disk, e.g. for a hub")
 	flag.Parse()
 
 	if GenerateRegion {
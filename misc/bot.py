@@ -1,4 +1,8 @@
-import json, requests
+import json, os, requests
+
+# the private server needs the API token that kiki keeps next to its keys
+with open(os.path.expanduser("~/.kiki/keys/default.token")) as f:
+	headers = {"Authorization": "Bearer " + f.read().strip()}
 
 content = """**My source code:**
 
@@ -9,13 +13,13 @@ quote = quote_response.json()[0]
 if len(quote['Name']) == 0:
 	quote['Name'] = 'Unknown'
 content = "<p>“{q[Text]}”</p><p>- <em>{q[Name]}</em></p>".format(q=quote)
-payload={'content':content,'purpose':'share-text','to':['public']}
-r = requests.post("http://localhost:8003/letter", data=json.dumps(payload))
+payload={'content':content,'recipients':['public']}
+r = requests.post("http://localhost:8003/api/v1/posts", data=json.dumps(payload), headers=headers)
 print(r.json())
 ```
 """
-payload={'content':content,'purpose':'share-text','to':['public']}
-r = requests.post("http://localhost:8003/letter", data=json.dumps(payload))
+payload={'content':content,'recipients':['public']}
+r = requests.post("http://localhost:8003/api/v1/posts", data=json.dumps(payload), headers=headers)
 
 
 quote_response = requests.get("https://quotes.schollz.com/subject/friend.json")
@@ -23,7 +27,7 @@ quote = quote_response.json()[0]
 if len(quote['Name']) == 0:
 	quote['Name'] = 'Unknown'
 content = "<p>“{q[Text]}”</p><p>- <em>{q[Name]}</em></p>".format(q=quote)
-payload={'content':content,'purpose':'share-text','to':['public']}
-r = requests.post("http://localhost:8003/letter", data=json.dumps(payload))
+payload={'content':content,'recipients':['public']}
+r = requests.post("http://localhost:8003/api/v1/posts", data=json.dumps(payload), headers=headers)
 print(r.json())
 
@@ -1,14 +1,17 @@
 package main
 
 import (
-	"encoding/base64"
 	"fmt"
 	"net/http"
+	"strconv"
 	"strings"
+	"time"
 
 	"github.com/gin-gonic/gin"
+	"github.com/pkg/errors"
 	"github.com/schollz/kiki/src/feed"
 	"github.com/schollz/kiki/src/letter"
+	"github.com/schollz/kiki/src/web"
 )
 
 func handleView(c *gin.Context) (posts []feed.Post) {
@@ -17,6 +20,8 @@ func handleView(c *gin.Context) (posts []feed.Post) {
 	p.Hashtag = c.DefaultQuery("hashtag", "")
 	p.User = c.DefaultQuery("user", "")
 	p.Search = c.DefaultQuery("search", "")
+	p.Group = c.DefaultQuery("group", "")
+	p.Conversation = c.DefaultQuery("conversation", "")
 	p.Latest = c.DefaultQuery("latest", "") == "1"
 	posts, _ = f.ShowFeed(p)
 	return
@@ -33,18 +38,37 @@ func handleHome(c *gin.Context) {
 	showPosts(c, posts)
 }
 
+// shownNotifications is how many of the latest notifications are in the menu
+const shownNotifications = 10
+
 func showPosts(c *gin.Context, posts []feed.Post) {
+	conversations, _ := f.GetConversations()
+	unread := 0
+	for _, conversation := range conversations {
+		unread += conversation.Unread
+	}
+	notifications, unreadNotifications, _ := f.GetNotifications(false)
+	if len(notifications) > shownNotifications {
+		notifications = notifications[:shownNotifications]
+	}
 
 	c.HTML(http.StatusOK, "index.tmpl", gin.H{
-		"Posts":          posts,
-		"User":           f.GetUser(),
-		"Friends":        f.GetUserFriends(),
-		"Connected":      f.GetConnected(),
-		"Hashtags":       f.GetHashTags(),
-		"RegionPublic":   RegionPublic,
-		"RegionPrivate":  RegionPrivate,
-		"ServerName":     strings.TrimLeft(strings.TrimLeft(ServerName, "http://"), "https://"),
-		"ServerNameFull": ServerName,
+		"Posts":               posts,
+		"User":                f.GetUser(),
+		"Friends":             f.GetUserFriends(),
+		"Connected":           f.GetConnected(),
+		"Hashtags":            f.GetHashTags(),
+		"Groups":              f.GetGroups(),
+		"Conversations":       conversations,
+		"Conversation":        c.DefaultQuery("conversation", ""),
+		"Unread":              unread,
+		"Notifications":       notifications,
+		"UnreadNotifications": unreadNotifications,
+		"RegionPublic":        RegionPublic,
+		"RegionPrivate":       RegionPrivate,
+		"ServerName":          strings.TrimLeft(strings.TrimLeft(ServerName, "http://"), "https://"),
+		"ServerNameFull":      ServerName,
+		"CSRFToken":           csrfToken,
 	})
 }
 
@@ -69,13 +93,24 @@ func handleImage(c *gin.Context) {
 		mimeType = "image/png"
 	}
 
-	imageBytes, err := base64.StdEncoding.DecodeString(e.Letter.Content)
+	file, hash, err := f.OpenImage(e)
 	if err != nil {
 		c.Data(http.StatusInternalServerError, "text/plain", []byte(err.Error()))
 		return
 	}
+	defer file.Close()
 
-	c.Data(http.StatusOK, mimeType, imageBytes)
+	// the image of an envelope never changes, and ServeContent answers
+	// If-None-Match with 304 Not Modified. Only public images can be kept by
+	// shared caches.
+	c.Header("Content-Type", mimeType)
+	c.Header("ETag", `"`+hash+`"`)
+	if f.IsPublic(e) {
+		c.Header("Cache-Control", "public, max-age=31536000, immutable")
+	} else {
+		c.Header("Cache-Control", "private, max-age=31536000, immutable")
+	}
+	http.ServeContent(c.Writer, c.Request, "", e.Timestamp, file)
 }
 
 // POST /letter
@@ -115,23 +150,224 @@ func handleEnvelope(c *gin.Context) (err error) {
 	if err != nil {
 		return
 	}
-	err = f.ProcessEnvelope(p)
+	peer := clientIP(c)
+	err = f.ProcessEnvelope(p, peer, limitSender)
+	if _, ok := errors.Cause(err).(feed.InvalidEnvelopeError); ok {
+		peerLimiter.Allow(peer)
+	}
 	f.SignalUpdate()
 	return
 }
 
-// GET /list?user_pub=X&signature=+
+// POST /batch/envelopes
+// The body is gzipped newline delimited JSON of envelopes, each is put into the database
+// and the result for each envelope is returned.
+func handleBatchEnvelopes(c *gin.Context) {
+	if c.GetHeader("Content-Encoding") != "gzip" {
+		c.JSON(http.StatusUnsupportedMediaType, gin.H{"status": "error", "error": "batch must be gzipped"})
+		return
+	}
+	c.Request.Body = http.MaxBytesReader(c.Writer, c.Request.Body, feed.MaxBatchBytes)
+	peer := clientIP(c)
+	results, err := f.ReadEnvelopes(c.Request.Body, peer, limitSender)
+	for _, result := range results {
+		if result.Invalid {
+			peerLimiter.Allow(peer)
+		}
+	}
+	f.SignalUpdate()
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"status": "error", "error": err.Error(), "results": results})
+		return
+	}
+	c.JSON(http.StatusOK, gin.H{"status": "ok", "message": fmt.Sprintf("processed %d envelopes", len(results)), "results": results})
+}
+
+// POST /batch/download {"ids":["ID1","ID2"]}
+// Returns the closed envelopes as gzipped newline delimited JSON.
+func handleBatchDownload(c *gin.Context) {
+	type Payload struct {
+		IDs []string `json:"ids" binding:"required"`
+	}
+	var p Payload
+	err := c.BindJSON(&p)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"status": "error", "error": err.Error()})
+		return
+	}
+	if len(p.IDs) > feed.BatchSize {
+		c.JSON(http.StatusBadRequest, gin.H{"status": "error", "error": fmt.Sprintf("too many envelopes, maximum is %d", feed.BatchSize)})
+		return
+	}
+	c.Header("Content-Type", "application/x-ndjson")
+	c.Header("Content-Encoding", "gzip")
+	c.Status(http.StatusOK)
+	err = f.WriteEnvelopes(c.Writer, p.IDs)
+	if err != nil {
+		logger.Log.Error(err)
+	}
+}
+
+// GET /quarantine
+func handleQuarantine(c *gin.Context) {
+	quarantined, err := f.GetQuarantined()
+	if err != nil {
+		c.JSON(500, gin.H{"status": "error", "error": err.Error()})
+		return
+	}
+	c.JSON(http.StatusOK, gin.H{"status": "ok", "message": fmt.Sprintf("%d quarantined", len(quarantined)), "quarantined": quarantined})
+}
+
+// GET /webhooks
+// Lists the latest deliveries to the webhooks in the settings, the latest first.
+func handleWebhooks(c *gin.Context) {
+	deliveries, err := f.GetWebhookDeliveries()
+	if err != nil {
+		c.JSON(500, gin.H{"status": "error", "error": err.Error()})
+		return
+	}
+	c.JSON(http.StatusOK, gin.H{"status": "ok", "message": fmt.Sprintf("%d deliveries", len(deliveries)), "deliveries": deliveries})
+}
+
+// GET /groups
+func handleGroups(c *gin.Context) {
+	groups := f.GetGroups()
+	c.JSON(http.StatusOK, gin.H{"status": "ok", "message": fmt.Sprintf("%d groups", len(groups)), "groups": groups})
+}
+
+// POST /groups
+// Makes a group, or changes the members of the group with the ID. The members are
+// sent a new group key.
+func handleGroup(c *gin.Context) {
+	type Payload struct {
+		ID      string   `json:"id"`
+		Name    string   `json:"name"`
+		Members []string `json:"members"`
+	}
+	var p Payload
+	err := c.BindJSON(&p)
+	if err != nil {
+		c.JSON(500, gin.H{"status": "error", "error": err.Error()})
+		return
+	}
+	var g feed.Group
+	if p.ID == "" {
+		g, err = f.NewGroup(p.Name, p.Members)
+	} else {
+		g, err = f.SetGroupMembers(p.ID, p.Members)
+	}
+	if err != nil {
+		logger.Log.Error(err)
+		c.JSON(500, gin.H{"status": "error", "error": err.Error()})
+		return
+	}
+	c.JSON(http.StatusOK, gin.H{"status": "ok", "message": "shared key for " + g.ID, "group": g})
+
+	go f.UpdateEverythingAndSync()
+}
+
+// GET /challenge
+// Issues a nonce that is used once to authenticate a request to another route.
+func handleChallenge(c *gin.Context) {
+	nonce, err := f.NewChallenge()
+	if err != nil {
+		c.JSON(500, gin.H{"status": "error", "error": err.Error()})
+		return
+	}
+	c.JSON(http.StatusOK, gin.H{"status": "ok", "message": "use nonce once", "nonce": nonce})
+}
+
+// errSenderLimited is returned for envelopes of a sender that sent too many of them
+var errSenderLimited = errors.New("sender is rate limited")
+
+// limitSender takes a token of the sender of a validated envelope, so that nobody can
+// use up the tokens of a sender with envelopes that only claim to be from them.
+// Envelopes that do not validate take a token of the peer instead.
+func limitSender(e letter.Envelope) error {
+	if !senderLimiter.Allow(e.Sender.Public) {
+		return errSenderLimited
+	}
+	return nil
+}
+
+// clientIP returns the address of the peer that made the request, which is only
+// taken from forwarding headers that come from the trusted proxies.
+func clientIP(c *gin.Context) string {
+	return web.PeerIP(c.Request, f.Settings.TrustedProxies)
+}
+
+// rateLimitPeers is middleware that answers peers that make too many requests
+// with 429 Too Many Requests.
+func rateLimitPeers() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		peer := clientIP(c)
+		if !peerLimiter.Allow(peer) {
+			logger.Log.Debugf("rate limited %s", peer)
+			c.Header("Retry-After", "1")
+			c.AbortWithStatusJSON(http.StatusTooManyRequests, gin.H{"status": "error", "error": "rate limited"})
+			return
+		}
+		c.Next()
+	}
+}
+
+// GET /ratelimits
+// Shows the counters of the rate limits, with the peers and senders that were
+// limited the most.
+func handleRateLimits(c *gin.Context) {
+	c.JSON(http.StatusOK, gin.H{
+		"status":  "ok",
+		"peers":   peerLimiter.Counters(20),
+		"senders": senderLimiter.Counters(20),
+	})
+}
+
+// requireProof is middleware that only lets through requests that have a proof made
+// for a nonce from /challenge and for the method, path and query of the request. The
+// public key of the requester is set as "requester".
+func requireProof() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		pubkey := c.GetHeader(feed.HeaderUser)
+		var err error
+		if pubkey != "" {
+			err = f.Authenticate(pubkey, c.GetHeader(feed.HeaderNonce), c.GetHeader(feed.HeaderProof), c.Request.Method, c.Request.URL.RequestURI())
+		} else {
+			// GET /list?user_pub=X&signature=Y from servers that do not use nonces
+			pubkey = c.DefaultQuery("user_pub", "")
+			err = f.AuthenticateLegacy(pubkey, c.DefaultQuery("signature", ""))
+		}
+		if err != nil {
+			logger.Log.Debugf("%s not authenticated: %s", c.Request.RemoteAddr, err.Error())
+			c.AbortWithStatusJSON(http.StatusUnauthorized, gin.H{"status": "error", "error": err.Error()})
+			return
+		}
+		c.Set("requester", pubkey)
+		c.Next()
+	}
+}
+
+// GET /list?since=N
+// When since is given, only the IDs of envelopes received after that cursor are listed.
+// The request must be authenticated with requireProof.
 func handleList(c *gin.Context) {
-	pubkey := c.DefaultQuery("user_pub", "")
-	signature := c.DefaultQuery("signature", "")
+	requester := c.GetString("requester")
 
-	idList, err := f.GetIDs(pubkey, signature)
+	var idList []string
+	var cursor int64
+	var err error
+	since, errSince := strconv.ParseInt(c.DefaultQuery("since", ""), 10, 64)
+	incremental := errSince == nil
+	if incremental {
+		idList, cursor, err = f.GetIDs(requester, since)
+	} else {
+		idList, cursor, err = f.GetIDs(requester)
+	}
 	personalSignature, _ := f.PersonalKey.Signature(f.RegionKey)
 	if err != nil {
 		logger.Log.Error(err)
 		c.JSON(500, gin.H{"status": "error", "error": err.Error()})
 	} else {
-		c.JSON(http.StatusOK, gin.H{"status": "ok", "message": "found IDs", "ids": idList, "personal_key": f.PersonalKey.Public, "personal_signature": personalSignature})
+		c.JSON(http.StatusOK, gin.H{"status": "ok", "message": "found IDs", "ids": idList, "cursor": cursor, "incremental": incremental, "personal_key": f.PersonalKey.Public, "personal_signature": personalSignature, "server_time": time.Now().UTC()})
 	}
 	return
 }
@@ -155,7 +391,7 @@ func handleDownload(c *gin.Context) {
 func handleSync(c *gin.Context) (err error) {
 	// bind the payload
 	type Payload struct {
-		Address string `json:"address" binding"required"`
+		Address string `json:"address" binding:"required"`
 	}
 	var p Payload
 	err = c.BindJSON(&p)
@@ -5,17 +5,21 @@ import (
 	"fmt"
 	"html/template"
 	"io"
+	"io/ioutil"
 	"mime/multipart"
 	"net"
 	"net/http"
 	"os"
 	"os/signal"
 	"strconv"
+	"strings"
 	"syscall"
 	"time"
 
 	"github.com/gin-contrib/multitemplate"
 	"github.com/gin-gonic/gin"
+	"github.com/pkg/errors"
+	"github.com/schollz/kiki/src/database"
 	"github.com/schollz/kiki/src/feed"
 	"github.com/schollz/kiki/src/logging"
 	"github.com/schollz/kiki/src/web"
@@ -24,23 +28,42 @@ import (
 var (
 	f      *feed.Feed
 	logger = logging.New()
+
+	// peerLimiter limits the requests of each peer to the public router, and
+	// senderLimiter limits the envelopes that are taken in from each sender
+	peerLimiter   = web.NewRateLimiter(0, 0)
+	senderLimiter = web.NewRateLimiter(0, 0)
 )
 
 func MiddleWareHandler() gin.HandlerFunc {
 	return func(c *gin.Context) {
 		// Log request
 		logger.Log.Debug(fmt.Sprintf("%v %v %v", c.Request.RemoteAddr, c.Request.Method, c.Request.URL))
-		// Add base headers
-		AddCORS(c)
 		// Run next function
 		c.Next()
 	}
 }
 
+// allowAnyOrigin is middleware for the public server, which anyone can use.
+func allowAnyOrigin() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		AddCORS(c)
+		c.Next()
+	}
+}
+
 func minus(a, b int) string {
 	return strconv.FormatInt(int64(a)-int64(b), 10)
 }
 
+// cleanup closes the feed, and removes everything that it kept if it is ephemeral
+func cleanup(f *feed.Feed) {
+	f.Cleanup()
+	if Ephemeral {
+		os.RemoveAll(Location)
+	}
+}
+
 // Run will start the server listening
 func Run(verbose bool) (err error) {
 	if !verbose {
@@ -49,7 +72,16 @@ func Run(verbose bool) (err error) {
 
 	// Startup feed
 	logger.Log.Debug("opening feed")
-	f, err = feed.New(Alias, Location, RegionPublic, RegionPrivate, verbose)
+	var stores []database.Store
+	if Ephemeral {
+		Location, err = ioutil.TempDir("", "kiki")
+		if err != nil {
+			return
+		}
+		logger.Log.Info("keeping everything in memory, nothing is kept after exiting")
+		stores = append(stores, database.NewMemory())
+	}
+	f, err = feed.New(Alias, Location, RegionPublic, RegionPrivate, verbose, stores...)
 	if err != nil {
 		logging.Log.Error(err)
 		return
@@ -68,25 +100,48 @@ func Run(verbose bool) (err error) {
 	}
 	logger.Log.Infof("Region public: %s", f.RegionKey.Public)
 	logger.Log.Infof("Region private: %s", f.RegionKey.Private)
+	if StampDifficulty >= 0 || ActionStampDifficulty >= 0 {
+		difficulty, actionDifficulty := f.Settings.StampDifficulty, f.Settings.ActionStampDifficulty
+		if StampDifficulty >= 0 {
+			difficulty = StampDifficulty
+		}
+		if ActionStampDifficulty >= 0 {
+			actionDifficulty = ActionStampDifficulty
+		}
+		err = f.SetStampDifficulty(difficulty, actionDifficulty)
+		if err != nil {
+			return
+		}
+	}
 	err = f.Save()
 	if err != nil {
 		return
 	}
+	peerLimiter = web.NewRateLimiter(f.Settings.PeerRequestsPerSecond, f.Settings.PeerBurst)
+	token, err := f.APIToken()
+	if err != nil {
+		return
+	}
+	setTokens(token)
+	if ExposeInternalPort {
+		logger.Log.Infof("the private port is exposed, requests need the API token in '%s'", f.TokenFile())
+	}
+	senderLimiter = web.NewRateLimiter(f.Settings.SenderEnvelopesPerSecond, f.Settings.SenderBurst)
 
 	c := make(chan os.Signal, 2)
 	signal.Notify(c, os.Interrupt, syscall.SIGTERM)
 	go func(f *feed.Feed) {
 		<-c
-		f.Cleanup()
+		cleanup(f)
 		os.Exit(1)
 	}(f)
-	defer f.Cleanup()
+	defer cleanup(f)
 
 	// Startup server
 	gin.SetMode(gin.ReleaseMode)
 
 	r := gin.New()
-	r.Use(MiddleWareHandler(), gin.Recovery()) // Standardize logs
+	r.Use(MiddleWareHandler(), gin.Recovery(), requireToken()) // Standardize logs
 	r.HTMLRender = loadTemplates("index.tmpl", "client.html")
 	r.HEAD("/", func(c *gin.Context) { // handler for the uptime robot
 		c.String(http.StatusOK, "OK")
@@ -96,9 +151,9 @@ func Run(verbose bool) (err error) {
 
 	// REST Api
 	r.GET("/client", func(c *gin.Context) {
-		c.HTML(http.StatusOK, "client.html", nil)
+		c.HTML(http.StatusOK, "client.html", gin.H{"CSRFToken": csrfToken})
 	})
-	restApi = HttpRestApi{Db: f.GetDatabase(), PrimaryUserId: f.PersonalKey.Public, RegionPublicId: f.RegionKey.Public}
+	restApi = HttpRestApi{Db: f.GetDatabase(), PrimaryUserId: f.PersonalKey.Public, RegionPublicId: f.RegionKey.Public, Feed: f}
 	restApi.AttachToRouter(r)
 	//.end
 
@@ -128,20 +183,28 @@ func Run(verbose bool) (err error) {
 
 	r.GET("/ping", handlePing)
 	r.GET("/img/:id", handleImage)
-	r.POST("/letter", handlerLetter)       // post to put in letter (local only)
-	r.OPTIONS("/letter", handlePing)       // post to put in letter (local only)
-	r.POST("/sync", handlerSync)           // tell server to sync with another server (local only)
-	r.OPTIONS("/sync", handlePing)         // post to put in letter (local only)
-	r.GET("/list", handleList)             // GET list of all envelope IDs
-	r.POST("/envelope", handlerEnvelope)   // post to put into database (public)
-	r.GET("/download/:id", handleDownload) // download a specific envelope
+	r.POST("/letter", handlerLetter)                 // post to put in letter (local only)
+	r.OPTIONS("/letter", handlePing)                 // post to put in letter (local only)
+	r.POST("/sync", handlerSync)                     // tell server to sync with another server (local only)
+	r.OPTIONS("/sync", handlePing)                   // post to put in letter (local only)
+	r.GET("/challenge", handleChallenge)             // GET a nonce to authenticate a request
+	r.GET("/list", requireProof(), handleList)       // GET list of all envelope IDs
+	r.POST("/envelope", handlerEnvelope)             // post to put into database (public)
+	r.GET("/download/:id", handleDownload)           // download a specific envelope
+	r.POST("/batch/envelopes", handleBatchEnvelopes) // post many envelopes to put into database
+	r.POST("/batch/download", handleBatchDownload)   // download many envelopes
+	r.GET("/quarantine", handleQuarantine)           // list envelopes that failed checks (local only)
+	r.GET("/groups", handleGroups)                   // list the groups you are in (local only)
+	r.POST("/groups", handleGroup)                   // make a group or change its members (local only)
+	r.GET("/ratelimits", handleRateLimits)           // show how many requests were rate limited (local only)
+	r.GET("/webhooks", handleWebhooks)               // list the latest deliveries to webhooks (local only)
 	r.GET("/test", func(c *gin.Context) {
 		message := ""
 		f.TestStuff()
 		c.JSON(http.StatusOK, gin.H{"success": err == nil, "message": message})
 	})
 	r.GET("/exit", func(c *gin.Context) {
-		f.Cleanup()
+		cleanup(f)
 		c.JSON(http.StatusOK, gin.H{"status": "ok", "message": "exited"})
 		go func() {
 			time.Sleep(200 * time.Millisecond)
@@ -151,11 +214,14 @@ func Run(verbose bool) (err error) {
 
 	// PUBLIC FACING ROUTES
 	publicRouter := gin.New()
-	publicRouter.Use(MiddleWareHandler(), gin.Recovery())
-	publicRouter.GET("/ping", handlePing)             // PING a kiki server to see if it is available
-	publicRouter.GET("/list", handleList)             // GET list of all envelope IDs
-	publicRouter.POST("/envelope", handlerEnvelope)   // post to put into database (public)
-	publicRouter.GET("/download/:id", handleDownload) // download a specific envelope
+	publicRouter.Use(MiddleWareHandler(), gin.Recovery(), allowAnyOrigin(), rateLimitPeers())
+	publicRouter.GET("/ping", handlePing)                       // PING a kiki server to see if it is available
+	publicRouter.GET("/challenge", handleChallenge)             // GET a nonce to authenticate a request
+	publicRouter.GET("/list", requireProof(), handleList)       // GET list of all envelope IDs
+	publicRouter.POST("/envelope", handlerEnvelope)             // post to put into database (public)
+	publicRouter.GET("/download/:id", handleDownload)           // download a specific envelope
+	publicRouter.POST("/batch/envelopes", handleBatchEnvelopes) // post many envelopes to put into database (public)
+	publicRouter.POST("/batch/download", handleBatchDownload)   // download many envelopes
 
 	// find a pair of ports
 	for {
@@ -217,7 +283,19 @@ func handlerLetter(c *gin.Context) {
 }
 
 func handlerEnvelope(c *gin.Context) {
-	respondWithJSON(c, "envelope added", handleEnvelope(c))
+	err := handleEnvelope(c)
+	if limitErr, ok := errors.Cause(err).(feed.StorageLimitError); ok {
+		// tell the peer that there is no room, so that it does not try again
+		logger.Log.Debug(err)
+		c.JSON(http.StatusInsufficientStorage, gin.H{"status": "rejected", "error": err.Error(), "storage": limitErr})
+		return
+	}
+	if err == errSenderLimited {
+		logger.Log.Debug(err)
+		c.JSON(http.StatusTooManyRequests, gin.H{"status": "error", "error": err.Error()})
+		return
+	}
+	respondWithJSON(c, "envelope added", err)
 }
 
 func handlerSync(c *gin.Context) {
@@ -239,8 +317,8 @@ func readFormFile(file *multipart.FileHeader) (data []byte, err error) {
 func AddCORS(c *gin.Context) {
 	c.Writer.Header().Set("Access-Control-Allow-Origin", "*")
 	c.Writer.Header().Set("Access-Control-Max-Age", "86400")
-	c.Writer.Header().Set("Access-Control-Allow-Methods", "GET,POST,OPTIONS")
-	c.Writer.Header().Set("Access-Control-Allow-Headers", "Content-Type, Content-Length, Accept-Encoding, X-CSRF-Token, Authorization, X-Max")
+	c.Writer.Header().Set("Access-Control-Allow-Methods", "GET,POST,PUT,DELETE,OPTIONS")
+	c.Writer.Header().Set("Access-Control-Allow-Headers", "Content-Type, Content-Length, Accept-Encoding, X-CSRF-Token, Authorization, X-Max, X-Kiki-User, X-Kiki-Nonce, X-Kiki-Proof")
 	c.Writer.Header().Set("Access-Control-Allow-Credentials", "true")
 }
 
@@ -257,6 +335,7 @@ func loadTemplates(list ...string) multitemplate.Render {
 	r := multitemplate.New()
 	funcMap := template.FuncMap{
 		"minus": minus,
+		"join":  strings.Join,
 	}
 	for _, x := range list {
 		templateString, err := Asset("templates/" + x)
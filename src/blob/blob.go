@@ -0,0 +1,114 @@
+package blob
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+// Store keeps blobs in a directory, each in a file named by the SHA-256 hash of its
+// content, so that identical blobs are only kept once.
+type Store struct {
+	dir string
+}
+
+// New opens the blob store in the directory, creating it if it does not exist.
+func New(dir string) (s Store, err error) {
+	err = os.MkdirAll(dir, 0755)
+	if err != nil {
+		return
+	}
+	return Store{dir: dir}, nil
+}
+
+// Hash returns the hash that the data is kept under.
+func Hash(data []byte) string {
+	h := sha256.Sum256(data)
+	return hex.EncodeToString(h[:])
+}
+
+// Put keeps the data and returns its hash. Nothing is written if the store already has
+// it, but the blob is touched so that it counts as just written for List.
+func (s Store) Put(data []byte) (hash string, err error) {
+	hash = Hash(data)
+	fileName := s.path(hash)
+	now := time.Now()
+	if err = os.Chtimes(fileName, now, now); err == nil {
+		return
+	}
+	err = os.MkdirAll(filepath.Dir(fileName), 0755)
+	if err != nil {
+		return
+	}
+	// write to a temporary file first so that a blob is never seen half written
+	tmp, err := ioutil.TempFile(filepath.Dir(fileName), "tmp-")
+	if err != nil {
+		return
+	}
+	_, err = tmp.Write(data)
+	if errClose := tmp.Close(); err == nil {
+		err = errClose
+	}
+	if err != nil {
+		os.Remove(tmp.Name())
+		return
+	}
+	err = os.Rename(tmp.Name(), fileName)
+	if err != nil {
+		os.Remove(tmp.Name())
+	}
+	return
+}
+
+// Open opens the blob with the hash for reading.
+func (s Store) Open(hash string) (f *os.File, err error) {
+	if !valid(hash) {
+		return nil, errors.New("not a blob hash")
+	}
+	return os.Open(s.path(hash))
+}
+
+// Remove deletes the blob with the hash.
+func (s Store) Remove(hash string) (err error) {
+	if !valid(hash) {
+		return errors.New("not a blob hash")
+	}
+	return os.Remove(s.path(hash))
+}
+
+// List returns the hashes of the blobs that were last written before the time.
+func (s Store) List(before time.Time) (hashes []string, err error) {
+	hashes = []string{}
+	err = filepath.Walk(s.dir, func(fileName string, info os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+		if info.IsDir() || !valid(info.Name()) || !info.ModTime().Before(before) {
+			return nil
+		}
+		hashes = append(hashes, info.Name())
+		return nil
+	})
+	return
+}
+
+// path is where the blob is kept. Blobs are spread over directories named by the
+// first two characters of the hash.
+func (s Store) path(hash string) string {
+	return filepath.Join(s.dir, hash[:2], hash)
+}
+
+// valid checks that the hash is a hex encoded SHA-256, which also makes sure
+// that it can not point outside of the store.
+func valid(hash string) bool {
+	if len(hash) != 2*sha256.Size {
+		return false
+	}
+	_, err := hex.DecodeString(hash)
+	return err == nil
+}
@@ -0,0 +1,62 @@
+package blob
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestStore(t *testing.T) {
+	dir, err := ioutil.TempDir("", "kiki-blob")
+	assert.Nil(t, err)
+	defer os.RemoveAll(dir)
+	s, err := New(filepath.Join(dir, "blobs"))
+	assert.Nil(t, err)
+
+	hash, err := s.Put([]byte("image"))
+	assert.Nil(t, err)
+	assert.Equal(t, Hash([]byte("image")), hash)
+
+	// the same data is only kept once
+	hash2, err := s.Put([]byte("image"))
+	assert.Nil(t, err)
+	assert.Equal(t, hash, hash2)
+	hashes, err := s.List(time.Now().Add(1 * time.Minute))
+	assert.Nil(t, err)
+	assert.Equal(t, []string{hash}, hashes)
+
+	f, err := s.Open(hash)
+	assert.Nil(t, err)
+	data, err := ioutil.ReadAll(f)
+	f.Close()
+	assert.Nil(t, err)
+	assert.Equal(t, "image", string(data))
+
+	// blobs that were just written are not listed as old
+	hashes, err = s.List(time.Now().Add(-1 * time.Minute))
+	assert.Nil(t, err)
+	assert.Equal(t, 0, len(hashes))
+
+	// putting an old blob again makes it count as just written
+	old := time.Now().Add(-1 * time.Hour)
+	assert.Nil(t, os.Chtimes(s.path(hash), old, old))
+	hashes, err = s.List(time.Now().Add(-1 * time.Minute))
+	assert.Nil(t, err)
+	assert.Equal(t, []string{hash}, hashes)
+	_, err = s.Put([]byte("image"))
+	assert.Nil(t, err)
+	hashes, err = s.List(time.Now().Add(-1 * time.Minute))
+	assert.Nil(t, err)
+	assert.Equal(t, 0, len(hashes))
+
+	assert.Nil(t, s.Remove(hash))
+	_, err = s.Open(hash)
+	assert.NotNil(t, err)
+
+	_, err = s.Open("../../etc/passwd")
+	assert.NotNil(t, err)
+}
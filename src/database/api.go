@@ -1,8 +1,8 @@
 package database
 
 import (
-	// "encoding/json"
 	"database/sql"
+	"encoding/json"
 	"fmt"
 	"strings"
 
@@ -16,44 +16,59 @@ import (
 // Publicly acessible database routines
 type DatabaseAPI struct {
 	FileName string
+	db       *database
 }
 
-func Setup(locationToDatabase string) (api DatabaseAPI) {
+// Setup opens the database, which stays open until it is closed. If a key is given,
+// the opened letters are encrypted at rest with it.
+func Setup(locationToDatabase string, key ...[32]byte) (api DatabaseAPI, err error) {
 	api = DatabaseAPI{
 		FileName: locationToDatabase,
 	}
+	api.db, err = open(api.FileName)
+	if err != nil {
+		return
+	}
+	var atRestKey *[32]byte
+	if len(key) > 0 {
+		atRestKey = &key[0]
+	}
+	err = api.db.encryptAtRest(atRestKey)
+	if err != nil {
+		api.db.Close()
+	}
 	return
 }
 
+// Close closes the database
+func (api DatabaseAPI) Close() (err error) {
+	return api.db.Close()
+}
+
 func (api DatabaseAPI) Set(bucket, key string, value interface{}) (err error) {
-	db, err := open(api.FileName)
-	if err != nil {
-		return
-	}
-	defer db.Close()
-	return db.Set(bucket, key, value)
+	return api.db.Set(bucket, key, value)
 }
 
 func (api DatabaseAPI) Get(bucket, key string, value interface{}) (err error) {
-	db, err := open(api.FileName)
-	if err != nil {
-		return
-	}
-	defer db.Close()
-	return db.Get(bucket, key, value)
+	return api.db.Get(bucket, key, value)
+}
+
+// Keys will list all the keys that are set in a bucket
+func (api DatabaseAPI) Keys(bucket string) (keys []string, err error) {
+	return api.db.keys(bucket)
+}
+
+// Delete will delete a key from the keystore
+func (api DatabaseAPI) Delete(bucket, key string) (err error) {
+	return api.db.delete(bucket, key)
 }
 
 // AddTags will add the tags to the database
 func (api DatabaseAPI) AddTags(idToTags map[string][]string) (err error) {
 	logger.Log.Debug(len(idToTags))
-	db, err := open(api.FileName)
-	if err != nil {
-		return
-	}
-	defer db.Close()
 	for id := range idToTags {
 		for _, tag := range idToTags[id] {
-			err = db.AddTag(tag, id)
+			err = api.db.AddTag(tag, id)
 			if err != nil {
 				return
 			}
@@ -65,11 +80,6 @@ func (api DatabaseAPI) AddTags(idToTags map[string][]string) (err error) {
 // GetEnvelopesFromTag uses the hashtag table to get the latest post for a hashtag.
 func (api DatabaseAPI) GetEnvelopesFromTag(tag string) (es []letter.Envelope, err error) {
 	logger.Log.Debug(tag)
-	db, err := open(api.FileName)
-	if err != nil {
-		return
-	}
-	defer db.Close()
 	query := `
 	SELECT * FROM
 		(
@@ -92,22 +102,17 @@ func (api DatabaseAPI) GetEnvelopesFromTag(tag string) (es []letter.Envelope, er
 		) GROUP BY letter_firstid ORDER BY time DESC;
 	`
 	logger.Log.Debug(query)
-	es, err = db.getAllFromPreparedQuery(query)
+	es, err = api.db.getAllFromPreparedQuery(query)
 	return
 }
 
 func (api DatabaseAPI) GetEnvelopesFromTag1(tag string) (es []letter.Envelope, err error) {
-	db, err := open(api.FileName)
-	if err != nil {
-		return
-	}
-	defer db.Close()
-	ids, err := db.GetIDsFromTag(tag)
+	ids, err := api.db.GetIDsFromTag(tag)
 	if err != nil {
 		return
 	}
 
-	es, err = db.getAllFromPreparedQuery(fmt.Sprintf("SELECT * FROM (SELECT * FROM letters WHERE opened ==1 AND letter_purpose = 'share-text' AND letter_content != '' AND id IN ('%s') AND letter_replyto == '' ORDER BY TIME) GROUP BY letter_firstid ORDER BY time DESC;", strings.Join(ids, "','")))
+	es, err = api.db.getAllFromPreparedQuery(latestVersionsQuery(fmt.Sprintf("opened ==1 AND letter_purpose = 'share-text' AND letter_content != '' AND id IN ('%s') AND letter_replyto == ''", strings.Join(ids, "','"))) + " ORDER BY time DESC;")
 	return
 }
 
@@ -117,36 +122,21 @@ func (api DatabaseAPI) AddEnvelope(e letter.Envelope) (err error) {
 	if err == nil {
 		return errors.New("envelope already exists")
 	}
-	db, err := open(api.FileName)
-	if err != nil {
-		return
-	}
-	defer db.Close()
-	return db.addEnvelope(e)
+	return api.db.addEnvelope(e)
 }
 
 func (api DatabaseAPI) UpdateEnvelope(e letter.Envelope) (err error) {
 	logger.Log.Debug(e.ID)
 
-	db, err := open(api.FileName)
-	if err != nil {
-		return
-	}
-	defer db.Close()
-	return db.addEnvelope(e)
+	return api.db.addEnvelope(e)
 }
 
 // GetEnvelopeFromID returns a single envelope from its ID and returns an error if it does not exist.
 func (api DatabaseAPI) GetEnvelopeFromID(id string) (e letter.Envelope, err error) {
 	logger.Log.Debug(id)
 
-	db, err := open(api.FileName)
-	if err != nil {
-		return
-	}
-	defer db.Close()
 	var es []letter.Envelope
-	es, err = db.getAllFromPreparedQuery("SELECT * FROM letters WHERE id = ?", id)
+	es, err = api.db.getAllFromPreparedQuery("SELECT * FROM letters WHERE id = ?", id)
 	if err != nil {
 		err = errors.Wrap(err, "GetEnvelopeFromID("+id+")")
 	} else {
@@ -163,16 +153,10 @@ func (api DatabaseAPI) GetEnvelopeFromID(id string) (e letter.Envelope, err erro
 func (api DatabaseAPI) GetLatestEnvelopeFromID(id string) (e letter.Envelope, err error) {
 	logger.Log.Debug(id)
 
-	db, err := open(api.FileName)
-	if err != nil {
-		return
-	}
-	es, err := db.getAllVersions(id)
+	es, err := api.db.getAllVersions(id)
 	if err != nil {
-		db.Close()
 		return
 	}
-	db.Close()
 	return api.GetEnvelopeFromID(es[0])
 }
 
@@ -180,19 +164,14 @@ func (api DatabaseAPI) GetLatestEnvelopeFromID(id string) (e letter.Envelope, er
 func (api DatabaseAPI) GetAllEnvelopes(opened ...bool) (e []letter.Envelope, err error) {
 	logger.Log.Debug(len(opened) > 0)
 
-	db, err := open(api.FileName)
-	if err != nil {
-		return
-	}
-	defer db.Close()
 	if len(opened) > 0 {
 		if opened[0] {
-			return db.getAllFromQuery("SELECT * FROM letters WHERE opened == 1 ORDER BY time DESC")
+			return api.db.getAllFromQuery("SELECT * FROM letters WHERE opened == 1 ORDER BY time DESC")
 		} else {
-			return db.getAllFromQuery("SELECT * FROM letters WHERE opened == 0 ORDER BY time DESC")
+			return api.db.getAllFromQuery("SELECT * FROM letters WHERE opened == 0 ORDER BY time DESC")
 		}
 	} else {
-		return db.getAllFromQuery("SELECT * FROM letters ORDER BY time DESC")
+		return api.db.getAllFromQuery("SELECT * FROM letters ORDER BY time DESC")
 	}
 }
 
@@ -200,34 +179,23 @@ func (api DatabaseAPI) GetAllEnvelopes(opened ...bool) (e []letter.Envelope, err
 func (api DatabaseAPI) GetReplies(id string) (e []letter.Envelope, err error) {
 	logger.Log.Debug(id)
 
-	db, err := open(api.FileName)
-	if err != nil {
-		return
-	}
-	defer db.Close()
 	// purpose should be to share text
 	// can be empty
 	// should not be replaced
 	// should be a reply
 	// ordered by time ascending
-	envelopes, err := db.getAllFromPreparedQuery(`
-		SELECT * FROM (
-			SELECT * FROM letters
-			WHERE opened == 1
+	envelopes, err := api.db.getAllFromPreparedQuery(latestVersionsQuery(`
+			opened == 1
 			AND letter_purpose = 'share-text'
 			AND letter_replyto == ?
-			ORDER BY time
-		)
-		GROUP BY letter_firstid
-		ORDER BY time
-	`, id)
+		`)+" ORDER BY time", id)
 	if err != nil {
 		return
 	}
 	e = make([]letter.Envelope, len(envelopes))
 	i := 0
 	for _, envelope := range envelopes {
-		yes, _ := db.isReplaced(envelope.ID)
+		yes, _ := api.db.isReplaced(envelope.ID)
 		if yes {
 			continue
 		}
@@ -238,31 +206,29 @@ func (api DatabaseAPI) GetReplies(id string) (e []letter.Envelope, err error) {
 	return
 }
 
+// latestVersionsIDs selects the ID of the latest version of every post that matches.
+// SQLite takes the other columns from the row with the MAX(time) of each group.
+func latestVersionsIDs(where string) string {
+	return "SELECT id FROM (SELECT id, MAX(time) FROM letters WHERE " + where + " GROUP BY letter_firstid)"
+}
+
+// latestVersionsQuery selects the latest version of every post that matches.
+func latestVersionsQuery(where string) string {
+	return "SELECT * FROM letters WHERE id IN (" + latestVersionsIDs(where) + ")"
+}
+
 func (api DatabaseAPI) GetBasicPosts() (e []letter.Envelope, err error) {
 	logger.Log.Debug("basic")
 
-	db, err := open(api.FileName)
-	if err != nil {
-		return
-	}
-	defer db.Close()
 	// purpose should be to share text
 	// should not be empty
 	// should not be replaced (GROUP BY letter_firstid)
 	// should not be a reply
-	es, err := db.getAllFromPreparedQuery(`
-		SELECT
-			*
-		FROM
-			(
-				SELECT * FROM letters
-				WHERE opened ==1
-				AND letter_purpose = 'share-text'
-				AND letter_replyto == ''
-				ORDER BY time
-			)
-		GROUP BY letter_firstid ORDER BY time DESC
-	`)
+	es, err := api.db.getAllFromPreparedQuery(latestVersionsQuery(`
+			opened ==1
+			AND letter_purpose = 'share-text'
+			AND letter_replyto == ''
+		`) + " ORDER BY time DESC")
 	if err != nil {
 		return
 	}
@@ -280,25 +246,16 @@ func (api DatabaseAPI) GetBasicPosts() (e []letter.Envelope, err error) {
 }
 
 func (api DatabaseAPI) GetBasicPostsForUser(publickey string) (e []letter.Envelope, err error) {
-	db, err := open(api.FileName)
-	if err != nil {
-		return
-	}
-	defer db.Close()
 	// purpose should be to share text
 	// should not be empty
 	// should not be replaced
 	// should not be a reply
-	es, err := db.getAllFromPreparedQuery(`
-		SELECT * FROM (
-			SELECT * FROM letters
-			WHERE opened ==1
+	es, err := api.db.getAllFromPreparedQuery(latestVersionsQuery(`
+			opened ==1
 			AND letter_purpose = 'share-text'
 			AND sender == ?
 			AND letter_replyto == ''
-			ORDER BY time
-		) GROUP BY letter_firstid ORDER BY time DESC;
-	`, publickey)
+		`)+" ORDER BY time DESC;", publickey)
 	if err != nil {
 		return
 	}
@@ -315,18 +272,37 @@ func (api DatabaseAPI) GetBasicPostsForUser(publickey string) (e []letter.Envelo
 	return
 }
 
-// GetBasicPostLatest returns the latest post for a person
-func (api DatabaseAPI) GetBasicPostLatest(publickey string) (e letter.Envelope, err error) {
-	db, err := open(api.FileName)
+// GetTexts returns the latest version of every text, posts and replies, from the
+// senders, or from everyone if there are no senders, the latest first.
+func (api DatabaseAPI) GetTexts(senders ...string) (e []letter.Envelope, err error) {
+	where := "opened == 1 AND letter_purpose = 'share-text'"
+	args := make([]interface{}, len(senders))
+	if len(senders) > 0 {
+		where += " AND sender IN (?" + strings.Repeat(",?", len(senders)-1) + ")"
+		for i, sender := range senders {
+			args[i] = sender
+		}
+	}
+	es, err := api.db.getAllFromPreparedQuery(latestVersionsQuery(where)+" ORDER BY time DESC;", args...)
 	if err != nil {
 		return
 	}
-	defer db.Close()
+	e = []letter.Envelope{}
+	for _, es0 := range es {
+		if es0.Letter.Content != "" {
+			e = append(e, es0)
+		}
+	}
+	return
+}
+
+// GetBasicPostLatest returns the latest post for a person
+func (api DatabaseAPI) GetBasicPostLatest(publickey string) (e letter.Envelope, err error) {
 	// purpose should be to share text
 	// should not be empty
 	// should not be replaced
 	// should not be a reply
-	es, err := db.getAllFromPreparedQuery(`
+	es, err := api.db.getAllFromPreparedQuery(`
 		SELECT * FROM (
 			SELECT * FROM letters
 			WHERE opened == 1
@@ -362,13 +338,13 @@ func (self DatabaseAPI) postJsonSql() string {
 		'{'||
 			'"id": "' ||  letter_firstid ||'",'||
 			'"timestamp": ' || strftime('%s',time) ||','||
-			'"recipients": ' ||  letter_to ||','||
+			'"recipients": ' ||  CASE WHEN letter_to LIKE 'enc1:%' THEN '["' || letter_to || '"]' ELSE letter_to END ||','||
 			'"owner_id": "' ||  sender ||'",'||
 			'"owner_name": "' || IFNULL((SELECT letter_content FROM letters WHERE opened == 1 AND letter_purpose == 'action-assign/name' AND sender == ltr.sender ORDER BY time DESC LIMIT 1), 'null') ||'",'||
 			'"content": "' ||  replace(letter_content, '"',  '''') ||'",'||
 			'"reply_to": "' ||  letter_replyto ||'",'||
 			'"purpose":"' ||  letter_purpose ||'",'||
-			'"likes": '|| (SELECT COUNT(*) FROM letters WHERE opened == 1 AND letter_purpose == 'action-like' AND letter_content=ltr.letter_firstid) ||','||
+			'"likes": '|| (SELECT COUNT(*) FROM (` + actionsInEffect(purpose.ActionLike, purpose.ActionUnlike, "a.letter_content == ltr.letter_firstid") + `)) ||','||
 			'"num_comments": '|| ( SELECT count(*) FROM letters WHERE opened == 1 AND letter_purpose = 'share-text' AND letter_replyto = ltr.letter_firstid ) ||','||
 			'"hashtags": [' ||
 				(SELECT IFNULL(GROUP_CONCAT(tag), '') FROM (
@@ -395,38 +371,57 @@ func (self DatabaseAPI) processRowsToPosts(rows *sql.Rows) ([]ApiBasicPost, erro
 		if err = post.Unmarshal(text); nil != err {
 			return posts, err
 		}
+		if err = self.unsealPost(&post); nil != err {
+			return posts, err
+		}
 
 		posts = append(posts, post)
 	}
 	return posts, nil
 }
 
+// unsealPost decrypts the content and recipients of a post that is encrypted at
+// rest, which are left encrypted in the JSON made by postJsonSql.
+func (self DatabaseAPI) unsealPost(post *ApiBasicPost) (err error) {
+	if len(post.Recipients) == 1 && strings.HasPrefix(post.Recipients[0], atRestPrefix) {
+		var mTo string
+		mTo, err = self.db.unseal(post.Recipients[0])
+		if err != nil {
+			return
+		}
+		post.Recipients = nil
+		err = json.Unmarshal([]byte(mTo), &post.Recipients)
+		if err != nil {
+			return
+		}
+	}
+	if strings.HasPrefix(post.Content, atRestPrefix) {
+		post.Content, err = self.db.unseal(post.Content)
+		post.Content = strings.Replace(post.Content, `"`, "'", -1)
+	}
+	return
+}
+
 // json1 needs to be loaded...
 func (self DatabaseAPI) GetPostsForApi() ([]ApiBasicPost, error) {
 	var posts []ApiBasicPost
 
-	db, err := open(self.FileName)
-	if nil != err {
-		return posts, err
-	}
-	defer db.Close()
-
 	query := `
 		SELECT
 	        ` + self.postJsonSql() + `
 		FROM letters AS ltr
-		WHERE
+		WHERE id IN (` + latestVersionsIDs(`
 				opened == 1
 			AND
 		        letter_purpose = 'share-text'
 		    AND
 				letter_replyto == ''
-		GROUP BY letter_firstid
+		`) + `)
 		ORDER BY time DESC;
 `
 
 	// prepare statement
-	stmt, err := db.db.Prepare(query)
+	stmt, err := self.db.db.Prepare(query)
 	if nil != err {
 		return posts, err
 	}
@@ -444,27 +439,21 @@ func (self DatabaseAPI) GetPostsForApi() ([]ApiBasicPost, error) {
 func (self DatabaseAPI) GetPostCommentsForApi(post_id string) ([]ApiBasicPost, error) {
 	var posts []ApiBasicPost
 
-	db, err := open(self.FileName)
-	if nil != err {
-		return posts, err
-	}
-	defer db.Close()
-
 	query := `
 		SELECT
 			` + self.postJsonSql() + `
 		FROM letters AS ltr
-		WHERE
+		WHERE id IN (` + latestVersionsIDs(`
 				opened == 1
 			AND
 		        letter_purpose = 'share-text'
 		    AND letter_replyto == ?
-		GROUP BY letter_firstid
+		`) + `)
 		ORDER BY time DESC;
 `
 
 	// prepare statement
-	stmt, err := db.db.Prepare(query)
+	stmt, err := self.db.db.Prepare(query)
 	if nil != err {
 		return posts, err
 	}
@@ -482,12 +471,6 @@ func (self DatabaseAPI) GetPostCommentsForApi(post_id string) ([]ApiBasicPost, e
 func (self DatabaseAPI) GetPostVersionsForApi(post_id string) ([]ApiBasicPost, error) {
 	var posts []ApiBasicPost
 
-	db, err := open(self.FileName)
-	if nil != err {
-		return posts, err
-	}
-	defer db.Close()
-
 	query := `
 		SELECT
 			` + self.postJsonSql() + `
@@ -501,7 +484,7 @@ func (self DatabaseAPI) GetPostVersionsForApi(post_id string) ([]ApiBasicPost, e
 `
 
 	// prepare statement
-	stmt, err := db.db.Prepare(query)
+	stmt, err := self.db.db.Prepare(query)
 	if nil != err {
 		return posts, err
 	}
@@ -519,12 +502,6 @@ func (self DatabaseAPI) GetPostVersionsForApi(post_id string) ([]ApiBasicPost, e
 func (self DatabaseAPI) GetPostForApi(post_id string) ([]ApiBasicPost, error) {
 	var posts []ApiBasicPost
 
-	db, err := open(self.FileName)
-	if nil != err {
-		return posts, err
-	}
-	defer db.Close()
-
 	query := `
 		SELECT
 			` + self.postJsonSql() + `
@@ -539,7 +516,7 @@ func (self DatabaseAPI) GetPostForApi(post_id string) ([]ApiBasicPost, error) {
 `
 
 	// prepare statement
-	stmt, err := db.db.Prepare(query)
+	stmt, err := self.db.db.Prepare(query)
 	if nil != err {
 		return posts, err
 	}
@@ -557,12 +534,6 @@ func (self DatabaseAPI) GetPostForApi(post_id string) ([]ApiBasicPost, error) {
 func (self DatabaseAPI) GetUserForApi(user_id string) (ApiUser, error) {
 	var user ApiUser
 
-	db, err := open(self.FileName)
-	if nil != err {
-		return user, err
-	}
-	defer db.Close()
-
 	query := `
 		SELECT
 	        '{'||
@@ -572,31 +543,31 @@ func (self DatabaseAPI) GetUserForApi(user_id string) (ApiUser, error) {
 				'"image": "' || IFNULL((SELECT letter_content FROM letters WHERE opened == 1 AND letter_purpose == 'action-assign/image' AND sender == ? ORDER BY time DESC LIMIT 1), 'null') ||'",'||
 				'"followers": [' || (
 					SELECT IFNULL(GROUP_CONCAT(ids), '') FROM (
-						SELECT IFNULL('"'||sender||'"', '') AS ids FROM letters WHERE letter_purpose = 'action-follow' AND letter_content = ?
+						SELECT IFNULL('"'||sender||'"', '') AS ids FROM (` + actionsInEffect(purpose.ActionFollow, purpose.ActionUnfollow, "a.letter_content = ?") + `)
 					)
 				) ||'],'||
 				'"following": [' || (
 					SELECT IFNULL(GROUP_CONCAT(ids), '') FROM (
-						SELECT IFNULL('"'||letter_content||'"', '') AS ids FROM letters WHERE letter_purpose = 'action-follow' AND sender = ?
+						SELECT IFNULL('"'||letter_content||'"', '') AS ids FROM (` + actionsInEffect(purpose.ActionFollow, purpose.ActionUnfollow, "a.sender = ?") + `)
 					)
 				) ||'],'||
 				'"blocked": [' || (
 					SELECT IFNULL(GROUP_CONCAT(ids), '') FROM (
-						SELECT IFNULL('"'||letter_content||'"', '') AS ids FROM letters WHERE letter_purpose = 'action-block' AND sender = ?
+						SELECT IFNULL('"'||letter_content||'"', '') AS ids FROM (` + actionsInEffect(purpose.ActionBlock, purpose.ActionUnblock, "a.sender = ?") + `)
 					)
 				) ||'],'||
 				'"friends": ['|| (
 		            SELECT IFNULL(GROUP_CONCAT(ids), '') FROM (
-		                SELECT '"'||sender||'"' AS ids FROM letters WHERE letter_purpose = 'action-follow' AND letter_content = ?
+		                SELECT '"'||sender||'"' AS ids FROM (` + actionsInEffect(purpose.ActionFollow, purpose.ActionUnfollow, "a.letter_content = ?") + `)
 		                INTERSECT
-		                SELECT '"'||letter_content||'"' AS ids FROM letters WHERE letter_purpose = 'action-follow' AND sender = ?
+		                SELECT '"'||letter_content||'"' AS ids FROM (` + actionsInEffect(purpose.ActionFollow, purpose.ActionUnfollow, "a.sender = ?") + `)
 		            )
 		        ) ||']'
 			||'}';
 `
 
 	// prepare statement
-	stmt, err := db.db.Prepare(query)
+	stmt, err := self.db.db.Prepare(query)
 	if nil != err {
 		return user, err
 	}
@@ -626,32 +597,17 @@ func (self DatabaseAPI) GetUserForApi(user_id string) (ApiUser, error) {
 
 // GetKeys will return all the keys
 func (api DatabaseAPI) GetKeys() (s []keypair.KeyPair, err error) {
-	db, err := open(api.FileName)
-	if err != nil {
-		return
-	}
-	defer db.Close()
-	return db.getKeys()
+	return api.db.getKeys()
 }
 
 // GetKeysFromSender will return all the keys from a certain sender
 func (api DatabaseAPI) GetKeysFromSender(sender string) (s []keypair.KeyPair, err error) {
-	db, err := open(api.FileName)
-	if err != nil {
-		return
-	}
-	defer db.Close()
-	return db.getKeys(sender)
+	return api.db.getKeys(sender)
 }
 
 // GetName will return the assigned name for the public key of a sender
 func (api DatabaseAPI) GetName(publicKey string) (name string) {
-	db, err := open(api.FileName)
-	if err != nil {
-		return
-	}
-	defer db.Close()
-	name, err = db.getName(publicKey)
+	name, err := api.db.getName(publicKey)
 	if err != nil {
 		logger.Log.Warn(err)
 	}
@@ -660,12 +616,7 @@ func (api DatabaseAPI) GetName(publicKey string) (name string) {
 
 // GetProfile will return the assigned profile for the public key of a sender
 func (api DatabaseAPI) GetProfile(publicKey string) (name string) {
-	db, err := open(api.FileName)
-	if err != nil {
-		return
-	}
-	defer db.Close()
-	name, err = db.getProfile(publicKey)
+	name, err := api.db.getProfile(publicKey)
 	if err != nil {
 		logger.Log.Warn(err)
 	}
@@ -674,12 +625,7 @@ func (api DatabaseAPI) GetProfile(publicKey string) (name string) {
 
 // GetProfile will return the assigned profile for the public key of a sender
 func (api DatabaseAPI) GetProfileImage(publicKey string) (imageID string) {
-	db, err := open(api.FileName)
-	if err != nil {
-		return
-	}
-	defer db.Close()
-	imageID, err = db.getProfileImage(publicKey)
+	imageID, err := api.db.getProfileImage(publicKey)
 	if err != nil {
 		logger.Log.Warn(err)
 	}
@@ -688,36 +634,21 @@ func (api DatabaseAPI) GetProfileImage(publicKey string) (imageID string) {
 
 // GetUser returns information for a user
 func (api DatabaseAPI) GetUser(publicKey string) (name, profile, image string) {
-	db, err := open(api.FileName)
-	if err != nil {
-		return
-	}
-	defer db.Close()
-	name, _ = db.getName(publicKey)
-	profile, _ = db.getProfile(publicKey)
-	image, _ = db.getProfileImage(publicKey)
+	name, _ = api.db.getName(publicKey)
+	profile, _ = api.db.getProfile(publicKey)
+	image, _ = api.db.getProfileImage(publicKey)
 	return
 }
 
 // GetFriendsName will search friend's keys and determine the name of the friends key, e.g. Zack's Friends (where Zack is assigned name of public key)
 func (api DatabaseAPI) GetFriendsName(publicKey string) (name string) {
-	db, err := open(api.FileName)
-	if err != nil {
-		return
-	}
-	defer db.Close()
-	return db.getFriendsName(publicKey)
+	return api.db.getFriendsName(publicKey)
 }
 
 // RemoveLetters will delete the letter containing that ID
 func (api DatabaseAPI) RemoveLetters(ids []string) (err error) {
-	db, err := open(api.FileName)
-	if err != nil {
-		return
-	}
-	defer db.Close()
 	for _, id := range ids {
-		err2 := db.deleteLetterFromID(id)
+		err2 := api.db.deleteLetterFromID(id)
 		if err2 != nil {
 			logger.Log.Warn(err2)
 		}
@@ -727,23 +658,13 @@ func (api DatabaseAPI) RemoveLetters(ids []string) (err error) {
 
 // RemoveLettersForUser will delete the envelopes for a specific user
 func (api DatabaseAPI) RemoveLettersForUser(user string) (err error) {
-	db, err := open(api.FileName)
-	if err != nil {
-		return
-	}
-	defer db.Close()
-	err = db.deleteLettersFromSender(user)
+	err = api.db.deleteLettersFromSender(user)
 	return
 }
 
 // GetIDs will delete the letter containing that ID
 func (api DatabaseAPI) GetIDs() (ids map[string]struct{}, err error) {
-	db, err := open(api.FileName)
-	if err != nil {
-		return
-	}
-	defer db.Close()
-	s, err := db.getIDs()
+	s, err := api.db.getIDs()
 	if err != nil {
 		return
 	}
@@ -754,14 +675,14 @@ func (api DatabaseAPI) GetIDs() (ids map[string]struct{}, err error) {
 	return
 }
 
+// GetIDsSince returns the IDs of envelopes received after the cursor, and the cursor of the latest one
+func (api DatabaseAPI) GetIDsSince(cursor int64) (ids []string, latest int64, err error) {
+	return api.db.getIDsSince(cursor)
+}
+
 // IsReplaced returns boolean of whether post with ID has been replaced
 func (api DatabaseAPI) IsReplaced(id string) (yes bool) {
-	db, err := open(api.FileName)
-	if err != nil {
-		return
-	}
-	defer db.Close()
-	yes, err = db.isReplaced(id)
+	yes, err := api.db.isReplaced(id)
 	if err != nil {
 		log.Error(err)
 	}
@@ -770,52 +691,27 @@ func (api DatabaseAPI) IsReplaced(id string) (yes bool) {
 
 // DiskSpaceForUser returns the bytes used by a user for recipients + sealed_content
 func (api DatabaseAPI) DiskSpaceForUser(user string) (diskSpace int64, err error) {
-	db, err := open(api.FileName)
-	if err != nil {
-		return
-	}
-	defer db.Close()
-	return db.diskSpaceForUser(user)
+	return api.db.diskSpaceForUser(user)
 }
 
 // ListUsers returns the bytes used by a user for recipients + sealed_content
 func (api DatabaseAPI) ListUsers() (users []string, err error) {
-	db, err := open(api.FileName)
-	if err != nil {
-		return
-	}
-	defer db.Close()
-	return db.listUsers()
+	return api.db.listUsers()
 }
 
 // ListBlockedUsers returns the bytes used by a user for recipients + sealed_content
 func (api DatabaseAPI) ListBlockedUsers(publickey string) (users []string, err error) {
-	db, err := open(api.FileName)
-	if err != nil {
-		return
-	}
-	defer db.Close()
-	return db.listBlockedUsers(publickey)
+	return api.db.listBlockedUsers(publickey)
 }
 
 // GetAllVersions returns the bytes used by a user for recipients + sealed_content
 func (api DatabaseAPI) GetAllVersions(id string) (ids []string, err error) {
-	db, err := open(api.FileName)
-	if err != nil {
-		return
-	}
-	defer db.Close()
-	return db.getAllVersions(id)
+	return api.db.getAllVersions(id)
 }
 
 // NumberOfLikes returns the number of likes for a post
 func (api DatabaseAPI) NumberOfLikes(postID string) (likes int64) {
-	db, err := open(api.FileName)
-	if err != nil {
-		return
-	}
-	defer db.Close()
-	likes, err = db.numLikesPerPost(postID)
+	likes, err := api.db.numLikesPerPost(postID)
 	if err != nil {
 		logger.Log.Warn(err)
 	}
@@ -827,19 +723,20 @@ func (api DatabaseAPI) Friends(publicKey string) (followers, following, friends
 	followers = []string{}
 	following = []string{}
 	friends = []string{}
-	db, err := open(api.FileName)
-	if err != nil {
-		return
-	}
-	defer db.Close()
-	followers, err = db.getFollowers(publicKey)
+	followers, err := api.db.getFollowers(publicKey)
 	if err != nil {
 		logger.Log.Warn(err)
 	}
-	following, err = db.getFollowing(publicKey)
+	following, err = api.db.getFollowing(publicKey)
 	if err != nil {
 		logger.Log.Warn(err)
 	}
+	return splitFriends(followers, following)
+}
+
+// splitFriends takes out the people that follow each other from the followers and
+// following, and returns them as friends.
+func splitFriends(followers, following []string) ([]string, []string, []string) {
 	followingMap := make(map[string]struct{})
 	for _, f := range following {
 		followingMap[f] = struct{}{}
@@ -849,7 +746,7 @@ func (api DatabaseAPI) Friends(publicKey string) (followers, following, friends
 		followerMap[f] = struct{}{}
 	}
 
-	friends = make([]string, len(following)+len(followers))
+	friends := make([]string, len(following)+len(followers))
 	i := 0
 	for _, follower := range followers {
 		if _, ok := followingMap[follower]; ok {
@@ -882,87 +779,51 @@ func (api DatabaseAPI) Friends(publicKey string) (followers, following, friends
 		}
 	}
 	following = following[:i]
-	return
+	return followers, following, friends
 }
 
 // GetLatestKeyForFriends will return the latest key for encrypting messages to friends
 func (api DatabaseAPI) GetLatestKeyForFriends(publicKey string) (key keypair.KeyPair, err error) {
-	db, err := open(api.FileName)
-	if err != nil {
-		return
-	}
-	defer db.Close()
-	return db.getKeyForFriends(publicKey)
+	return api.db.getKeyForFriends(publicKey)
 }
 
 // DeleteUsersOldestPost will delete the users oldest post
 func (api DatabaseAPI) DeleteUsersOldestPost(publicKey string) (err error) {
-	db, err := open(api.FileName)
-	if err != nil {
-		return
-	}
-	defer db.Close()
-	return db.deleteUsersOldestPost(publicKey)
+	return api.db.deleteUsersOldestPost(publicKey)
 }
 
 // DeleteUsersOldestPost will delete the users oldest post
 func (api DatabaseAPI) DeleteUsersOldestLargestPost(publicKey string) (err error) {
-	db, err := open(api.FileName)
-	if err != nil {
-		return
-	}
-	defer db.Close()
-	return db.deleteUsersOldestLargestPost(publicKey)
+	return api.db.deleteUsersOldestLargestPost(publicKey)
 }
 
 // DeleteUsersEdits will delete the users edits made to posts
 func (api DatabaseAPI) DeleteUsersEdits(publicKey string) (err error) {
-	db, err := open(api.FileName)
-	if err != nil {
-		return
-	}
-	defer db.Close()
-	return db.deleteUsersEdits(publicKey)
+	return api.db.deleteUsersEdits(publicKey)
 }
 
 // DeleteOldActions will delete all the old actions of a user
 func (api DatabaseAPI) DeleteOldActions(publicKey string) (err error) {
-	db, err := open(api.FileName)
-	if err != nil {
-		return
-	}
-	defer db.Close()
-	err = db.deleteUsersOldActions(publicKey, purpose.ActionName)
-	if err != nil {
-		return
-	}
-	err = db.deleteUsersOldActions(publicKey, purpose.ActionProfile)
-	if err != nil {
-		return
-	}
-	err = db.deleteUsersOldActions(publicKey, purpose.ActionImage)
-	if err != nil {
-		return
+	for _, p := range purpose.WithStorage(purpose.StorageLatest) {
+		err = api.db.deleteUsersOldActions(publicKey, p)
+		if err != nil {
+			return
+		}
 	}
 	return
 }
 
 // DeleteProfiles will delete everything for all users that have submitted an action-erase
 func (api DatabaseAPI) DeleteProfiles() (err error) {
-	db, err := open(api.FileName)
-	if err != nil {
-		return
-	}
-	defer db.Close()
-	return db.deleteUsers()
+	return api.db.deleteUsers()
+}
+
+// DeleteExpired will delete the envelopes that have expired
+func (api DatabaseAPI) DeleteExpired() (err error) {
+	return api.db.deleteExpired()
 }
 
 // DeleteUser will delete everything for all users that have submitted an action-erase
 func (api DatabaseAPI) DeleteUser(publicKey string) (err error) {
-	db, err := open(api.FileName)
-	if err != nil {
-		return
-	}
-	defer db.Close()
-	return db.deleteUser(publicKey)
+	return api.db.deleteUser(publicKey)
 }
@@ -1,18 +1,116 @@
 package database
 
 import (
+	"fmt"
+	"io/ioutil"
 	"os"
+	"path/filepath"
+	"sync"
 	"testing"
 
+	"github.com/schollz/kiki/src/keypair"
+	"github.com/schollz/kiki/src/letter"
+	"github.com/schollz/kiki/src/purpose"
 	"github.com/stretchr/testify/assert"
 )
 
 func init() {
 	logger.SetLevel("error")
 }
+
+// testDatabase opens a new database in a temporary directory and fills it with
+// posts from a couple of users that follow each other.
+func testDatabase(tb testing.TB) (api DatabaseAPI, ids []string, cleanup func()) {
+	dir, err := ioutil.TempDir("", "kiki-database")
+	if err != nil {
+		tb.Fatal(err)
+	}
+	api, err = Setup(filepath.Join(dir, "kiki.db"))
+	if err != nil {
+		tb.Fatal(err)
+	}
+	cleanup = func() {
+		api.Close()
+		os.RemoveAll(dir)
+	}
+	ids = fillStore(tb, api, testEnvelopes(tb))
+	return
+}
+
+// testEnvelopes makes opened envelopes from a couple of users that follow each other
+// and post with a hashtag.
+func testEnvelopes(tb testing.TB) (es []letter.Envelope) {
+	regionKey := keypair.New()
+	zack := keypair.New()
+	jane := keypair.New()
+	letters := []letter.Letter{
+		{Purpose: purpose.ActionName, Content: "zack"},
+		{Purpose: purpose.ActionFollow, Content: jane.Public},
+	}
+	for i := 0; i < 50; i++ {
+		letters = append(letters, letter.Letter{Purpose: purpose.ShareText, Content: fmt.Sprintf("hello #hashtag %d", i)})
+	}
+	for _, sender := range []keypair.KeyPair{zack, jane} {
+		for _, l := range letters {
+			if l.Purpose == purpose.ActionFollow {
+				l.Content = zack.Public
+				if sender.Public == zack.Public {
+					l.Content = jane.Public
+				}
+			}
+			es = append(es, openedEnvelope(tb, l, sender, regionKey))
+		}
+	}
+	return
+}
+
+// openedEnvelope seals the letter to the region and opens it again.
+func openedEnvelope(tb testing.TB, l letter.Letter, sender, regionKey keypair.KeyPair) letter.Envelope {
+	l.To = []string{regionKey.Public}
+	e, err := l.Seal(sender, regionKey)
+	if err != nil {
+		tb.Fatal(err)
+	}
+	e, err = e.Unseal([]keypair.KeyPair{regionKey}, regionKey)
+	if err != nil {
+		tb.Fatal(err)
+	}
+	return e
+}
+
+// fillStore adds the envelopes to the store and tags the posts with "hashtag".
+func fillStore(tb testing.TB, store Store, es []letter.Envelope) (ids []string) {
+	for _, e := range es {
+		err := store.AddEnvelope(e)
+		if err != nil {
+			tb.Fatal(err)
+		}
+		ids = append(ids, e.ID)
+		if e.Letter.Purpose == purpose.ShareText {
+			err = store.AddTags(map[string][]string{e.ID: {"hashtag"}})
+			if err != nil {
+				tb.Fatal(err)
+			}
+		}
+	}
+	return
+}
+
+// BenchmarkOpening      	    2688	    820258 ns/op
+// BenchmarkGetEnvelope  	   28046	     78326 ns/op
+// BenchmarkGetPosts     	     759	   2954993 ns/op
+// BenchmarkGetUser      	   28543	     91872 ns/op
+// BenchmarkGetFriends   	   37065	     62326 ns/op
+
+// BenchmarkOpening is the cost that every call used to have before the database
+// was kept open.
 func BenchmarkOpening(b *testing.B) {
+	api, _, cleanup := testDatabase(b)
+	api.Close()
+	defer cleanup()
+	b.ResetTimer()
 	for i := 0; i < b.N; i++ {
-		db, err := open("kiki.db")
+		db, err := open(api.FileName)
 		if err != nil {
 			panic(err)
 		}
@@ -20,42 +118,74 @@ func BenchmarkOpening(b *testing.B) {
 	}
 }
 
+func BenchmarkGetEnvelope(b *testing.B) {
+	api, ids, cleanup := testDatabase(b)
+	defer cleanup()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		api.GetEnvelopeFromID(ids[i%len(ids)])
+	}
+}
+
 func BenchmarkGetPosts(b *testing.B) {
-	api := Setup(".", "kiki.db")
+	api, _, cleanup := testDatabase(b)
+	defer cleanup()
+	b.ResetTimer()
 	for i := 0; i < b.N; i++ {
 		api.GetBasicPosts()
 	}
 }
+
+func BenchmarkGetUser(b *testing.B) {
+	api, ids, cleanup := testDatabase(b)
+	defer cleanup()
+	e, _ := api.GetEnvelopeFromID(ids[0])
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		api.GetUser(e.Sender.Public)
+	}
+}
+
 func BenchmarkGetIDs(b *testing.B) {
-	api := Setup(".", "kiki.db")
+	api, _, cleanup := testDatabase(b)
+	defer cleanup()
+	b.ResetTimer()
 	for i := 0; i < b.N; i++ {
 		api.GetIDs()
 	}
 }
 
 func BenchmarkGetHashtags(b *testing.B) {
-	api := Setup(".", "kiki.db")
+	api, _, cleanup := testDatabase(b)
+	defer cleanup()
+	b.ResetTimer()
 	for i := 0; i < b.N; i++ {
 		api.GetEnvelopesFromTag("hashtag")
 	}
 }
 
 func BenchmarkGetHashtags1(b *testing.B) {
-	api := Setup(".", "kiki.db")
+	api, _, cleanup := testDatabase(b)
+	defer cleanup()
+	b.ResetTimer()
 	for i := 0; i < b.N; i++ {
 		api.GetEnvelopesFromTag1("hashtag")
 	}
 }
 
 func BenchmarkGetFriends(b *testing.B) {
-	api := Setup(".", "kiki.db")
+	api, ids, cleanup := testDatabase(b)
+	defer cleanup()
+	e, _ := api.GetEnvelopeFromID(ids[0])
+	b.ResetTimer()
 	for i := 0; i < b.N; i++ {
-		api.Friends("9khErfNFBB6ACNM43vBmcY4YVgQ6aF9CR9qDQWHyF6uW")
+		api.Friends(e.Sender.Public)
 	}
 }
 
 func TestGetVersions(t *testing.T) {
-	api := Setup(".", "kiki.db")
+	api, _, cleanup := testDatabase(t)
+	defer cleanup()
 	s, err := api.GetAllVersions("alskdjflkasjdf")
 	assert.NotNil(t, err)
 	assert.Equal(t, 0, len(s))
@@ -65,22 +195,67 @@ func TestGetVersions(t *testing.T) {
 }
 
 func TestGettingPosts(t *testing.T) {
-	api := Setup(".", "kiki.db")
+	api, _, cleanup := testDatabase(t)
+	defer cleanup()
 	e, err := api.GetBasicPosts()
 	assert.Nil(t, err)
-	assert.True(t, len(e) > 0)
+	assert.Equal(t, 100, len(e))
+
+	e, err = api.GetEnvelopesFromTag1("hashtag")
+	assert.Nil(t, err)
+	assert.Equal(t, 100, len(e))
+}
+
+func TestFriends(t *testing.T) {
+	api, ids, cleanup := testDatabase(t)
+	defer cleanup()
+	e, err := api.GetEnvelopeFromID(ids[0])
+	assert.Nil(t, err)
+	assert.Equal(t, "zack", api.GetName(e.Sender.Public))
+	_, _, friends := api.Friends(e.Sender.Public)
+	assert.Equal(t, 1, len(friends))
+}
+
+func TestConcurrentAccess(t *testing.T) {
+	api, ids, cleanup := testDatabase(t)
+	defer cleanup()
+	var wg sync.WaitGroup
+	for i := 0; i < 8; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			for j := 0; j < 20; j++ {
+				assert.Nil(t, api.Set("counts", fmt.Sprintf("%d-%d", i, j), j))
+				_, err := api.GetEnvelopeFromID(ids[j])
+				assert.Nil(t, err)
+			}
+		}(i)
+	}
+	wg.Wait()
+	keys, err := api.Keys("counts")
+	assert.Nil(t, err)
+	assert.Equal(t, 160, len(keys))
 }
 
 func TestOpenClose(t *testing.T) {
 	os.Remove("kiki.sqlite3.db")
+	defer os.Remove("kiki.sqlite3.db")
+	defer os.Remove("kiki.sqlite3.db.lock")
 	db, err := open("kiki.sqlite3.db")
 	assert.Nil(t, err)
+
+	// only one process can have the database open
+	_, err = open("kiki.sqlite3.db")
+	assert.NotNil(t, err)
+
 	err = db.Close()
 	assert.Nil(t, err)
 }
 
 func TestKeyStore(t *testing.T) {
 	os.Remove("kiki.sqlite3.db")
+	defer os.Remove("kiki.sqlite3.db")
+	defer os.Remove("kiki.sqlite3.db.lock")
 	type A struct {
 		B int
 		C string
@@ -98,4 +273,6 @@ func TestKeyStore(t *testing.T) {
 	err = db.Get("Astuff", "a", &a2)
 	assert.Nil(t, err)
 	assert.Equal(t, a, a2)
+	assert.Nil(t, db.delete("Astuff", "a"))
+	assert.NotNil(t, db.Get("Astuff", "a", &a2))
 }
@@ -0,0 +1,157 @@
+package database
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"strings"
+
+	"github.com/pkg/errors"
+	"github.com/schollz/kiki/src/purpose"
+	"github.com/schollz/kiki/src/symmetric"
+)
+
+// atRestPrefix starts the content and recipients of opened letters that are
+// encrypted at rest.
+const atRestPrefix = "enc1:"
+
+// atRestCheck is kept encrypted in the keystore to tell whether a key is the one that
+// the database was encrypted with.
+const atRestCheck = "kiki"
+
+// encryptedAtRest tells whether opened letters with the purpose are encrypted at rest.
+// Actions are public and are looked up by their content, so they stay in the clear.
+func encryptedAtRest(p string) bool {
+	return !purpose.IsAction(p)
+}
+
+// seal encrypts a value that is stored in the letters table. Empty values are kept
+// empty so that empty posts can still be told apart.
+func (d *database) seal(s string) (string, error) {
+	if d.key == nil || s == "" {
+		return s, nil
+	}
+	encrypted, err := symmetric.Encrypt([]byte(s), *d.key)
+	if err != nil {
+		return s, errors.Wrap(err, "seal")
+	}
+	return atRestPrefix + base64.StdEncoding.EncodeToString(encrypted), nil
+}
+
+// unseal decrypts a value that seal encrypted, and returns any other value as it is.
+func (d *database) unseal(s string) (string, error) {
+	if !strings.HasPrefix(s, atRestPrefix) {
+		return s, nil
+	}
+	if d.key == nil {
+		return s, errors.New("letter is encrypted at rest but there is no key")
+	}
+	encrypted, err := base64.StdEncoding.DecodeString(strings.TrimPrefix(s, atRestPrefix))
+	if err != nil {
+		return s, errors.Wrap(err, "unseal")
+	}
+	decrypted, err := symmetric.Decrypt(encrypted, *d.key)
+	if err != nil {
+		return s, errors.Wrap(err, "unseal")
+	}
+	return string(decrypted), nil
+}
+
+// encryptAtRest starts encrypting opened letters with the key. The first time that
+// the database is opened with a key, the opened letters that are still in the clear
+// are encrypted, and the check that tells the key is kept in the same transaction.
+// Every letter is sealed from then on, so this only happens once, and a database
+// that has been encrypted can only be opened with the same key.
+//
+// This is not one of the migrations, because those are applied once the schema
+// version is behind, while encryption starts whenever a key is first given, which
+// can be long after the schema is up to date.
+func (d *database) encryptAtRest(key *[32]byte) (err error) {
+	var check string
+	errCheck := d.Get("atrest", "check", &check)
+	if key == nil {
+		if errCheck == nil {
+			err = errors.New("database is encrypted at rest and needs a key")
+		}
+		return
+	}
+	d.key = key
+	if errCheck == nil {
+		check, err = d.unseal(check)
+		if err != nil || check != atRestCheck {
+			d.key = nil
+			return errors.New("wrong key for database that is encrypted at rest")
+		}
+		return
+	}
+
+	tx, err := d.writer.Begin()
+	if err != nil {
+		return errors.Wrap(err, "encryptAtRest")
+	}
+	defer tx.Rollback()
+
+	type row struct {
+		id, to, content string
+	}
+	var rs []row
+	rows, err := tx.Query("SELECT id, letter_to, letter_content FROM letters WHERE opened == 1 AND letter_purpose NOT LIKE 'action-%' AND (letter_to NOT LIKE ? OR (letter_content != '' AND letter_content NOT LIKE ?))", atRestPrefix+"%", atRestPrefix+"%")
+	if err != nil {
+		return errors.Wrap(err, "encryptAtRest")
+	}
+	for rows.Next() {
+		var r row
+		err = rows.Scan(&r.id, &r.to, &r.content)
+		if err != nil {
+			rows.Close()
+			return errors.Wrap(err, "encryptAtRest")
+		}
+		rs = append(rs, r)
+	}
+	rows.Close()
+	if len(rs) > 0 {
+		log.Infof("encrypting %d letters at rest", len(rs))
+	}
+	for _, r := range rs {
+		if !strings.HasPrefix(r.to, atRestPrefix) {
+			r.to, err = d.seal(r.to)
+			if err != nil {
+				return
+			}
+		}
+		if !strings.HasPrefix(r.content, atRestPrefix) {
+			r.content, err = d.seal(r.content)
+			if err != nil {
+				return
+			}
+		}
+		_, err = tx.Exec("UPDATE letters SET letter_to = ?, letter_content = ? WHERE id = ?", r.to, r.content, r.id)
+		if err != nil {
+			return errors.Wrap(err, "encryptAtRest")
+		}
+	}
+
+	check, err = d.seal(atRestCheck)
+	if err != nil {
+		return
+	}
+	b, _ := json.Marshal(check)
+	_, err = tx.Exec("INSERT OR REPLACE INTO keystore(bucket_key,value) VALUES ('atrest/check', ?)", string(b))
+	if err != nil {
+		return errors.Wrap(err, "encryptAtRest")
+	}
+	err = tx.Commit()
+	if err != nil || len(rs) == 0 {
+		return
+	}
+
+	// the letters in the clear are left in free pages until the database is vacuumed
+	_, err = d.writer.Exec("VACUUM")
+	if err != nil {
+		return errors.Wrap(err, "encryptAtRest")
+	}
+	_, err = d.writer.Exec("PRAGMA wal_checkpoint(TRUNCATE)")
+	if err != nil {
+		return errors.Wrap(err, "encryptAtRest")
+	}
+	return
+}
@@ -0,0 +1,86 @@
+package database
+
+import (
+	"database/sql"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// rawLetters reads the content and recipients of the opened letters as they are on disk.
+func rawLetters(t *testing.T, fileName string) (letters map[string][2]string) {
+	db, err := sql.Open("sqlite3", fileName)
+	assert.Nil(t, err)
+	defer db.Close()
+	rows, err := db.Query("SELECT letter_purpose, letter_to, letter_content FROM letters WHERE opened == 1")
+	assert.Nil(t, err)
+	defer rows.Close()
+	letters = make(map[string][2]string)
+	for rows.Next() {
+		var p, to, content string
+		assert.Nil(t, rows.Scan(&p, &to, &content))
+		letters[content] = [2]string{p, to}
+	}
+	return
+}
+
+func TestEncryptAtRest(t *testing.T) {
+	es, _, _, _ := storeEnvelopes(t)
+	key := [32]byte{1, 2, 3}
+	dir, err := ioutil.TempDir("", "kiki-atrest")
+	assert.Nil(t, err)
+	defer os.RemoveAll(dir)
+	fileName := filepath.Join(dir, "kiki.db")
+
+	// letters that were stored in the clear are encrypted once there is a key
+	api, err := Setup(fileName)
+	assert.Nil(t, err)
+	fillStore(t, api, es[:10])
+	assert.Nil(t, api.Close())
+	api, err = Setup(fileName, key)
+	assert.Nil(t, err)
+	fillStore(t, api, es[10:])
+	assert.Nil(t, api.Close())
+
+	b, err := ioutil.ReadFile(fileName)
+	assert.Nil(t, err)
+	assert.NotContains(t, string(b), "first edited")
+	for content, letter := range rawLetters(t, fileName) {
+		if strings.HasPrefix(letter[0], "action-") {
+			assert.False(t, strings.HasPrefix(content, atRestPrefix), content)
+			continue
+		}
+		assert.NotContains(t, content, "first", content)
+		assert.NotContains(t, content, "reply", content)
+		if content != "" {
+			assert.True(t, strings.HasPrefix(content, atRestPrefix), content)
+		}
+		assert.True(t, strings.HasPrefix(letter[1], atRestPrefix), letter[1])
+	}
+
+	// the letters are read back in the clear
+	api, err = Setup(fileName, key)
+	assert.Nil(t, err)
+	e, err := api.GetLatestEnvelopeFromID(es[6].ID)
+	assert.Nil(t, err)
+	assert.Equal(t, "first edited #hashtag", e.Letter.Content)
+	assert.Equal(t, es[6].Letter.To, e.Letter.To)
+	posts, err := api.GetPostForApi(es[6].ID)
+	assert.Nil(t, err)
+	assert.Equal(t, "first edited #hashtag", posts[0].Content)
+	assert.Equal(t, es[6].Letter.To, posts[0].Recipients)
+	keys, err := api.GetKeys()
+	assert.Nil(t, err)
+	assert.Equal(t, 2, len(keys))
+	assert.Nil(t, api.Close())
+
+	// the database can not be opened with another key, or without one
+	_, err = Setup(fileName, [32]byte{3, 2, 1})
+	assert.NotNil(t, err)
+	_, err = Setup(fileName)
+	assert.NotNil(t, err)
+}
@@ -5,6 +5,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"os"
+	"strings"
 	"time"
 
 	"github.com/cihub/seelog"
@@ -25,7 +26,10 @@ var (
 type database struct {
 	name     string
 	db       *sql.DB
+	writer   *sql.DB
 	fileLock *flock.Flock
+	// key encrypts opened letters at rest, if it is set
+	key *[32]byte
 }
 
 func init() {
@@ -42,7 +46,9 @@ func Debug(verbose bool) {
 	log = logger.Log
 }
 
-// open will open the database for transactions by first aquiring a filelock.
+// open will open the database, first aquiring a filelock that is held until it is
+// closed so that no other process can use the same database. Reads can happen at the
+// same time, and all writes go through a single connection.
 func open(fileName string, readOnly ...bool) (d *database, err error) {
 	d = new(database)
 	// convert the name to base64 for file writing
@@ -56,12 +62,14 @@ func open(fileName string, readOnly ...bool) (d *database, err error) {
 
 	// obtain a lock on the database
 	d.fileLock = flock.NewFlock(d.name + ".lock")
-	for {
-		locked, err := d.fileLock.TryLock()
-		if err == nil && locked {
-			break
-		}
-		time.Sleep(10 * time.Millisecond)
+	locked, err := d.fileLock.TryLock()
+	if err != nil {
+		err = errors.Wrap(err, "could not lock database")
+		return
+	}
+	if !locked {
+		err = errors.New(fmt.Sprintf("database '%s' is being used by another process", d.name))
+		return
 	}
 
 	// check if it is a new database
@@ -70,9 +78,22 @@ func open(fileName string, readOnly ...bool) (d *database, err error) {
 		newDatabase = true
 	}
 
-	// open sqlite3 database
-	d.db, err = sql.Open("sqlite3", d.name)
+	// open sqlite3 database, one connection for writing and a pool for reading
+	d.writer, err = sql.Open("sqlite3", d.name+"?_busy_timeout=5000&_txlock=immediate")
+	if err != nil {
+		d.fileLock.Unlock()
+		return
+	}
+	d.writer.SetMaxOpenConns(1)
+	_, err = d.writer.Exec("PRAGMA journal_mode=WAL")
+	if err != nil {
+		d.Close()
+		err = errors.Wrap(err, "could not set WAL mode")
+		return
+	}
+	d.db, err = sql.Open("sqlite3", d.name+"?_busy_timeout=5000")
 	if err != nil {
+		d.Close()
 		return
 	}
 
@@ -80,24 +101,38 @@ func open(fileName string, readOnly ...bool) (d *database, err error) {
 	if newDatabase {
 		err = d.MakeTables()
 		if err != nil {
+			d.Close()
 			return
 		}
 	}
 
+	// bring the schema up to date
+	err = d.migrate()
+	if err != nil {
+		d.Close()
+		return
+	}
+
 	return
 }
 
-// Close will close the database connection and remove the filelock.
+// Close will close the database connections and remove the filelock.
 func (d *database) Close() (err error) {
-	// close filelock
-	err = d.fileLock.Unlock()
-	if err != nil {
+	// close database before letting go of the lock, and leave the lock file
+	// in place so that anyone waiting on it keeps waiting on the same file
+	if d.db != nil {
+		err = d.db.Close()
+		if err != nil {
+			log.Error(err)
+		}
+	}
+	err2 := d.writer.Close()
+	if err2 != nil {
+		err = err2
 		log.Error(err)
-	} else {
-		os.Remove(d.name + ".lock")
 	}
-	// close database
-	err2 := d.db.Close()
+	// close filelock
+	err2 = d.fileLock.Unlock()
 	if err2 != nil {
 		err = err2
 		log.Error(err)
@@ -105,40 +140,41 @@ func (d *database) Close() (err error) {
 	return
 }
 
-// MakeTables creates two tables, a `keystore` table:
+// MakeTables creates the tables of the first version of the schema, which are then
+// brought up to date with the migrations. There is a `keystore` table:
 //
 // 	BUCKET_KEY (TEXT)	VALUE (TEXT)
 //
 // and also a `letters`:
 func (d *database) MakeTables() (err error) {
 	sqlStmt := `CREATE TABLE tags (tag TEXT, e_id TEXT);`
-	_, err = d.db.Exec(sqlStmt)
+	_, err = d.writer.Exec(sqlStmt)
 	if err != nil {
 		err = errors.Wrap(err, "MakeTables")
 		return
 	}
 	sqlStmt = `CREATE index tags_idx on tags(tag,e_id);`
-	_, err = d.db.Exec(sqlStmt)
+	_, err = d.writer.Exec(sqlStmt)
 	if err != nil {
 		err = errors.Wrap(err, "MakeTables")
 		return
 	}
 
 	sqlStmt = `create table keystore (bucket_key text not null primary key, value text);`
-	_, err = d.db.Exec(sqlStmt)
+	_, err = d.writer.Exec(sqlStmt)
 	if err != nil {
 		err = errors.Wrap(err, "MakeTables")
 		return
 	}
 	sqlStmt = `create index keystore_idx on keystore(bucket_key);`
-	_, err = d.db.Exec(sqlStmt)
+	_, err = d.writer.Exec(sqlStmt)
 	if err != nil {
 		err = errors.Wrap(err, "MakeTables")
 		return
 	}
 	// The "letters" table contains all the envelopes (opened and unopened) and their respective inforamtion in the letters.
 	sqlStmt = `create table letters (id text not null primary key, time TIMESTAMP, sender text, signature text, sealed_recipients text, sealed_letter text, opened integer, letter_purpose text, letter_to text, letter_content text, letter_firstid text, letter_replyto text, unique(id), UNIQUE(signature));`
-	_, err = d.db.Exec(sqlStmt)
+	_, err = d.writer.Exec(sqlStmt)
 	if err != nil {
 		err = errors.Wrap(err, "MakeTables, letters")
 		return
@@ -146,7 +182,7 @@ func (d *database) MakeTables() (err error) {
 
 	// indices
 	sqlStmt = `CREATE INDEX idx_sender ON letters(opened,letter_purpose,sender,letter_content);`
-	_, err = d.db.Exec(sqlStmt)
+	_, err = d.writer.Exec(sqlStmt)
 	if err != nil {
 		err = errors.Wrap(err, "MakeTables, letters")
 		return
@@ -154,7 +190,7 @@ func (d *database) MakeTables() (err error) {
 
 	// indices
 	sqlStmt = `CREATE INDEX idx_content ON letters(opened,letter_purpose,letter_content,id,letter_replyto);`
-	_, err = d.db.Exec(sqlStmt)
+	_, err = d.writer.Exec(sqlStmt)
 	if err != nil {
 		err = errors.Wrap(err, "MakeTables, letters")
 		return
@@ -162,7 +198,7 @@ func (d *database) MakeTables() (err error) {
 
 	// indices
 	sqlStmt = `CREATE INDEX idx_replyto ON letters(opened,letter_purpose,letter_replyto);`
-	_, err = d.db.Exec(sqlStmt)
+	_, err = d.writer.Exec(sqlStmt)
 	if err != nil {
 		err = errors.Wrap(err, "MakeTables, letters")
 		return
@@ -170,7 +206,7 @@ func (d *database) MakeTables() (err error) {
 
 	// indices
 	sqlStmt = `CREATE INDEX idx_replaces ON letters(opened,letter_firstid);`
-	_, err = d.db.Exec(sqlStmt)
+	_, err = d.writer.Exec(sqlStmt)
 	if err != nil {
 		err = errors.Wrap(err, "MakeTables, letters")
 		return
@@ -178,7 +214,7 @@ func (d *database) MakeTables() (err error) {
 
 	// indices
 	sqlStmt = `CREATE INDEX idx_purpose ON letters(sender,letter_purpose);`
-	_, err = d.db.Exec(sqlStmt)
+	_, err = d.writer.Exec(sqlStmt)
 	if err != nil {
 		err = errors.Wrap(err, "MakeTables, letters")
 		return
@@ -192,10 +228,11 @@ func (d *database) AddTag(tag, id string) (err error) {
 	if exists := d.TagExists(tag, id); exists {
 		return
 	}
-	tx, err := d.db.Begin()
+	tx, err := d.writer.Begin()
 	if err != nil {
 		return errors.Wrap(err, "AddTag")
 	}
+	defer tx.Rollback()
 	stmt, err := tx.Prepare("INSERT INTO tags(tag,e_id) values (?, ?)")
 	if err != nil {
 		return errors.Wrap(err, "AddTag")
@@ -277,6 +314,35 @@ func (d *database) Get(bucket, key string, v interface{}) (err error) {
 	return
 }
 
+// keys will list the keys in a bucket of the keystore.
+func (d *database) keys(bucket string) (keys []string, err error) {
+	stmt, err := d.db.Prepare("select bucket_key from keystore where bucket_key LIKE ?")
+	if err != nil {
+		return nil, errors.Wrap(err, "problem preparing SQL")
+	}
+	defer stmt.Close()
+	rows, err := stmt.Query(bucket + "/%")
+	if err != nil {
+		return nil, errors.Wrap(err, "problem listing keys")
+	}
+	defer rows.Close()
+	keys = []string{}
+	for rows.Next() {
+		var bucketKey string
+		err = rows.Scan(&bucketKey)
+		if err != nil {
+			return nil, errors.Wrap(err, "keys")
+		}
+		// LIKE also treats "_" as a wildcard
+		if !strings.HasPrefix(bucketKey, bucket+"/") {
+			continue
+		}
+		keys = append(keys, bucketKey[len(bucket)+1:])
+	}
+	err = rows.Err()
+	return
+}
+
 // Set will set a value in the database, when using it like a keystore.
 func (d *database) Set(bucket, key string, value interface{}) (err error) {
 	var b []byte
@@ -284,10 +350,11 @@ func (d *database) Set(bucket, key string, value interface{}) (err error) {
 	if err != nil {
 		return err
 	}
-	tx, err := d.db.Begin()
+	tx, err := d.writer.Begin()
 	if err != nil {
 		return errors.Wrap(err, "Set")
 	}
+	defer tx.Rollback()
 	stmt, err := tx.Prepare("insert or replace into keystore(bucket_key,value) values (?, ?)")
 	if err != nil {
 		return errors.Wrap(err, "Set")
@@ -307,12 +374,22 @@ func (d *database) Set(bucket, key string, value interface{}) (err error) {
 	return
 }
 
+// delete will delete a key from the keystore.
+func (d *database) delete(bucket, key string) (err error) {
+	_, err = d.writer.Exec("delete from keystore where bucket_key = ?", bucket+"/"+key)
+	if err != nil {
+		return errors.Wrap(err, "delete")
+	}
+	return
+}
+
 // addEnvelope will add or replace an envelope
 func (d *database) addEnvelope(e letter.Envelope) (err error) {
-	tx, err := d.db.Begin()
+	tx, err := d.writer.Begin()
 	if err != nil {
 		return
 	}
+	defer tx.Rollback()
 	var opened int
 	// marshaled things
 	var mSealedRecipients, mTo string
@@ -334,14 +411,59 @@ func (d *database) addEnvelope(e letter.Envelope) (err error) {
 		return errors.Wrap(err, "problem marshaling To")
 	}
 	mTo = string(b)
+	content := e.Letter.Content
+	if e.Opened && encryptedAtRest(e.Letter.Purpose) {
+		mTo, err = d.seal(mTo)
+		if err != nil {
+			return
+		}
+		content, err = d.seal(content)
+		if err != nil {
+			return
+		}
+	}
+
+	// keep the order in which the envelope was first received, which is used to
+	// tell peers what is new since they last synced
+	var received int64
+	err = tx.QueryRow("SELECT received FROM letters WHERE id = ?", e.ID).Scan(&received)
+	if err == sql.ErrNoRows {
+		err = tx.QueryRow("SELECT IFNULL(MAX(received),0) FROM letters").Scan(&received)
+		if err != nil {
+			tx.Rollback()
+			return errors.Wrap(err, "problem getting received")
+		}
+		var counter int64
+		var mCounter string
+		if tx.QueryRow("SELECT value FROM keystore WHERE bucket_key = 'globals/received'").Scan(&mCounter) == nil {
+			json.Unmarshal([]byte(mCounter), &counter)
+		}
+		if counter > received {
+			received = counter
+		}
+		received++
+		_, err = tx.Exec("insert or replace into keystore(bucket_key,value) values ('globals/received', ?)", fmt.Sprintf("%d", received))
+	}
+	if err != nil {
+		tx.Rollback()
+		return errors.Wrap(err, "problem setting received")
+	}
+
+	// envelopes that do not expire are kept with an expiry of 0
+	var expires int64
+	if !e.Expires.IsZero() {
+		expires = e.Expires.UnixNano()
+	}
 
-	stmt, err := tx.Prepare("insert or replace into letters(id,time,sender,signature,sealed_recipients,sealed_letter,opened,letter_purpose,letter_to,letter_content,letter_firstid,letter_replyto) values(?,?,?,?,?,?,?,?,?,?,?,?)")
+	stmt, err := tx.Prepare("insert or replace into letters(id,time,sender,signature,sealed_recipients,sealed_letter,opened,letter_purpose,letter_to,letter_content,letter_firstid,letter_replyto,version,signing_key,envelope_signature,received,expires,stamp) values(?,?,?,?,?,?,?,?,?,?,?,?,?,?,?,?,?,?)")
 	if err != nil {
+		tx.Rollback()
 		return
 	}
 	defer stmt.Close()
-	_, err = stmt.Exec(e.ID, e.Timestamp, e.Sender.Public, e.Signature, mSealedRecipients, e.SealedLetter, opened, e.Letter.Purpose, mTo, e.Letter.Content, e.Letter.FirstID, e.Letter.ReplyTo)
+	_, err = stmt.Exec(e.ID, e.Timestamp, e.Sender.Public, e.Signature, mSealedRecipients, e.SealedLetter, opened, e.Letter.Purpose, mTo, content, e.Letter.FirstID, e.Letter.ReplyTo, e.Version, e.SigningKey, e.EnvelopeSignature, received, expires, e.Stamp)
 	if err != nil {
+		tx.Rollback()
 		return
 	}
 	tx.Commit()
@@ -396,16 +518,34 @@ func (d *database) getRows(rows *sql.Rows) (s []letter.Envelope, err error) {
 		var opened int
 		// marshaled things
 		var mSender, mSealedRecipients, mTo string
-		err = rows.Scan(&e.ID, &e.Timestamp, &mSender, &e.Signature, &mSealedRecipients, &e.SealedLetter, &opened, &e.Letter.Purpose, &mTo, &e.Letter.Content, &e.Letter.FirstID, &e.Letter.ReplyTo)
+		var received, expires int64
+		err = rows.Scan(&e.ID, &e.Timestamp, &mSender, &e.Signature, &mSealedRecipients, &e.SealedLetter, &opened, &e.Letter.Purpose, &mTo, &e.Letter.Content, &e.Letter.FirstID, &e.Letter.ReplyTo, &e.Version, &e.SigningKey, &e.EnvelopeSignature, &received, &expires, &e.Stamp)
+		if err != nil {
+			err = errors.Wrap(err, "getRows")
+			return
+		}
 		e.Sender, err = keypair.FromPublic(mSender)
-		json.Unmarshal([]byte(mSealedRecipients), &e.SealedRecipients)
-		json.Unmarshal([]byte(mTo), &e.Letter.To)
-
-		e.Opened = opened == 1
 		if err != nil {
 			err = errors.Wrap(err, "getRows")
 			return
 		}
+		mTo, err = d.unseal(mTo)
+		if err != nil {
+			err = errors.Wrap(err, "getRows")
+			return
+		}
+		e.Letter.Content, err = d.unseal(e.Letter.Content)
+		if err != nil {
+			err = errors.Wrap(err, "getRows")
+			return
+		}
+		json.Unmarshal([]byte(mSealedRecipients), &e.SealedRecipients)
+		json.Unmarshal([]byte(mTo), &e.Letter.To)
+		if expires > 0 {
+			e.Expires = time.Unix(0, expires).UTC()
+		}
+
+		e.Opened = opened == 1
 
 		s = append(s, e)
 	}
@@ -444,6 +584,11 @@ func (d *database) getKeys(sender ...string) (s []keypair.KeyPair, err error) {
 			return
 		}
 
+		mKeyPair, err = d.unseal(mKeyPair)
+		if err != nil {
+			err = errors.Wrap(err, "getKeys")
+			return
+		}
 		var kp keypair.KeyPair
 		err = json.Unmarshal([]byte(mKeyPair), &kp)
 		if err != nil {
@@ -491,6 +636,39 @@ func (d *database) getIDs(sender ...string) (s []string, err error) {
 	return
 }
 
+// getIDsSince returns the envelope IDs that were received after the cursor, in the order
+// they were received, along with the cursor of the latest envelope. Envelopes that have
+// expired are left out.
+func (d *database) getIDsSince(cursor int64) (s []string, latest int64, err error) {
+	s = []string{}
+	latest = cursor
+	rows, err := d.db.Query("SELECT id, received FROM letters WHERE received > ? AND (expires == 0 OR expires > ?) ORDER BY received", cursor, time.Now().UnixNano())
+	if err != nil {
+		err = errors.Wrap(err, "getIDsSince")
+		return
+	}
+	defer rows.Close()
+
+	// loop through rows
+	for rows.Next() {
+		var mID string
+		var received int64
+		err = rows.Scan(&mID, &received)
+		if err != nil {
+			err = errors.Wrap(err, "getIDsSince")
+			return
+		}
+		s = append(s, mID)
+		latest = received
+	}
+
+	err = rows.Err()
+	if err != nil {
+		err = errors.Wrap(err, "getIDsSince")
+	}
+	return
+}
+
 // getName returns the name of a person
 func (d *database) getName(person string) (name string, err error) {
 	query := fmt.Sprintf("SELECT letter_content FROM letters WHERE opened == 1 AND letter_purpose == '%s' AND sender == '%s' ORDER BY time DESC;", purpose.ActionName, person)
@@ -576,7 +754,8 @@ func (d *database) getProfileImage(person string) (imageID string, err error) {
 }
 
 func (d *database) getFriendsName(publicKey string) (name string) {
-	query := "SELECT sender FROM letters WHERE opened == 1 AND letter_purpose == '" + purpose.ShareKey + "' AND letter_content LIKE '%" + publicKey + "%' LIMIT 1;"
+	// the shared keys are looked through here, as they may be encrypted at rest
+	query := "SELECT sender, letter_content FROM letters WHERE opened == 1 AND letter_purpose == '" + purpose.ShareKey + "';"
 	logger.Log.Debug(query)
 	rows, err := d.db.Query(query)
 	if err != nil {
@@ -586,7 +765,15 @@ func (d *database) getFriendsName(publicKey string) (name string) {
 
 	var sender string
 	for rows.Next() {
-		rows.Scan(&sender)
+		var keySender, content string
+		if rows.Scan(&keySender, &content) != nil {
+			continue
+		}
+		content, err = d.unseal(content)
+		if err == nil && !isGroupKey(content) && strings.Contains(content, publicKey) {
+			sender = keySender
+			break
+		}
 	}
 	if sender == "" {
 		return
@@ -602,10 +789,11 @@ func (d *database) getFriendsName(publicKey string) (name string) {
 
 // deleteLetterFromID will delete a letter with the pertaining ID.
 func (d *database) deleteLetterFromID(id string) (err error) {
-	tx, err := d.db.Begin()
+	tx, err := d.writer.Begin()
 	if err != nil {
 		return errors.Wrap(err, "deleteLetterFromID")
 	}
+	defer tx.Rollback()
 	query := "DELETE FROM letters WHERE id == ?"
 	logger.Log.Debug(query)
 	stmt, err := tx.Prepare(query)
@@ -629,10 +817,11 @@ func (d *database) deleteLetterFromID(id string) (err error) {
 
 // deleteLettersFromSender will delete a letter with the pertaining ID.
 func (d *database) deleteLettersFromSender(sender string) (err error) {
-	tx, err := d.db.Begin()
+	tx, err := d.writer.Begin()
 	if err != nil {
 		return errors.Wrap(err, "deleteLettersFromSender")
 	}
+	defer tx.Rollback()
 	query := "DELETE FROM letters WHERE sender == ?"
 	logger.Log.Debug(query, sender)
 	stmt, err := tx.Prepare(query)
@@ -656,12 +845,15 @@ func (d *database) deleteLettersFromSender(sender string) (err error) {
 
 // deleteUsersOldestPost will delete a letter with the pertaining ID.
 func (d *database) deleteUsersOldestPost(publicKey string) (err error) {
-	tx, err := d.db.Begin()
+	tx, err := d.writer.Begin()
 	if err != nil {
 		return errors.Wrap(err, "deleteUsersOldestPost")
 	}
+	defer tx.Rollback()
 	logger.Log.Debug(publicKey)
-	query := "DELETE from letters WHERE id in (SELECT id FROM letters WHERE letter_purpose IN ('share-text','share-image/png','share-image/jpg','') AND sender == ? ORDER BY time LIMIT 1);"
+	// letters that are not opened have no purpose, and are purged like posts
+	posts := append(purpose.WithStorage(purpose.StoragePost), "")
+	query := "DELETE from letters WHERE id in (SELECT id FROM letters WHERE letter_purpose IN ('" + strings.Join(posts, "','") + "') AND sender == ? ORDER BY time LIMIT 1);"
 	logger.Log.Debug(query)
 	stmt, err := tx.Prepare(query)
 	if err != nil {
@@ -683,10 +875,11 @@ func (d *database) deleteUsersOldestPost(publicKey string) (err error) {
 
 // deleteUsersOldestPost will delete a letter with the pertaining ID.
 func (d *database) deleteUsersOldestLargestPost(publicKey string) (err error) {
-	tx, err := d.db.Begin()
+	tx, err := d.writer.Begin()
 	if err != nil {
 		return errors.Wrap(err, "deleteUsersOldestLargestPost")
 	}
+	defer tx.Rollback()
 	logger.Log.Debug(publicKey)
 	query := "DELETE from letters WHERE id in (SELECT id FROM letters WHERE LENGTH(sealed_letter) > 5000 AND sender == ? ORDER BY time LIMIT 1);"
 	logger.Log.Debug(query)
@@ -710,10 +903,11 @@ func (d *database) deleteUsersOldestLargestPost(publicKey string) (err error) {
 
 // deleteUser will delete everything except the ActionErases
 func (d *database) deleteUsers() (err error) {
-	tx, err := d.db.Begin()
+	tx, err := d.writer.Begin()
 	if err != nil {
 		return errors.Wrap(err, "deleteUser")
 	}
+	defer tx.Rollback()
 	query := "DELETE FROM letters WHERE sender IN (SELECT sender FROM letters WHERE letter_purpose == '" + purpose.ActionErase + "');"
 	logger.Log.Debug(query)
 	stmt, err := tx.Prepare(query)
@@ -736,10 +930,11 @@ func (d *database) deleteUsers() (err error) {
 
 // deleteUser will delete everything except the ActionErases
 func (d *database) deleteUser(publicKey string) (err error) {
-	tx, err := d.db.Begin()
+	tx, err := d.writer.Begin()
 	if err != nil {
 		return errors.Wrap(err, "deleteUser")
 	}
+	defer tx.Rollback()
 	query := "DELETE FROM letters WHERE sender == ?;"
 	logger.Log.Debug(query)
 	stmt, err := tx.Prepare(query)
@@ -760,12 +955,40 @@ func (d *database) deleteUser(publicKey string) (err error) {
 	return
 }
 
+// deleteExpired will delete the envelopes that have expired
+func (d *database) deleteExpired() (err error) {
+	tx, err := d.writer.Begin()
+	if err != nil {
+		return errors.Wrap(err, "deleteExpired")
+	}
+	defer tx.Rollback()
+	query := "DELETE FROM letters WHERE expires > 0 AND expires <= ?;"
+	logger.Log.Debug(query)
+	stmt, err := tx.Prepare(query)
+	if err != nil {
+		return errors.Wrap(err, "deleteExpired")
+	}
+	defer stmt.Close()
+
+	_, err = stmt.Exec(time.Now().UnixNano())
+	if err != nil {
+		return errors.Wrap(err, "deleteExpired")
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		return errors.Wrap(err, "deleteExpired")
+	}
+	return
+}
+
 // deleteUsersOldActions will delete old actions and leave only the most recent action undeleted
 func (d *database) deleteUsersOldActions(publicKey string, purpose string) (err error) {
-	tx, err := d.db.Begin()
+	tx, err := d.writer.Begin()
 	if err != nil {
 		return errors.Wrap(err, "deleteUsersOldActions")
 	}
+	defer tx.Rollback()
 	logger.Log.Debug(publicKey, purpose)
 	query := "DELETE FROM letters WHERE id in (SELECT id FROM letters WHERE opened == 1 AND letter_purpose == ? AND sender == ? ORDER BY time DESC LIMIT 1000000000 OFFSET 1);"
 	logger.Log.Debug(query)
@@ -837,8 +1060,20 @@ func (d *database) diskSpaceForUser(user string) (diskSpace int64, err error) {
 	return
 }
 
+// actionsInEffect selects the sender, content and received order of the actions that
+// are in effect. An action is in effect if it is the latest of the action and the
+// action that undoes it, for its sender and content. The condition is on the action
+// letters, which are called `a`.
+func actionsInEffect(action, undo, where string) string {
+	return fmt.Sprintf(`SELECT a.sender AS sender, a.letter_content AS letter_content, a.received AS received
+		FROM letters AS a WHERE a.opened == 1 AND a.letter_purpose == '%[1]s' AND %[3]s AND NOT EXISTS (
+			SELECT 1 FROM letters AS b WHERE b.opened == 1 AND b.letter_purpose IN ('%[1]s', '%[2]s')
+			AND b.sender == a.sender AND b.letter_content == a.letter_content
+			AND (b.time > a.time OR (b.time == a.time AND b.id > a.id)))`, action, undo, where)
+}
+
 func (d *database) numLikesPerPost(idPost string) (likes int64, err error) {
-	stmt, err := d.db.Prepare("SELECT COUNT(id) FROM letters WHERE opened == 1 AND letter_purpose == '" + purpose.ActionLike + "' AND letter_content=?")
+	stmt, err := d.db.Prepare("SELECT COUNT(*) FROM (" + actionsInEffect(purpose.ActionLike, purpose.ActionUnlike, "a.letter_content == ?") + ")")
 	if err != nil {
 		err = errors.Wrap(err, "problem preparing SQL")
 		return
@@ -879,9 +1114,9 @@ func (d *database) listUsers() (s []string, err error) {
 }
 
 func (d *database) listBlockedUsers(publicKey string) (s []string, err error) {
-	query := fmt.Sprintf("SELECT letter_content FROM letters WHERE opened == 1 AND letter_purpose == '%s' AND sender == '%s' AND letter_content != '';", purpose.ActionBlock, publicKey)
+	query := "SELECT letter_content FROM (" + actionsInEffect(purpose.ActionBlock, purpose.ActionUnblock, "a.sender == ? AND a.letter_content != ''") + ") ORDER BY received;"
 	logger.Log.Debug(query)
-	rows, err := d.db.Query(query)
+	rows, err := d.db.Query(query, publicKey)
 	if err != nil {
 		err = errors.Wrap(err, "listBlockedUsers")
 		return
@@ -907,9 +1142,9 @@ func (d *database) listBlockedUsers(publicKey string) (s []string, err error) {
 }
 
 func (d *database) getFollowing(publicKey string) (s []string, err error) {
-	query := fmt.Sprintf("SELECT DISTINCT(letter_content) FROM letters WHERE opened == 1 AND letter_purpose == '%s' AND sender == '%s' ;", purpose.ActionFollow, publicKey)
+	query := "SELECT letter_content FROM (" + actionsInEffect(purpose.ActionFollow, purpose.ActionUnfollow, "a.sender == ?") + ") ORDER BY received;"
 	logger.Log.Debug(query)
-	rows, err := d.db.Query(query)
+	rows, err := d.db.Query(query, publicKey)
 	if err != nil {
 		err = errors.Wrap(err, "getFollowing")
 		return
@@ -938,9 +1173,9 @@ func (d *database) getFollowing(publicKey string) (s []string, err error) {
 }
 
 func (d *database) getFollowers(publicKey string) (s []string, err error) {
-	query := fmt.Sprintf("SELECT DISTINCT(sender) FROM letters WHERE opened==1 AND letter_purpose == '%s' AND letter_content == '%s';", purpose.ActionFollow, publicKey)
+	query := "SELECT sender FROM (" + actionsInEffect(purpose.ActionFollow, purpose.ActionUnfollow, "a.letter_content == ?") + ") ORDER BY received;"
 	logger.Log.Debug(query)
-	rows, err := d.db.Query(query)
+	rows, err := d.db.Query(query, publicKey)
 	if err != nil {
 		err = errors.Wrap(err, "getFollowers")
 		return
@@ -984,6 +1219,15 @@ func (d *database) getAllVersions(id string) (s []string, err error) {
 	return
 }
 
+// isGroupKey tells whether the content of a share-key letter is the key of a group,
+// rather than a friends key.
+func isGroupKey(content string) bool {
+	var k struct {
+		Group string `json:"group"`
+	}
+	return json.Unmarshal([]byte(content), &k) == nil && k.Group != ""
+}
+
 func (d *database) getKeyForFriends(user string) (key keypair.KeyPair, err error) {
 	stmt, err := d.db.Prepare("SELECT letter_content FROM letters WHERE opened ==1 AND letter_purpose==? AND sender==? ORDER BY time DESC")
 	if err != nil {
@@ -991,13 +1235,33 @@ func (d *database) getKeyForFriends(user string) (key keypair.KeyPair, err error
 		return
 	}
 	defer stmt.Close()
-	var keystring string
-	err = stmt.QueryRow(purpose.ShareKey, user).Scan(&keystring)
+	rows, err := stmt.Query(purpose.ShareKey, user)
 	if err != nil {
-		err = errors.Wrap(err, "getKeyForFriends, bad scan")
+		err = errors.Wrap(err, "getKeyForFriends, bad query")
+		return
+	}
+	defer rows.Close()
+	var keystring string
+	for rows.Next() {
+		err = rows.Scan(&keystring)
+		if err != nil {
+			err = errors.Wrap(err, "getKeyForFriends, bad scan")
+			return
+		}
+		keystring, err = d.unseal(keystring)
+		if err != nil {
+			err = errors.Wrap(err, "getKeyForFriends")
+			return
+		}
+		if !isGroupKey(keystring) {
+			break
+		}
+		keystring = ""
+	}
+	if keystring == "" {
+		err = errors.New("getKeyForFriends, no key")
 		return
 	}
-	logger.Log.Infof(`query: "SELECT letter_content FROM letters WHERE opened ==1 AND letter_purpose=='%s' AND sender=='%s' ORDER BY time DESC": [%v]`, purpose.ShareKey, user, keystring)
 	err = json.Unmarshal([]byte(keystring), &key)
 	if err != nil {
 		err = errors.Wrap(err, "getKeyForFriends, bad unmarshal")
@@ -0,0 +1,749 @@
+package database
+
+import (
+	"encoding/json"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/pkg/errors"
+	"github.com/schollz/kiki/src/keypair"
+	"github.com/schollz/kiki/src/letter"
+	"github.com/schollz/kiki/src/purpose"
+)
+
+// MemoryStore keeps everything in memory, which is useful for tests and for hubs
+// that do not need to keep anything after they stop. It answers every query the
+// same way as the sqlite database.
+type MemoryStore struct {
+	letters  map[string]memoryLetter
+	received int64
+	keystore map[string]string
+	tags     map[string][]string
+	sync.RWMutex
+}
+
+type memoryLetter struct {
+	e        letter.Envelope
+	received int64
+}
+
+// NewMemory returns an empty store that lives in memory.
+func NewMemory() *MemoryStore {
+	return &MemoryStore{
+		letters:  make(map[string]memoryLetter),
+		keystore: make(map[string]string),
+		tags:     make(map[string][]string),
+	}
+}
+
+// Close empties the store
+func (m *MemoryStore) Close() (err error) {
+	m.Lock()
+	defer m.Unlock()
+	m.letters = make(map[string]memoryLetter)
+	m.keystore = make(map[string]string)
+	m.tags = make(map[string][]string)
+	return
+}
+
+// Set will set a value in the keystore
+func (m *MemoryStore) Set(bucket, key string, value interface{}) (err error) {
+	b, err := json.Marshal(value)
+	if err != nil {
+		return errors.Wrap(err, "Set")
+	}
+	m.Lock()
+	defer m.Unlock()
+	m.keystore[bucket+"/"+key] = string(b)
+	return
+}
+
+// Get will get a value from the keystore
+func (m *MemoryStore) Get(bucket, key string, value interface{}) (err error) {
+	m.RLock()
+	result, ok := m.keystore[bucket+"/"+key]
+	m.RUnlock()
+	if !ok {
+		return errors.New("problem getting key")
+	}
+	return json.Unmarshal([]byte(result), &value)
+}
+
+// Keys will list all the keys that are set in a bucket
+func (m *MemoryStore) Keys(bucket string) (keys []string, err error) {
+	m.RLock()
+	defer m.RUnlock()
+	keys = []string{}
+	for bucketKey := range m.keystore {
+		if strings.HasPrefix(bucketKey, bucket+"/") {
+			keys = append(keys, bucketKey[len(bucket)+1:])
+		}
+	}
+	sort.Strings(keys)
+	return
+}
+
+// Delete will delete a key from the keystore
+func (m *MemoryStore) Delete(bucket, key string) (err error) {
+	m.Lock()
+	defer m.Unlock()
+	delete(m.keystore, bucket+"/"+key)
+	return
+}
+
+// AddTags will add the tags to the envelopes
+func (m *MemoryStore) AddTags(idToTags map[string][]string) (err error) {
+	m.Lock()
+	defer m.Unlock()
+	for id, tags := range idToTags {
+		for _, tag := range tags {
+			if !containsString(m.tags[id], tag) {
+				m.tags[id] = append(m.tags[id], tag)
+			}
+		}
+	}
+	return
+}
+
+// AddEnvelope adds an envelope and returns an error if it already exists
+func (m *MemoryStore) AddEnvelope(e letter.Envelope) (err error) {
+	m.Lock()
+	defer m.Unlock()
+	if _, ok := m.letters[e.ID]; ok {
+		return errors.New("envelope already exists")
+	}
+	m.put(e)
+	return
+}
+
+// UpdateEnvelope adds an envelope or replaces it if it already exists
+func (m *MemoryStore) UpdateEnvelope(e letter.Envelope) (err error) {
+	m.Lock()
+	defer m.Unlock()
+	m.put(e)
+	return
+}
+
+// put stores a copy of the envelope, keeping the order it was first received.
+func (m *MemoryStore) put(e letter.Envelope) {
+	e.SealedRecipients = append([]string(nil), e.SealedRecipients...)
+	e.Letter.To = append([]string(nil), e.Letter.To...)
+	l, ok := m.letters[e.ID]
+	if !ok {
+		m.received++
+		l.received = m.received
+	}
+	l.e = e
+	m.letters[e.ID] = l
+}
+
+// remove deletes the letter, along with its origin and notification, like the
+// triggers of the sqlite database do.
+func (m *MemoryStore) remove(id string) {
+	delete(m.letters, id)
+	delete(m.keystore, "origins/"+id)
+	delete(m.keystore, "notifications/"+id)
+}
+
+// GetEnvelopeFromID returns a single envelope from its ID and returns an error if it does not exist.
+func (m *MemoryStore) GetEnvelopeFromID(id string) (e letter.Envelope, err error) {
+	m.RLock()
+	defer m.RUnlock()
+	l, ok := m.letters[id]
+	if !ok {
+		return e, errors.New("envelope does not exist")
+	}
+	return l.e, nil
+}
+
+// GetLatestEnvelopeFromID returns a single envelope from its ID, trying to find the latest version of it
+func (m *MemoryStore) GetLatestEnvelopeFromID(id string) (e letter.Envelope, err error) {
+	ids, err := m.GetAllVersions(id)
+	if err != nil {
+		return
+	}
+	return m.GetEnvelopeFromID(ids[0])
+}
+
+// GetAllEnvelopes returns all envelopes determined by whether they are opened
+func (m *MemoryStore) GetAllEnvelopes(opened ...bool) (es []letter.Envelope, err error) {
+	m.RLock()
+	defer m.RUnlock()
+	return m.newestFirst(func(e letter.Envelope) bool {
+		return len(opened) == 0 || e.Opened == opened[0]
+	}), nil
+}
+
+// GetAllVersions returns the IDs of all the versions of a post, the latest first
+func (m *MemoryStore) GetAllVersions(id string) (ids []string, err error) {
+	m.RLock()
+	defer m.RUnlock()
+	l, ok := m.letters[id]
+	if !ok || !l.e.Opened {
+		return nil, errors.New("no letters")
+	}
+	es := m.newestFirst(func(e letter.Envelope) bool {
+		return e.Opened && e.Letter.FirstID == l.e.Letter.FirstID
+	})
+	if len(es) == 0 {
+		return nil, errors.New("no letters")
+	}
+	ids = make([]string, len(es))
+	for i, e := range es {
+		ids[i] = e.ID
+	}
+	return
+}
+
+// GetIDs returns all the envelope IDs
+func (m *MemoryStore) GetIDs() (ids map[string]struct{}, err error) {
+	m.RLock()
+	defer m.RUnlock()
+	ids = make(map[string]struct{}, len(m.letters))
+	for id := range m.letters {
+		ids[id] = struct{}{}
+	}
+	return
+}
+
+// GetIDsSince returns the IDs of envelopes received after the cursor, and the cursor of the latest one.
+// Envelopes that have expired are left out.
+func (m *MemoryStore) GetIDsSince(cursor int64) (ids []string, latest int64, err error) {
+	m.RLock()
+	defer m.RUnlock()
+	ids = []string{}
+	latest = cursor
+	for _, l := range m.inOrder() {
+		if l.received > cursor && !l.e.Expired() {
+			ids = append(ids, l.e.ID)
+			latest = l.received
+		}
+	}
+	return
+}
+
+// RemoveLetters will delete the letters with those IDs
+func (m *MemoryStore) RemoveLetters(ids []string) (err error) {
+	m.Lock()
+	defer m.Unlock()
+	for _, id := range ids {
+		m.remove(id)
+	}
+	return
+}
+
+// RemoveLettersForUser will delete the envelopes for a specific user
+func (m *MemoryStore) RemoveLettersForUser(user string) (err error) {
+	return m.DeleteUser(user)
+}
+
+// DiskSpaceForUser returns the bytes used by a user for recipients + sealed_content
+func (m *MemoryStore) DiskSpaceForUser(user string) (diskSpace int64, err error) {
+	m.RLock()
+	defer m.RUnlock()
+	for _, l := range m.letters {
+		if l.e.Sender.Public != user {
+			continue
+		}
+		b, _ := json.Marshal(l.e.SealedRecipients)
+		diskSpace += int64(len(l.e.SealedLetter) + len(b))
+	}
+	return
+}
+
+// ListUsers returns the public keys of everyone that sent an envelope
+func (m *MemoryStore) ListUsers() (users []string, err error) {
+	m.RLock()
+	defer m.RUnlock()
+	users = []string{}
+	seen := make(map[string]struct{})
+	for _, l := range m.inOrder() {
+		if _, ok := seen[l.e.Sender.Public]; !ok {
+			seen[l.e.Sender.Public] = struct{}{}
+			users = append(users, l.e.Sender.Public)
+		}
+	}
+	return
+}
+
+// DeleteUsersOldestPost will delete the users oldest post
+func (m *MemoryStore) DeleteUsersOldestPost(publicKey string) (err error) {
+	return m.deleteOldest(func(e letter.Envelope) bool {
+		p, _ := purpose.Get(e.Letter.Purpose)
+		return e.Sender.Public == publicKey && (e.Letter.Purpose == "" || p.Storage == purpose.StoragePost)
+	})
+}
+
+// DeleteUsersOldestLargestPost will delete the users oldest post that is large
+func (m *MemoryStore) DeleteUsersOldestLargestPost(publicKey string) (err error) {
+	return m.deleteOldest(func(e letter.Envelope) bool {
+		return e.Sender.Public == publicKey && len(e.SealedLetter) > 5000
+	})
+}
+
+func (m *MemoryStore) deleteOldest(match func(e letter.Envelope) bool) (err error) {
+	m.Lock()
+	defer m.Unlock()
+	es := m.newestFirst(match)
+	if len(es) > 0 {
+		m.remove(es[len(es)-1].ID)
+	}
+	return
+}
+
+// DeleteUsersEdits will delete the users edits made to posts
+func (m *MemoryStore) DeleteUsersEdits(publicKey string) (err error) {
+	m.Lock()
+	defer m.Unlock()
+	firstIDs := make(map[string]struct{})
+	for _, l := range m.letters {
+		if l.e.Opened && l.e.Sender.Public == publicKey {
+			firstIDs[l.e.Letter.FirstID] = struct{}{}
+		}
+	}
+	for firstID := range firstIDs {
+		versions := m.newestFirst(func(e letter.Envelope) bool {
+			return e.Opened && e.Letter.FirstID == firstID
+		})
+		for _, e := range versions[1:] {
+			m.remove(e.ID)
+		}
+	}
+	return
+}
+
+// DeleteOldActions will delete all the old actions of a user
+func (m *MemoryStore) DeleteOldActions(publicKey string) (err error) {
+	m.Lock()
+	defer m.Unlock()
+	for _, p := range purpose.WithStorage(purpose.StorageLatest) {
+		es := m.newestFirst(func(e letter.Envelope) bool {
+			return e.Opened && e.Letter.Purpose == p && e.Sender.Public == publicKey
+		})
+		for i := 1; i < len(es); i++ {
+			m.remove(es[i].ID)
+		}
+	}
+	return
+}
+
+// DeleteProfiles will delete everything for all users that have submitted an action-erase
+func (m *MemoryStore) DeleteProfiles() (err error) {
+	m.Lock()
+	defer m.Unlock()
+	erased := make(map[string]struct{})
+	for _, l := range m.letters {
+		if l.e.Letter.Purpose == purpose.ActionErase {
+			erased[l.e.Sender.Public] = struct{}{}
+		}
+	}
+	for id, l := range m.letters {
+		if _, ok := erased[l.e.Sender.Public]; ok {
+			m.remove(id)
+		}
+	}
+	return
+}
+
+// DeleteUser will delete everything from a user
+func (m *MemoryStore) DeleteUser(publicKey string) (err error) {
+	m.Lock()
+	defer m.Unlock()
+	for id, l := range m.letters {
+		if l.e.Sender.Public == publicKey {
+			m.remove(id)
+		}
+	}
+	return
+}
+
+// DeleteExpired will delete the envelopes that have expired
+func (m *MemoryStore) DeleteExpired() (err error) {
+	m.Lock()
+	defer m.Unlock()
+	for id, l := range m.letters {
+		if l.e.Expired() {
+			m.remove(id)
+		}
+	}
+	return
+}
+
+// GetBasicPosts returns the latest version of every post that is not a reply
+func (m *MemoryStore) GetBasicPosts() (es []letter.Envelope, err error) {
+	return m.basicPosts("")
+}
+
+// GetBasicPostsForUser returns the latest version of every post of a user that is not a reply
+func (m *MemoryStore) GetBasicPostsForUser(publicKey string) (es []letter.Envelope, err error) {
+	return m.basicPosts(publicKey)
+}
+
+// basicPosts returns the posts of a user, or of everyone if the public key is empty.
+func (m *MemoryStore) basicPosts(publicKey string) (es []letter.Envelope, err error) {
+	m.RLock()
+	defer m.RUnlock()
+	es = latestVersions(m.newestFirst(func(e letter.Envelope) bool {
+		return e.Opened && e.Letter.Purpose == purpose.ShareText && e.Letter.ReplyTo == "" &&
+			(publicKey == "" || e.Sender.Public == publicKey)
+	}))
+	return withContent(es), nil
+}
+
+// GetTexts returns the latest version of every text from the senders, or from
+// everyone if there are no senders, the latest first
+func (m *MemoryStore) GetTexts(senders ...string) (es []letter.Envelope, err error) {
+	m.RLock()
+	defer m.RUnlock()
+	es = latestVersions(m.newestFirst(func(e letter.Envelope) bool {
+		return e.Opened && e.Letter.Purpose == purpose.ShareText &&
+			(len(senders) == 0 || containsString(senders, e.Sender.Public))
+	}))
+	return withContent(es), nil
+}
+
+// GetReplies returns the latest version of every reply to a post, the oldest first
+func (m *MemoryStore) GetReplies(id string) (es []letter.Envelope, err error) {
+	m.RLock()
+	defer m.RUnlock()
+	es = latestVersions(m.newestFirst(func(e letter.Envelope) bool {
+		return e.Opened && e.Letter.Purpose == purpose.ShareText && e.Letter.ReplyTo == id
+	}))
+	for i, j := 0, len(es)-1; i < j; i, j = i+1, j-1 {
+		es[i], es[j] = es[j], es[i]
+	}
+	return
+}
+
+// GetEnvelopesFromTag1 returns the latest version of every post with the tag
+func (m *MemoryStore) GetEnvelopesFromTag1(tag string) (es []letter.Envelope, err error) {
+	m.RLock()
+	defer m.RUnlock()
+	es = latestVersions(m.newestFirst(func(e letter.Envelope) bool {
+		return e.Opened && e.Letter.Purpose == purpose.ShareText && e.Letter.Content != "" &&
+			e.Letter.ReplyTo == "" && containsString(m.tags[e.ID], tag)
+	}))
+	return
+}
+
+// NumberOfLikes returns the number of likes for a post
+func (m *MemoryStore) NumberOfLikes(postID string) (likes int64) {
+	m.RLock()
+	defer m.RUnlock()
+	return m.numberOfLikes(postID)
+}
+
+func (m *MemoryStore) numberOfLikes(postID string) (likes int64) {
+	return int64(len(m.inEffect(purpose.ActionLike, purpose.ActionUnlike, func(e letter.Envelope) bool {
+		return e.Letter.Content == postID
+	})))
+}
+
+// inEffect returns the actions that are in effect, in the order they were received.
+// An action is in effect if it is the latest of the action and the action that
+// undoes it, for its sender and content.
+func (m *MemoryStore) inEffect(action, undo string, match func(e letter.Envelope) bool) (es []letter.Envelope) {
+	latest := make(map[[2]string]letter.Envelope)
+	for _, l := range m.letters {
+		e := l.e
+		if !e.Opened || (e.Letter.Purpose != action && e.Letter.Purpose != undo) || !match(e) {
+			continue
+		}
+		key := [2]string{e.Sender.Public, e.Letter.Content}
+		other, ok := latest[key]
+		if !ok || e.Timestamp.After(other.Timestamp) || (e.Timestamp.Equal(other.Timestamp) && e.ID > other.ID) {
+			latest[key] = e
+		}
+	}
+	es = []letter.Envelope{}
+	for _, l := range m.inOrder() {
+		e, ok := latest[[2]string{l.e.Sender.Public, l.e.Letter.Content}]
+		if ok && e.ID == l.e.ID && e.Letter.Purpose == action {
+			es = append(es, e)
+		}
+	}
+	return
+}
+
+// Friends will return the followers, following and friends for a given user
+func (m *MemoryStore) Friends(publicKey string) (followers, following, friends []string) {
+	m.RLock()
+	defer m.RUnlock()
+	return splitFriends(m.followers(publicKey), m.following(publicKey))
+}
+
+func (m *MemoryStore) followers(publicKey string) (followers []string) {
+	followers = []string{}
+	for _, e := range m.inEffect(purpose.ActionFollow, purpose.ActionUnfollow, func(e letter.Envelope) bool {
+		return e.Letter.Content == publicKey
+	}) {
+		if e.Sender.Public != "" {
+			followers = append(followers, e.Sender.Public)
+		}
+	}
+	return
+}
+
+func (m *MemoryStore) following(publicKey string) (following []string) {
+	following = []string{}
+	for _, e := range m.inEffect(purpose.ActionFollow, purpose.ActionUnfollow, func(e letter.Envelope) bool {
+		return e.Sender.Public == publicKey
+	}) {
+		if e.Letter.Content != "" {
+			following = append(following, e.Letter.Content)
+		}
+	}
+	return
+}
+
+// ListBlockedUsers returns the people blocked by a user
+func (m *MemoryStore) ListBlockedUsers(publicKey string) (users []string, err error) {
+	m.RLock()
+	defer m.RUnlock()
+	users = []string{}
+	for _, e := range m.inEffect(purpose.ActionBlock, purpose.ActionUnblock, func(e letter.Envelope) bool {
+		return e.Sender.Public == publicKey && e.Letter.Content != ""
+	}) {
+		users = append(users, e.Letter.Content)
+	}
+	return
+}
+
+// GetName will return the assigned name for the public key of a sender
+func (m *MemoryStore) GetName(publicKey string) (name string) {
+	m.RLock()
+	defer m.RUnlock()
+	return m.latestAction(publicKey, purpose.ActionName)
+}
+
+// GetUser returns information for a user
+func (m *MemoryStore) GetUser(publicKey string) (name, profile, image string) {
+	m.RLock()
+	defer m.RUnlock()
+	return m.latestAction(publicKey, purpose.ActionName), m.latestAction(publicKey, purpose.ActionProfile), m.latestAction(publicKey, purpose.ActionImage)
+}
+
+// latestAction returns the content of the latest action of a user with the purpose.
+func (m *MemoryStore) latestAction(publicKey, p string) (content string) {
+	es := m.newestFirst(func(e letter.Envelope) bool {
+		return e.Opened && e.Letter.Purpose == p && e.Sender.Public == publicKey
+	})
+	if len(es) > 0 {
+		content = es[0].Letter.Content
+	}
+	return
+}
+
+// GetFriendsName will search friend's keys and determine the name of the friends key
+func (m *MemoryStore) GetFriendsName(publicKey string) (name string) {
+	m.RLock()
+	defer m.RUnlock()
+	for _, l := range m.inOrder() {
+		if !l.e.Opened || l.e.Letter.Purpose != purpose.ShareKey || isGroupKey(l.e.Letter.Content) || !strings.Contains(l.e.Letter.Content, publicKey) {
+			continue
+		}
+		sender := l.e.Sender.Public
+		if senderName := m.latestAction(sender, purpose.ActionName); senderName != "" {
+			sender = senderName
+		}
+		return "Friends of " + sender
+	}
+	return
+}
+
+// GetKeys will return all the keys
+func (m *MemoryStore) GetKeys() (s []keypair.KeyPair, err error) {
+	return m.getKeys("")
+}
+
+// GetKeysFromSender will return all the keys from a certain sender
+func (m *MemoryStore) GetKeysFromSender(sender string) (s []keypair.KeyPair, err error) {
+	return m.getKeys(sender)
+}
+
+func (m *MemoryStore) getKeys(sender string) (s []keypair.KeyPair, err error) {
+	m.RLock()
+	defer m.RUnlock()
+	s = []keypair.KeyPair{}
+	es := m.newestFirst(func(e letter.Envelope) bool {
+		return e.Opened && e.Letter.Purpose == purpose.ShareKey && (sender == "" || e.Sender.Public == sender)
+	})
+	for _, e := range es {
+		var kp keypair.KeyPair
+		err = json.Unmarshal([]byte(e.Letter.Content), &kp)
+		if err != nil {
+			return
+		}
+		s = append(s, kp)
+	}
+	return
+}
+
+// GetLatestKeyForFriends will return the latest key for encrypting messages to friends
+func (m *MemoryStore) GetLatestKeyForFriends(publicKey string) (key keypair.KeyPair, err error) {
+	m.RLock()
+	defer m.RUnlock()
+	for _, e := range m.newestFirst(func(e letter.Envelope) bool {
+		return e.Opened && e.Letter.Purpose == purpose.ShareKey && e.Sender.Public == publicKey
+	}) {
+		if !isGroupKey(e.Letter.Content) {
+			err = json.Unmarshal([]byte(e.Letter.Content), &key)
+			return
+		}
+	}
+	err = errors.New("getKeyForFriends, no key")
+	return
+}
+
+// GetPostsForApi returns the latest version of every post that is not a reply
+func (m *MemoryStore) GetPostsForApi() ([]ApiBasicPost, error) {
+	m.RLock()
+	defer m.RUnlock()
+	return m.apiPosts(latestVersions(m.newestFirst(func(e letter.Envelope) bool {
+		return e.Opened && e.Letter.Purpose == purpose.ShareText && e.Letter.ReplyTo == ""
+	}))), nil
+}
+
+// GetPostForApi returns the latest version of a post
+func (m *MemoryStore) GetPostForApi(postID string) ([]ApiBasicPost, error) {
+	m.RLock()
+	defer m.RUnlock()
+	es := m.newestFirst(func(e letter.Envelope) bool {
+		return e.Opened && e.Letter.Purpose == purpose.ShareText && e.Letter.FirstID == postID
+	})
+	if len(es) > 1 {
+		es = es[:1]
+	}
+	return m.apiPosts(es), nil
+}
+
+// GetPostCommentsForApi returns the latest version of every reply to a post
+func (m *MemoryStore) GetPostCommentsForApi(postID string) ([]ApiBasicPost, error) {
+	m.RLock()
+	defer m.RUnlock()
+	return m.apiPosts(latestVersions(m.newestFirst(func(e letter.Envelope) bool {
+		return e.Opened && e.Letter.Purpose == purpose.ShareText && e.Letter.ReplyTo == postID
+	}))), nil
+}
+
+// GetPostVersionsForApi returns every version of a post, the latest first
+func (m *MemoryStore) GetPostVersionsForApi(postID string) ([]ApiBasicPost, error) {
+	m.RLock()
+	defer m.RUnlock()
+	return m.apiPosts(m.newestFirst(func(e letter.Envelope) bool {
+		return e.Opened && e.Letter.Purpose == purpose.ShareText && e.Letter.FirstID == postID
+	})), nil
+}
+
+// GetUserForApi returns the profile and the social graph of a user
+func (m *MemoryStore) GetUserForApi(userID string) (user ApiUser, err error) {
+	m.RLock()
+	defer m.RUnlock()
+	followers, following := m.followers(userID), m.following(userID)
+	_, _, friends := splitFriends(append([]string(nil), followers...), append([]string(nil), following...))
+	user = ApiUser{
+		PublicKey: userID,
+		Name:      m.latestAction(userID, purpose.ActionName),
+		Profile:   strings.Replace(m.latestAction(userID, purpose.ActionProfile), `"`, "'", -1),
+		Image:     m.latestAction(userID, purpose.ActionImage),
+		Followers: followers,
+		Following: following,
+		Blocked:   []string{},
+		Friends:   friends,
+	}
+	for _, e := range m.inEffect(purpose.ActionBlock, purpose.ActionUnblock, func(e letter.Envelope) bool {
+		return e.Sender.Public == userID
+	}) {
+		user.Blocked = append(user.Blocked, e.Letter.Content)
+	}
+	return
+}
+
+func (m *MemoryStore) apiPosts(es []letter.Envelope) (posts []ApiBasicPost) {
+	for _, e := range es {
+		post := ApiBasicPost{
+			ID:         e.Letter.FirstID,
+			Recipients: e.Letter.To,
+			ReplyTo:    e.Letter.ReplyTo,
+			Content:    strings.Replace(e.Letter.Content, `"`, "'", -1),
+			Timestamp:  e.Timestamp.Unix(),
+			OwnerId:    e.Sender.Public,
+			OwnerName:  m.latestAction(e.Sender.Public, purpose.ActionName),
+			Likes:      m.numberOfLikes(e.Letter.FirstID),
+			Purpose:    e.Letter.Purpose,
+			HashTags:   append([]string{}, m.tags[e.ID]...),
+		}
+		for _, l := range m.letters {
+			if l.e.Opened && l.e.Letter.Purpose == purpose.ShareText && l.e.Letter.ReplyTo == e.Letter.FirstID {
+				post.NumComments++
+			}
+		}
+		posts = append(posts, post)
+	}
+	return
+}
+
+// inOrder returns the letters in the order they were received.
+func (m *MemoryStore) inOrder() (ls []memoryLetter) {
+	ls = make([]memoryLetter, 0, len(m.letters))
+	for _, l := range m.letters {
+		ls = append(ls, l)
+	}
+	sort.Slice(ls, func(i, j int) bool {
+		return ls[i].received < ls[j].received
+	})
+	return
+}
+
+// newestFirst returns the envelopes that match, the newest first.
+func (m *MemoryStore) newestFirst(match func(e letter.Envelope) bool) (es []letter.Envelope) {
+	es = []letter.Envelope{}
+	for _, l := range m.inOrder() {
+		if match(l.e) {
+			es = append(es, l.e)
+		}
+	}
+	sort.SliceStable(es, func(i, j int) bool {
+		return es[i].Timestamp.After(es[j].Timestamp)
+	})
+	return
+}
+
+// latestVersions keeps only the first envelope of every post, which is the
+// latest version when the envelopes are newest first.
+func latestVersions(es []letter.Envelope) []letter.Envelope {
+	seen := make(map[string]struct{})
+	i := 0
+	for _, e := range es {
+		if _, ok := seen[e.Letter.FirstID]; ok {
+			continue
+		}
+		seen[e.Letter.FirstID] = struct{}{}
+		es[i] = e
+		i++
+	}
+	return es[:i]
+}
+
+// withContent removes the envelopes that are empty.
+func withContent(es []letter.Envelope) []letter.Envelope {
+	i := 0
+	for _, e := range es {
+		if e.Letter.Content == "" {
+			continue
+		}
+		es[i] = e
+		i++
+	}
+	return es[:i]
+}
+
+func containsString(s []string, x string) bool {
+	for _, y := range s {
+		if y == x {
+			return true
+		}
+	}
+	return false
+}
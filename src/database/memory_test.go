@@ -0,0 +1,239 @@
+package database
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+	"testing"
+	"time"
+
+	"github.com/schollz/kiki/src/keypair"
+	"github.com/schollz/kiki/src/letter"
+	"github.com/schollz/kiki/src/purpose"
+	"github.com/stretchr/testify/assert"
+)
+
+// storeEnvelopes makes envelopes that use every kind of query: edits, replies,
+// likes, blocks, profiles, friends keys, group keys, actions that are taken back and
+// envelopes that expire.
+func storeEnvelopes(t *testing.T) (es []letter.Envelope, alice, bob, friendsKey keypair.KeyPair) {
+	regionKey := keypair.New()
+	alice = keypair.New()
+	bob = keypair.New()
+	carol := keypair.New()
+	friendsKey = keypair.New()
+	bFriendsKey, _ := json.Marshal(friendsKey)
+
+	add := func(sender keypair.KeyPair, l letter.Letter) letter.Envelope {
+		e := openedEnvelope(t, l, sender, regionKey)
+		e.Timestamp = time.Date(2018, 1, 1, 0, 0, len(es), 0, time.UTC)
+		es = append(es, e)
+		return e
+	}
+	add(alice, letter.Letter{Purpose: purpose.ActionName, Content: "alice"})
+	add(alice, letter.Letter{Purpose: purpose.ActionProfile, Content: `likes "quotes"`})
+	add(alice, letter.Letter{Purpose: purpose.ActionImage, Content: "image"})
+	add(alice, letter.Letter{Purpose: purpose.ActionFollow, Content: bob.Public})
+	add(bob, letter.Letter{Purpose: purpose.ActionFollow, Content: alice.Public})
+	add(carol, letter.Letter{Purpose: purpose.ActionFollow, Content: alice.Public})
+	first := add(alice, letter.Letter{Purpose: purpose.ShareText, Content: "first #hashtag"})
+	add(alice, letter.Letter{Purpose: purpose.ShareText, Content: "second"})
+	add(alice, letter.Letter{Purpose: purpose.ShareText, Content: ""})
+	add(alice, letter.Letter{Purpose: purpose.ShareText, Content: "first edited #hashtag", FirstID: first.ID})
+	reply := add(bob, letter.Letter{Purpose: purpose.ShareText, Content: "a reply", ReplyTo: first.ID})
+	add(carol, letter.Letter{Purpose: purpose.ShareText, Content: "another reply", ReplyTo: first.ID})
+	add(bob, letter.Letter{Purpose: purpose.ShareText, Content: "a reply edited", ReplyTo: first.ID, FirstID: reply.ID})
+	add(bob, letter.Letter{Purpose: purpose.ActionLike, Content: first.ID})
+	add(bob, letter.Letter{Purpose: purpose.ShareKey, Content: string(bFriendsKey)})
+	add(bob, letter.Letter{Purpose: purpose.ActionName, Content: "bob"})
+	add(alice, letter.Letter{Purpose: purpose.ActionBlock, Content: carol.Public})
+	add(alice, letter.Letter{Purpose: purpose.ActionName, Content: "alice again"})
+	// actions that are taken back
+	add(carol, letter.Letter{Purpose: purpose.ActionUnfollow, Content: alice.Public})
+	add(carol, letter.Letter{Purpose: purpose.ActionLike, Content: first.ID})
+	add(bob, letter.Letter{Purpose: purpose.ActionUnlike, Content: first.ID})
+	add(alice, letter.Letter{Purpose: purpose.ActionBlock, Content: bob.Public})
+	add(alice, letter.Letter{Purpose: purpose.ActionUnblock, Content: carol.Public})
+	// a group key is not a friends key
+	groupKey := keypair.New()
+	add(bob, letter.Letter{Purpose: purpose.ShareKey, Content: `{"public":"` + groupKey.Public + `","private":"` + groupKey.Private + `","group":"g"}`})
+	// replies that expire, the first of which has expired
+	add(carol, letter.Letter{Purpose: purpose.ShareText, Content: "expired", ReplyTo: first.ID})
+	es[len(es)-1].Expires = time.Now().UTC().Add(-1 * time.Minute)
+	add(carol, letter.Letter{Purpose: purpose.ShareText, Content: "expires", ReplyTo: first.ID})
+	es[len(es)-1].Expires = time.Now().UTC().Add(1 * time.Hour)
+	es[len(es)-1].Stamp = "stamp"
+	return
+}
+
+func envelopeIDs(es []letter.Envelope, err error) []string {
+	ids := []string{}
+	for _, e := range es {
+		ids = append(ids, e.ID)
+	}
+	return ids
+}
+
+func postIDs(posts []ApiBasicPost, err error) (ids []string) {
+	for _, post := range posts {
+		ids = append(ids, post.ID)
+	}
+	return
+}
+
+func sortedIDs(ids map[string]struct{}, err error) (s []string) {
+	for id := range ids {
+		s = append(s, id)
+	}
+	sort.Strings(s)
+	return
+}
+
+func TestMemoryMatchesDatabase(t *testing.T) {
+	es, alice, bob, friendsKey := storeEnvelopes(t)
+	first := es[6].ID
+
+	dir, err := ioutil.TempDir("", "kiki-memory")
+	assert.Nil(t, err)
+	defer os.RemoveAll(dir)
+	// the sqlite database is encrypted at rest, which should not change any query
+	api, err := Setup(filepath.Join(dir, "kiki.db"), [32]byte{1, 2, 3})
+	assert.Nil(t, err)
+	defer api.Close()
+	m := NewMemory()
+	defer m.Close()
+
+	stores := []Store{api, m}
+	for _, store := range stores {
+		fillStore(t, store, es)
+		for _, e := range es {
+			assert.Nil(t, store.Set("origins", e.ID, "peer"))
+			assert.Nil(t, store.Set("notifications", e.ID, "notification"))
+		}
+	}
+
+	check := func(name string, query func(store Store) interface{}) {
+		assert.Equal(t, query(api), query(m), name)
+	}
+	// only the latest of an action and the action that undoes it counts
+	followers, _, friends := api.Friends(alice.Public)
+	assert.Equal(t, []string{}, followers)
+	assert.Equal(t, []string{bob.Public}, friends)
+	assert.Equal(t, int64(1), api.NumberOfLikes(first))
+	blocked, err := api.ListBlockedUsers(alice.Public)
+	assert.Nil(t, err)
+	assert.Equal(t, []string{bob.Public}, blocked)
+	key, err := api.GetLatestKeyForFriends(bob.Public)
+	assert.Nil(t, err)
+	assert.Equal(t, friendsKey.Public, key.Public)
+	// posts are shown with their latest edit
+	posts, err := api.GetBasicPosts()
+	assert.Nil(t, err)
+	assert.Equal(t, "first edited #hashtag", posts[0].Letter.Content)
+	check("GetBasicPosts", func(s Store) interface{} { return envelopeIDs(s.GetBasicPosts()) })
+	check("GetBasicPostsForUser", func(s Store) interface{} { return envelopeIDs(s.GetBasicPostsForUser(alice.Public)) })
+	check("GetReplies", func(s Store) interface{} { return envelopeIDs(s.GetReplies(first)) })
+	check("GetTexts", func(s Store) interface{} { return envelopeIDs(s.GetTexts()) })
+	check("GetTexts from", func(s Store) interface{} { return envelopeIDs(s.GetTexts(alice.Public, bob.Public)) })
+	check("GetEnvelopesFromTag1", func(s Store) interface{} { return envelopeIDs(s.GetEnvelopesFromTag1("hashtag")) })
+	check("GetAllEnvelopes", func(s Store) interface{} { return envelopeIDs(s.GetAllEnvelopes()) })
+	check("GetAllVersions", func(s Store) interface{} { ids, _ := s.GetAllVersions(first); return ids })
+	check("GetLatestEnvelopeFromID", func(s Store) interface{} { e, _ := s.GetLatestEnvelopeFromID(first); return e.Letter.Content })
+	check("GetIDs", func(s Store) interface{} { return sortedIDs(s.GetIDs()) })
+	check("GetIDsSince", func(s Store) interface{} { ids, latest, _ := s.GetIDsSince(10); return []interface{}{ids, latest} })
+	check("Friends", func(s Store) interface{} { a, b, c := s.Friends(alice.Public); return [][]string{a, b, c} })
+	check("ListBlockedUsers", func(s Store) interface{} { users, _ := s.ListBlockedUsers(alice.Public); return users })
+	check("GetUser", func(s Store) interface{} { a, b, c := s.GetUser(alice.Public); return []string{a, b, c} })
+	check("GetName", func(s Store) interface{} { return s.GetName(bob.Public) })
+	check("GetFriendsName", func(s Store) interface{} { return s.GetFriendsName(friendsKey.Public) })
+	check("GetKeys", func(s Store) interface{} { keys, _ := s.GetKeys(); return keys })
+	check("GetLatestKeyForFriends", func(s Store) interface{} { key, _ := s.GetLatestKeyForFriends(bob.Public); return key })
+	check("NumberOfLikes", func(s Store) interface{} { return s.NumberOfLikes(first) })
+	check("DiskSpaceForUser", func(s Store) interface{} { space, _ := s.DiskSpaceForUser(alice.Public); return space })
+	check("ListUsers", func(s Store) interface{} { users, _ := s.ListUsers(); sort.Strings(users); return users })
+	check("GetPostsForApi", func(s Store) interface{} { return postIDs(s.GetPostsForApi()) })
+	check("GetPostForApi", func(s Store) interface{} { posts, _ := s.GetPostForApi(first); return posts })
+	check("GetPostCommentsForApi", func(s Store) interface{} { return postIDs(s.GetPostCommentsForApi(first)) })
+	check("GetPostVersionsForApi", func(s Store) interface{} { posts, _ := s.GetPostVersionsForApi(first); return posts })
+	check("GetUserForApi", func(s Store) interface{} {
+		// the sqlite database does not order the people
+		user, _ := s.GetUserForApi(alice.Public)
+		for _, people := range [][]string{user.Followers, user.Following, user.Blocked, user.Friends} {
+			sort.Strings(people)
+		}
+		return user
+	})
+
+	for _, store := range stores {
+		assert.Nil(t, store.DeleteUsersEdits(alice.Public))
+		assert.Nil(t, store.DeleteOldActions(alice.Public))
+		assert.Nil(t, store.DeleteUsersOldestPost(bob.Public))
+		assert.Nil(t, store.DeleteExpired())
+	}
+	check("deleted", func(s Store) interface{} { return sortedIDs(s.GetIDs()) })
+	// the origins and notifications of the deleted envelopes are deleted along with them
+	check("origins", func(s Store) interface{} { keys, _ := s.Keys("origins"); return keys })
+	check("notifications", func(s Store) interface{} { keys, _ := s.Keys("notifications"); return keys })
+	origins, err := api.Keys("origins")
+	assert.Nil(t, err)
+	assert.Equal(t, sortedIDs(api.GetIDs()), origins)
+	_, err = api.GetEnvelopeFromID(es[len(es)-2].ID)
+	assert.NotNil(t, err)
+	e, err := api.GetEnvelopeFromID(es[len(es)-1].ID)
+	assert.Nil(t, err)
+	assert.Equal(t, es[len(es)-1].Expires.UnixNano(), e.Expires.UnixNano())
+	assert.Equal(t, "stamp", e.Stamp)
+	assert.Equal(t, []string{"alice again"}, func() (names []string) {
+		for _, e := range es {
+			if _, err := m.GetEnvelopeFromID(e.ID); err == nil && e.Letter.Purpose == purpose.ActionName && e.Sender.Public == alice.Public {
+				names = append(names, e.Letter.Content)
+			}
+		}
+		return
+	}())
+}
+
+func TestMemoryKeyStore(t *testing.T) {
+	m := NewMemory()
+	assert.NotNil(t, m.Get("bucket", "key", new(int)))
+	assert.Nil(t, m.Set("bucket", "key", 3))
+	assert.Nil(t, m.Set("bucket_2", "key", 4))
+	var value int
+	assert.Nil(t, m.Get("bucket", "key", &value))
+	assert.Equal(t, 3, value)
+	keys, err := m.Keys("bucket")
+	assert.Nil(t, err)
+	assert.Equal(t, []string{"key"}, keys)
+	assert.Nil(t, m.Delete("bucket", "key"))
+	assert.NotNil(t, m.Get("bucket", "key", &value))
+}
+
+func TestMemoryEnvelopes(t *testing.T) {
+	m := NewMemory()
+	es := testEnvelopes(t)
+	ids := fillStore(t, m, es)
+	assert.NotNil(t, m.AddEnvelope(es[0]))
+
+	// replacing an envelope keeps the order it was received
+	assert.Nil(t, m.UpdateEnvelope(es[0]))
+	since, latest, err := m.GetIDsSince(0)
+	assert.Nil(t, err)
+	assert.Equal(t, ids, since)
+	assert.Equal(t, int64(len(ids)), latest)
+
+	posts, err := m.GetBasicPosts()
+	assert.Nil(t, err)
+	assert.Equal(t, 100, len(posts))
+	_, _, friends := m.Friends(es[0].Sender.Public)
+	assert.Equal(t, 1, len(friends))
+
+	assert.Nil(t, m.RemoveLetters(ids[:2]))
+	_, err = m.GetEnvelopeFromID(ids[0])
+	assert.NotNil(t, err)
+	assert.Nil(t, m.DeleteUser(es[0].Sender.Public))
+	users, err := m.ListUsers()
+	assert.Nil(t, err)
+	assert.Equal(t, []string{es[len(es)-1].Sender.Public}, users)
+}
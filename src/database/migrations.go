@@ -0,0 +1,157 @@
+package database
+
+import (
+	"database/sql"
+	"fmt"
+
+	"github.com/pkg/errors"
+)
+
+// migration brings the schema from the version before it up to its own version.
+type migration struct {
+	description string
+	apply       func(tx *sql.Tx) error
+}
+
+// migrations are applied in order, the schema version of a database is the number of
+// migrations that have been applied to it. Never change or reorder a migration that has
+// been released, only add new ones to the end.
+var migrations = []migration{
+	{"add envelope signatures to letters", func(tx *sql.Tx) (err error) {
+		err = addColumn(tx, "letters", "version", "integer not null default 0")
+		if err != nil {
+			return
+		}
+		err = addColumn(tx, "letters", "signing_key", "text not null default ''")
+		if err != nil {
+			return
+		}
+		return addColumn(tx, "letters", "envelope_signature", "text not null default ''")
+	}},
+	{"add the order envelopes were received to letters", func(tx *sql.Tx) (err error) {
+		added, err := addColumnIfMissing(tx, "letters", "received", "integer not null default 0")
+		if err != nil || !added {
+			return
+		}
+		_, err = tx.Exec("UPDATE letters SET received = rowid")
+		if err != nil {
+			return
+		}
+		_, err = tx.Exec("CREATE INDEX IF NOT EXISTS idx_received ON letters(received)")
+		return
+	}},
+	{"add the time envelopes expire to letters", func(tx *sql.Tx) (err error) {
+		return addColumn(tx, "letters", "expires", "integer not null default 0")
+	}},
+	{"add proof-of-work stamps to letters", func(tx *sql.Tx) (err error) {
+		return addColumn(tx, "letters", "stamp", "text not null default ''")
+	}},
+	{"delete the origins of letters along with them", func(tx *sql.Tx) (err error) {
+		_, err = tx.Exec("DELETE FROM keystore WHERE bucket_key LIKE 'origins/%' AND substr(bucket_key, 9) NOT IN (SELECT id FROM letters)")
+		if err != nil {
+			return
+		}
+		_, err = tx.Exec(`CREATE TRIGGER IF NOT EXISTS delete_letter_origin AFTER DELETE ON letters
+			BEGIN DELETE FROM keystore WHERE bucket_key = 'origins/' || OLD.id; END`)
+		return
+	}},
+	{"delete the notifications of letters along with them", func(tx *sql.Tx) (err error) {
+		_, err = tx.Exec("DELETE FROM keystore WHERE bucket_key LIKE 'notifications/%' AND substr(bucket_key, 15) NOT IN (SELECT id FROM letters)")
+		if err != nil {
+			return
+		}
+		_, err = tx.Exec(`CREATE TRIGGER IF NOT EXISTS delete_letter_notification AFTER DELETE ON letters
+			BEGIN DELETE FROM keystore WHERE bucket_key = 'notifications/' || OLD.id; END`)
+		return
+	}},
+}
+
+// SchemaVersion is the version of the schema that this version of kiki uses.
+var SchemaVersion = len(migrations)
+
+// migrate applies all the migrations that the database does not have yet, in a single
+// transaction so that a failed migration leaves the database as it was.
+func (d *database) migrate() (err error) {
+	tx, err := d.writer.Begin()
+	if err != nil {
+		return errors.Wrap(err, "migrate")
+	}
+	defer tx.Rollback()
+
+	version, err := schemaVersion(tx)
+	if err != nil {
+		return errors.Wrap(err, "migrate")
+	}
+	if version > len(migrations) {
+		return fmt.Errorf("database '%s' has schema version %d, which is newer than the %d this version of kiki knows about", d.name, version, len(migrations))
+	}
+	if version == len(migrations) {
+		return
+	}
+
+	for i := version; i < len(migrations); i++ {
+		logger.Log.Infof("migrating database to version %d: %s", i+1, migrations[i].description)
+		err = migrations[i].apply(tx)
+		if err != nil {
+			return errors.Wrap(err, fmt.Sprintf("migrate to version %d", i+1))
+		}
+	}
+	_, err = tx.Exec("insert or replace into keystore(bucket_key,value) values ('schema/version', ?)", fmt.Sprintf("%d", len(migrations)))
+	if err != nil {
+		return errors.Wrap(err, "migrate")
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		return errors.Wrap(err, "migrate")
+	}
+	return
+}
+
+// schemaVersion returns the version recorded in the keystore, which is 0 if the
+// database was made before there were migrations.
+func schemaVersion(tx *sql.Tx) (version int, err error) {
+	var value string
+	err = tx.QueryRow("SELECT value FROM keystore WHERE bucket_key = 'schema/version'").Scan(&value)
+	if err == sql.ErrNoRows {
+		return 0, nil
+	} else if err != nil {
+		return
+	}
+	_, err = fmt.Sscanf(value, "%d", &version)
+	return
+}
+
+// addColumn adds a column to the table, unless the table already has it.
+func addColumn(tx *sql.Tx, table, name, definition string) (err error) {
+	_, err = addColumnIfMissing(tx, table, name, definition)
+	return
+}
+
+// addColumnIfMissing adds a column to the table and returns whether it was added.
+// Databases from before there were migrations might already have the column.
+func addColumnIfMissing(tx *sql.Tx, table, name, definition string) (added bool, err error) {
+	rows, err := tx.Query(fmt.Sprintf("PRAGMA table_info(%s)", table))
+	if err != nil {
+		return
+	}
+	for rows.Next() {
+		var cid, notNull, pk int
+		var column, columnType string
+		var defaultValue sql.NullString
+		err = rows.Scan(&cid, &column, &columnType, &notNull, &defaultValue, &pk)
+		if err != nil {
+			rows.Close()
+			return
+		}
+		if column == name {
+			rows.Close()
+			return
+		}
+	}
+	rows.Close()
+
+	_, err = tx.Exec(fmt.Sprintf("ALTER TABLE %s ADD COLUMN %s %s", table, name, definition))
+	added = err == nil
+	return
+}
@@ -0,0 +1,170 @@
+package database
+
+import (
+	"database/sql"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// fixtureDatabase makes a database from the SQL in testdata.
+func fixtureDatabase(t *testing.T, fixture string) (fileName string, cleanup func()) {
+	dir, err := ioutil.TempDir("", "kiki-migrations")
+	if err != nil {
+		t.Fatal(err)
+	}
+	cleanup = func() {
+		os.RemoveAll(dir)
+	}
+	fileName = filepath.Join(dir, "kiki.db")
+	script, err := ioutil.ReadFile(filepath.Join("testdata", fixture))
+	if err != nil {
+		t.Fatal(err)
+	}
+	db, err := sql.Open("sqlite3", fileName)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer db.Close()
+	_, err = db.Exec(string(script))
+	if err != nil {
+		t.Fatal(err)
+	}
+	return
+}
+
+func TestMigrateFromFirstSchema(t *testing.T) {
+	fileName, cleanup := fixtureDatabase(t, "schema_v0.sql")
+	defer cleanup()
+
+	api, err := Setup(fileName)
+	assert.Nil(t, err)
+
+	var version int
+	assert.Nil(t, api.Get("schema", "version", &version))
+	assert.Equal(t, SchemaVersion, version)
+
+	// existing letters are kept and can be read with the new columns
+	e, err := api.GetEnvelopeFromID("id1")
+	assert.Nil(t, err)
+	assert.Equal(t, "hello #kiki", e.Letter.Content)
+	assert.Equal(t, 0, e.Version)
+	assert.Equal(t, "zack", api.GetName("5QKi6C9ETCes6x7ZECmCFHezAEBg9rhBJ35rhhGX1KQa"))
+	var hashtags []string
+	assert.Nil(t, api.Get("globals", "hashtags", &hashtags))
+	assert.Equal(t, []string{"kiki"}, hashtags)
+
+	// the order that they were received is kept
+	ids, latest, err := api.GetIDsSince(0)
+	assert.Nil(t, err)
+	assert.Equal(t, []string{"id1", "id2", "id3"}, ids)
+	assert.Equal(t, int64(3), latest)
+	assert.Nil(t, api.Close())
+
+	// opening it again does not migrate again
+	api, err = Setup(fileName)
+	assert.Nil(t, err)
+	ids, _, err = api.GetIDsSince(2)
+	assert.Nil(t, err)
+	assert.Equal(t, []string{"id3"}, ids)
+	assert.Nil(t, api.Close())
+}
+
+func TestMigrateNewDatabase(t *testing.T) {
+	dir, err := ioutil.TempDir("", "kiki-migrations")
+	assert.Nil(t, err)
+	defer os.RemoveAll(dir)
+
+	api, err := Setup(filepath.Join(dir, "kiki.db"))
+	assert.Nil(t, err)
+	defer api.Close()
+	var version int
+	assert.Nil(t, api.Get("schema", "version", &version))
+	assert.Equal(t, SchemaVersion, version)
+}
+
+func TestMigrateNewerDatabase(t *testing.T) {
+	fileName, cleanup := fixtureDatabase(t, "schema_v0.sql")
+	defer cleanup()
+
+	api, err := Setup(fileName)
+	assert.Nil(t, err)
+	assert.Nil(t, api.Set("schema", "version", SchemaVersion+1))
+	assert.Nil(t, api.Close())
+
+	_, err = Setup(fileName)
+	assert.NotNil(t, err)
+}
+
+func TestMigrateFailureRollsBack(t *testing.T) {
+	fileName, cleanup := fixtureDatabase(t, "schema_v0.sql")
+	defer cleanup()
+
+	// a database that already has a column from the first migration, and a table
+	// in the way of the index of the second migration
+	db, err := sql.Open("sqlite3", fileName)
+	assert.Nil(t, err)
+	_, err = db.Exec("ALTER TABLE letters ADD COLUMN signing_key text not null default ''; CREATE TABLE idx_received (a text);")
+	assert.Nil(t, err)
+	db.Close()
+
+	_, err = Setup(fileName)
+	assert.NotNil(t, err)
+
+	// none of the migrations were applied
+	db, err = sql.Open("sqlite3", fileName)
+	assert.Nil(t, err)
+	defer db.Close()
+	var count int
+	assert.Nil(t, db.QueryRow("SELECT COUNT(*) FROM keystore WHERE bucket_key = 'schema/version'").Scan(&count))
+	assert.Equal(t, 0, count)
+	assert.Nil(t, db.QueryRow("SELECT COUNT(*) FROM pragma_table_info('letters') WHERE name = 'version'").Scan(&count))
+	assert.Equal(t, 0, count)
+}
+
+func TestMigrateOrigins(t *testing.T) {
+	fileName, cleanup := fixtureDatabase(t, "schema_v0.sql")
+	defer cleanup()
+	db, err := sql.Open("sqlite3", fileName)
+	assert.Nil(t, err)
+	_, err = db.Exec(`INSERT INTO keystore(bucket_key,value) VALUES ('origins/id1','"peer"'), ('origins/gone','"peer"');`)
+	assert.Nil(t, err)
+	db.Close()
+
+	// origins of letters that are gone are deleted, and the rest go with their letters
+	api, err := Setup(fileName)
+	assert.Nil(t, err)
+	defer api.Close()
+	origins, err := api.Keys("origins")
+	assert.Nil(t, err)
+	assert.Equal(t, []string{"id1"}, origins)
+	assert.Nil(t, api.RemoveLetters([]string{"id1"}))
+	origins, err = api.Keys("origins")
+	assert.Nil(t, err)
+	assert.Empty(t, origins)
+}
+
+func TestMigrateNotifications(t *testing.T) {
+	fileName, cleanup := fixtureDatabase(t, "schema_v0.sql")
+	defer cleanup()
+	db, err := sql.Open("sqlite3", fileName)
+	assert.Nil(t, err)
+	_, err = db.Exec(`INSERT INTO keystore(bucket_key,value) VALUES ('notifications/id1','{}'), ('notifications/gone','{}');`)
+	assert.Nil(t, err)
+	db.Close()
+
+	// notifications of letters that are gone are deleted, and the rest go with their letters
+	api, err := Setup(fileName)
+	assert.Nil(t, err)
+	defer api.Close()
+	notifications, err := api.Keys("notifications")
+	assert.Nil(t, err)
+	assert.Equal(t, []string{"id1"}, notifications)
+	assert.Nil(t, api.RemoveLetters([]string{"id1"}))
+	notifications, err = api.Keys("notifications")
+	assert.Nil(t, err)
+	assert.Empty(t, notifications)
+}
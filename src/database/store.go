@@ -0,0 +1,75 @@
+package database
+
+import (
+	"github.com/schollz/kiki/src/keypair"
+	"github.com/schollz/kiki/src/letter"
+)
+
+// Store is everything that a feed needs to keep its envelopes. DatabaseAPI keeps
+// them in sqlite and MemoryStore keeps them in memory.
+type Store interface {
+	// Close releases the store, it can not be used afterwards
+	Close() error
+
+	// Envelopes
+	AddEnvelope(e letter.Envelope) error
+	UpdateEnvelope(e letter.Envelope) error
+	GetEnvelopeFromID(id string) (letter.Envelope, error)
+	GetLatestEnvelopeFromID(id string) (letter.Envelope, error)
+	GetAllEnvelopes(opened ...bool) ([]letter.Envelope, error)
+	GetAllVersions(id string) ([]string, error)
+	GetIDs() (map[string]struct{}, error)
+	GetIDsSince(cursor int64) (ids []string, latest int64, err error)
+	RemoveLetters(ids []string) error
+	RemoveLettersForUser(user string) error
+	DiskSpaceForUser(user string) (int64, error)
+	ListUsers() ([]string, error)
+
+	// Pruning
+	DeleteUsersOldestPost(publicKey string) error
+	DeleteUsersOldestLargestPost(publicKey string) error
+	DeleteUsersEdits(publicKey string) error
+	DeleteOldActions(publicKey string) error
+	DeleteProfiles() error
+	DeleteUser(publicKey string) error
+	DeleteExpired() error
+
+	// Posts
+	GetBasicPosts() ([]letter.Envelope, error)
+	GetBasicPostsForUser(publicKey string) ([]letter.Envelope, error)
+	GetReplies(id string) ([]letter.Envelope, error)
+	GetTexts(senders ...string) ([]letter.Envelope, error)
+	GetEnvelopesFromTag1(tag string) ([]letter.Envelope, error)
+	NumberOfLikes(postID string) int64
+
+	// Keystore
+	Get(bucket, key string, value interface{}) error
+	Set(bucket, key string, value interface{}) error
+	Keys(bucket string) ([]string, error)
+	Delete(bucket, key string) error
+
+	// Tags
+	AddTags(idToTags map[string][]string) error
+
+	// Social graph
+	Friends(publicKey string) (followers, following, friends []string)
+	ListBlockedUsers(publicKey string) ([]string, error)
+	GetName(publicKey string) string
+	GetUser(publicKey string) (name, profile, image string)
+	GetFriendsName(publicKey string) string
+	GetKeys() ([]keypair.KeyPair, error)
+	GetKeysFromSender(sender string) ([]keypair.KeyPair, error)
+	GetLatestKeyForFriends(publicKey string) (keypair.KeyPair, error)
+
+	// REST API
+	GetPostsForApi() ([]ApiBasicPost, error)
+	GetPostForApi(postID string) ([]ApiBasicPost, error)
+	GetPostCommentsForApi(postID string) ([]ApiBasicPost, error)
+	GetPostVersionsForApi(postID string) ([]ApiBasicPost, error)
+	GetUserForApi(userID string) (ApiUser, error)
+}
+
+var (
+	_ Store = DatabaseAPI{}
+	_ Store = (*MemoryStore)(nil)
+)
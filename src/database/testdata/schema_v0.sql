@@ -0,0 +1,17 @@
+-- The schema of kiki.db before there were migrations (schema version 0), with a few rows.
+CREATE TABLE tags (tag TEXT, e_id TEXT);
+CREATE index tags_idx on tags(tag,e_id);
+create table keystore (bucket_key text not null primary key, value text);
+create index keystore_idx on keystore(bucket_key);
+create table letters (id text not null primary key, time TIMESTAMP, sender text, signature text, sealed_recipients text, sealed_letter text, opened integer, letter_purpose text, letter_to text, letter_content text, letter_firstid text, letter_replyto text, unique(id), UNIQUE(signature));
+CREATE INDEX idx_sender ON letters(opened,letter_purpose,sender,letter_content);
+CREATE INDEX idx_content ON letters(opened,letter_purpose,letter_content,id,letter_replyto);
+CREATE INDEX idx_replyto ON letters(opened,letter_purpose,letter_replyto);
+CREATE INDEX idx_replaces ON letters(opened,letter_firstid);
+CREATE INDEX idx_purpose ON letters(sender,letter_purpose);
+
+INSERT INTO keystore(bucket_key,value) VALUES ('globals/hashtags','["kiki"]');
+INSERT INTO letters VALUES ('id1','2018-05-01 10:00:00+00:00','5QKi6C9ETCes6x7ZECmCFHezAEBg9rhBJ35rhhGX1KQa','signature1','["r1"]','sealed1',1,'share-text','["5QKi6C9ETCes6x7ZECmCFHezAEBg9rhBJ35rhhGX1KQa"]','hello #kiki','id1','');
+INSERT INTO letters VALUES ('id2','2018-05-01 11:00:00+00:00','5QKi6C9ETCes6x7ZECmCFHezAEBg9rhBJ35rhhGX1KQa','signature2','["r1"]','sealed2',1,'action-assign/name','["5QKi6C9ETCes6x7ZECmCFHezAEBg9rhBJ35rhhGX1KQa"]','zack','id2','');
+INSERT INTO letters VALUES ('id3','2018-05-02 10:00:00+00:00','9NtrBdVXJnkc4EBUDbtHM8Cbsms4pMR82zYbcqNvmvfn','signature3','["r2"]','sealed3',0,'','null','','','');
+INSERT INTO tags VALUES ('kiki','id1');
@@ -0,0 +1,274 @@
+package feed
+
+import (
+	"bufio"
+	"bytes"
+	"compress/gzip"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"sync"
+
+	"github.com/pkg/errors"
+	"github.com/schollz/kiki/src/letter"
+)
+
+const (
+	// BatchSize is the most envelopes that are transfered in one request
+	BatchSize = 100
+	// MaxEnvelopeBytes is the largest envelope, as JSON, that is read from a batch,
+	// which is more than a friend can store
+	MaxEnvelopeBytes = 16 << 20
+	// MaxBatchBytes is the most bytes of a batch that are read, before and after
+	// it is decompressed
+	MaxBatchBytes = 64 << 20
+	// syncWorkers is the number of batches that are transfered at the same time
+	syncWorkers = 4
+)
+
+// errBatchUnsupported is returned when a peer does not have the batch routes
+var errBatchUnsupported = errors.New("peer does not support batches")
+
+// BatchResult is the result of processing one envelope of a batch
+type BatchResult struct {
+	ID    string `json:"id"`
+	Error string `json:"error,omitempty"`
+	// Rejected is set when the envelope did not fit in the storage of its sender
+	Rejected bool `json:"rejected,omitempty"`
+	// Invalid is set when the envelope had a bad stamp or signature
+	Invalid bool `json:"invalid,omitempty"`
+}
+
+// WriteEnvelopes writes the closed envelopes with the given IDs to w as gzipped
+// newline delimited JSON. IDs that are not found are skipped.
+func (f *Feed) WriteEnvelopes(w io.Writer, ids []string) (err error) {
+	if len(ids) > BatchSize {
+		return fmt.Errorf("too many envelopes, maximum is %d", BatchSize)
+	}
+	gz := gzip.NewWriter(w)
+	enc := json.NewEncoder(gz)
+	for _, id := range ids {
+		e, errGet := f.GetEnvelope(id)
+		if errGet != nil {
+			f.logger.Log.Debugf("skipping %s: %s", id, errGet.Error())
+			continue
+		}
+		e.Close()
+		err = enc.Encode(e)
+		if err != nil {
+			return
+		}
+	}
+	return gz.Close()
+}
+
+// ReadEnvelopes processes each envelope in the gzipped newline delimited JSON of r,
+// as it came from the peer. An envelope that is not accepted does not stop the rest.
+// Validated envelopes for which one of the checks returns an error are not taken in. At most
+// MaxBatchBytes are read from r and decompressed, and each envelope can be at most
+// MaxEnvelopeBytes.
+func (f *Feed) ReadEnvelopes(r io.Reader, peer string, checks ...func(letter.Envelope) error) (results []BatchResult, err error) {
+	gz, err := gzip.NewReader(io.LimitReader(r, MaxBatchBytes))
+	if err != nil {
+		return
+	}
+	defer gz.Close()
+	decompressed := &io.LimitedReader{R: gz, N: MaxBatchBytes}
+	scanner := bufio.NewScanner(decompressed)
+	scanner.Buffer(make([]byte, 64*1024), MaxEnvelopeBytes)
+	results = []BatchResult{}
+	for scanner.Scan() {
+		line := bytes.TrimSpace(scanner.Bytes())
+		if len(line) == 0 {
+			continue
+		}
+		var e letter.Envelope
+		err = json.Unmarshal(line, &e)
+		if err != nil && decompressed.N == 0 {
+			err = fmt.Errorf("batch is too large, maximum is %d bytes", MaxBatchBytes)
+			return
+		} else if err != nil {
+			err = errors.Wrap(err, "could not decode")
+			return
+		}
+		if len(results) == BatchSize {
+			err = fmt.Errorf("too many envelopes, maximum is %d", BatchSize)
+			return
+		}
+		result := BatchResult{ID: e.ID}
+		errProcess := f.ProcessEnvelope(e, peer, checks...)
+		if errProcess != nil {
+			f.logger.Log.Debugf("batch from %s, %s: %s", peer, e.ID, errProcess.Error())
+			result.Error = errProcess.Error()
+			_, result.Rejected = errors.Cause(errProcess).(StorageLimitError)
+			_, result.Invalid = errors.Cause(errProcess).(InvalidEnvelopeError)
+		}
+		results = append(results, result)
+	}
+	err = scanner.Err()
+	if err == bufio.ErrTooLong {
+		err = fmt.Errorf("envelope is too large, maximum is %d bytes", MaxEnvelopeBytes)
+	} else if err == nil && decompressed.N == 0 {
+		err = fmt.Errorf("batch is too large, maximum is %d bytes", MaxBatchBytes)
+	} else if err != nil {
+		err = errors.Wrap(err, "could not read")
+	}
+	return
+}
+
+// DownloadEnvelopes will download the specified envelopes in one request
+func (f *Feed) DownloadEnvelopes(address string, ids []string) (err error) {
+	payloadBytes, err := json.Marshal(map[string][]string{"ids": ids})
+	if err != nil {
+		return
+	}
+	req, err := http.NewRequest("POST", address+"/batch/download", bytes.NewReader(payloadBytes))
+	if err != nil {
+		return errors.Wrap(err, "problem making req")
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("Accept-Encoding", "gzip")
+
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return errors.Wrap(err, "problem doing req")
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode == http.StatusNotFound {
+		return errBatchUnsupported
+	}
+	if resp.StatusCode != http.StatusOK {
+		var target Response
+		json.NewDecoder(resp.Body).Decode(&target)
+		return errors.Wrap(errors.New(target.Error), "bad status")
+	}
+
+	results, err := f.ReadEnvelopes(resp.Body, address)
+	if err != nil {
+		return
+	}
+	f.logger.Log.Debugf("downloaded %d envelopes from %s", len(results), address)
+	return
+}
+
+// UploadEnvelopes will upload the specified envelopes in one request
+func (f *Feed) UploadEnvelopes(address string, ids []string) (err error) {
+	var body bytes.Buffer
+	err = f.WriteEnvelopes(&body, ids)
+	if err != nil {
+		return
+	}
+
+	req, err := http.NewRequest("POST", address+"/batch/envelopes", &body)
+	if err != nil {
+		return
+	}
+	req.Header.Set("Content-Type", "application/x-ndjson")
+	req.Header.Set("Content-Encoding", "gzip")
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode == http.StatusNotFound {
+		return errBatchUnsupported
+	}
+
+	var target Response
+	err = json.NewDecoder(resp.Body).Decode(&target)
+	if err != nil {
+		return
+	}
+	if "ok" != target.Status {
+		return errors.New(target.Error)
+	}
+	for _, result := range target.Results {
+		if result.Rejected {
+			f.logger.Log.Debugf("%s rejected %s: %s", address, result.ID, result.Error)
+		} else if result.Error != "" {
+			f.logger.Log.Debugf("%s did not accept %s: %s", address, result.ID, result.Error)
+		}
+	}
+
+	f.logger.Log.Debugf("uploaded %d envelopes to %s", len(ids), address)
+	return
+}
+
+// transferEnvelopes splits the IDs into batches and transfers them with a bounded
+// number of workers. If the peer does not support batches, each envelope is
+// transfered on its own instead.
+func (f *Feed) transferEnvelopes(ids []string, batch func([]string) error, single func(string) error) (err error) {
+	batches := make(chan []string)
+	go func() {
+		for i := 0; i < len(ids); i += BatchSize {
+			end := i + BatchSize
+			if end > len(ids) {
+				end = len(ids)
+			}
+			batches <- ids[i:end]
+		}
+		close(batches)
+	}()
+
+	var mutex sync.Mutex
+	unsupported := false
+	var wg sync.WaitGroup
+	for i := 0; i < syncWorkers; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for b := range batches {
+				mutex.Lock()
+				skipBatch := unsupported
+				failed := err != nil
+				mutex.Unlock()
+				if failed {
+					continue
+				}
+
+				var errTransfer error
+				if !skipBatch {
+					errTransfer = batch(b)
+				}
+				if skipBatch || errTransfer == errBatchUnsupported {
+					mutex.Lock()
+					unsupported = true
+					mutex.Unlock()
+					errTransfer = nil
+					for _, id := range b {
+						errTransfer = single(id)
+						if errTransfer != nil {
+							break
+						}
+					}
+				}
+				if errTransfer != nil {
+					mutex.Lock()
+					if err == nil {
+						err = errTransfer
+					}
+					mutex.Unlock()
+				}
+			}
+		}()
+	}
+	wg.Wait()
+	return
+}
+
+// DownloadAll downloads all the specified envelopes from the address
+func (f *Feed) DownloadAll(address string, ids []string) (err error) {
+	return f.transferEnvelopes(ids,
+		func(b []string) error { return f.DownloadEnvelopes(address, b) },
+		func(id string) error { return f.DownloadEnvelope(address, id) },
+	)
+}
+
+// UploadAll uploads all the specified envelopes to the address
+func (f *Feed) UploadAll(address string, ids []string) (err error) {
+	return f.transferEnvelopes(ids,
+		func(b []string) error { return f.UploadEnvelopes(address, b) },
+		func(id string) error { return f.UploadEnvelope(address, id) },
+	)
+}
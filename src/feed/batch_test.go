@@ -0,0 +1,121 @@
+package feed
+
+import (
+	"bytes"
+	"compress/gzip"
+	"encoding/json"
+	"sort"
+	"strconv"
+	"sync"
+	"testing"
+
+	"github.com/pkg/errors"
+	"github.com/schollz/kiki/src/letter"
+	"github.com/schollz/kiki/src/purpose"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTransferEnvelopes(t *testing.T) {
+	var ids []string
+	for i := 0; i < 2*BatchSize+10; i++ {
+		ids = append(ids, strconv.Itoa(i))
+	}
+	var mutex sync.Mutex
+	var batched, singled []string
+
+	// every envelope is sent in a batch
+	err := new(Feed).transferEnvelopes(ids, func(b []string) error {
+		assert.True(t, len(b) <= BatchSize)
+		mutex.Lock()
+		batched = append(batched, b...)
+		mutex.Unlock()
+		return nil
+	}, func(id string) error {
+		singled = append(singled, id)
+		return nil
+	})
+	assert.Nil(t, err)
+	assert.Empty(t, singled)
+	sort.Strings(batched)
+	sorted := append([]string{}, ids...)
+	sort.Strings(sorted)
+	assert.Equal(t, sorted, batched)
+
+	// peers without batches get every envelope on its own
+	singled = []string{}
+	err = new(Feed).transferEnvelopes(ids, func(b []string) error {
+		return errBatchUnsupported
+	}, func(id string) error {
+		mutex.Lock()
+		singled = append(singled, id)
+		mutex.Unlock()
+		return nil
+	})
+	assert.Nil(t, err)
+	sort.Strings(singled)
+	assert.Equal(t, sorted, singled)
+
+	// errors are returned
+	err = new(Feed).transferEnvelopes(ids, func(b []string) error {
+		return errors.New("no route")
+	}, func(id string) error {
+		return nil
+	})
+	assert.NotNil(t, err)
+}
+
+func TestReadEnvelopesLimits(t *testing.T) {
+	alice, bob := newTestFeed(t, "alice"), newTestFeed(t, "bob")
+	post, err := alice.ProcessLetter(letter.Letter{To: []string{"public"}, Purpose: purpose.ShareText, Content: "hello"})
+	assert.Nil(t, err)
+	var batch bytes.Buffer
+	assert.Nil(t, alice.WriteEnvelopes(&batch, []string{post.ID}))
+	results, err := bob.ReadEnvelopes(&batch, "peer")
+	assert.Nil(t, err)
+	assert.Equal(t, 1, len(results))
+	assert.Empty(t, results[0].Error)
+
+	// an envelope that is too large is not read into memory
+	batch.Reset()
+	gz := gzip.NewWriter(&batch)
+	gz.Write([]byte(`{"id":"`))
+	gz.Write(bytes.Repeat([]byte("a"), MaxEnvelopeBytes))
+	gz.Write([]byte("\"}\n"))
+	assert.Nil(t, gz.Close())
+	_, err = bob.ReadEnvelopes(&batch, "peer")
+	assert.NotNil(t, err)
+	assert.Contains(t, err.Error(), "envelope is too large")
+}
+
+func TestReadEnvelopesChecks(t *testing.T) {
+	alice, bob := newTestFeed(t, "alice"), newTestFeed(t, "bob")
+	post, err := alice.ProcessLetter(letter.Letter{To: []string{"public"}, Purpose: purpose.ShareText, Content: "hello"})
+	assert.Nil(t, err)
+	sealed, err := alice.db.GetEnvelopeFromID(post.ID)
+	assert.Nil(t, err)
+	sealed.Close()
+	forged := sealed
+	forged.ID = "forged"
+	forged.Signature = sealed.Signature[:len(sealed.Signature)/2]
+
+	var batch bytes.Buffer
+	gz := gzip.NewWriter(&batch)
+	for _, e := range []letter.Envelope{forged, sealed} {
+		b, _ := json.Marshal(e)
+		gz.Write(append(b, '\n'))
+	}
+	assert.Nil(t, gz.Close())
+
+	// only validated envelopes are checked
+	var checked []string
+	results, err := bob.ReadEnvelopes(&batch, "peer", func(e letter.Envelope) error {
+		checked = append(checked, e.ID)
+		return nil
+	})
+	assert.Nil(t, err)
+	assert.Equal(t, []string{sealed.ID}, checked)
+	assert.Equal(t, 2, len(results))
+	assert.True(t, results[0].Invalid)
+	assert.False(t, results[1].Invalid)
+	assert.Empty(t, results[1].Error)
+}
@@ -0,0 +1,115 @@
+package feed
+
+import (
+	crypto_rand "crypto/rand"
+	"encoding/json"
+	"net/http"
+	"time"
+
+	"github.com/mr-tron/base58/base58"
+	"github.com/pkg/errors"
+	"github.com/schollz/kiki/src/keypair"
+	"github.com/schollz/kiki/src/letter"
+)
+
+// challengeDuration is how long a nonce can be used after it is issued
+const challengeDuration = 1 * time.Minute
+
+// Headers used to authenticate requests between servers
+const (
+	HeaderUser  = "X-Kiki-User"
+	HeaderNonce = "X-Kiki-Nonce"
+	HeaderProof = "X-Kiki-Proof"
+)
+
+// NewChallenge issues a nonce that can be used once to authenticate one request.
+func (f *Feed) NewChallenge() (nonce string, err error) {
+	b := make([]byte, 32)
+	_, err = crypto_rand.Read(b)
+	if err != nil {
+		return
+	}
+	nonce = base58.FastBase58Encoding(b)
+	f.caching.Set("challenge-"+nonce, struct{}{}, challengeDuration)
+	return
+}
+
+// Authenticate checks that the proof was made by the owner of the public key for
+// the nonce and the request, with the signing key that is pinned for them if there
+// is one. The nonce can not be used again afterwards.
+func (f *Feed) Authenticate(pubkey, nonce, proof, method, path string) (err error) {
+	requester, err := keypair.FromPublic(pubkey)
+	if err != nil {
+		return
+	}
+	if _, ok := f.caching.Get("challenge-" + nonce); !ok {
+		return errors.New("unknown or expired nonce")
+	}
+	signingKey, err := requester.SigningKey()
+	if err != nil {
+		return
+	}
+	var pin signingKeyPin
+	if f.db.Get("signing-keys", pubkey, &pin) == nil && pin.Version == letter.VersionXEdDSA && pin.SigningKey != signingKey {
+		return errors.New("signing key does not match the one known for the requester")
+	}
+	err = f.RegionKey.ValidateProof(proof, requester, nonce, method, path)
+	if err != nil {
+		return
+	}
+	if !f.caching.Delete("challenge-" + nonce) {
+		return errors.New("nonce already used")
+	}
+	return
+}
+
+// AuthenticateLegacy checks the old signature which is not bound to a request, if it
+// is still allowed.
+func (f *Feed) AuthenticateLegacy(pubkey, signature string) (err error) {
+	if !f.Settings.AllowLegacyAuth {
+		return errors.New("signatures without a nonce are not allowed")
+	}
+	requester, err := keypair.FromPublic(pubkey)
+	if err != nil {
+		return
+	}
+	return f.RegionKey.Validate(signature, requester)
+}
+
+// authorize gets a nonce from the address and adds a proof for the request to it.
+// Servers that do not issue nonces get the old signature instead.
+func (f *Feed) authorize(address string, req *http.Request) (err error) {
+	resp, err := http.Get(address + "/challenge")
+	if err != nil {
+		return
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode == http.StatusNotFound {
+		signature, errSignature := f.PersonalKey.Signature(f.RegionKey)
+		if errSignature != nil {
+			return errSignature
+		}
+		q := req.URL.Query()
+		q.Set("user_pub", f.PersonalKey.Public)
+		q.Set("signature", signature)
+		req.URL.RawQuery = q.Encode()
+		return
+	}
+
+	var target Response
+	err = json.NewDecoder(resp.Body).Decode(&target)
+	if err != nil {
+		return
+	}
+	if "ok" != target.Status {
+		return errors.New(target.Error)
+	}
+	proof, err := f.PersonalKey.Proof(f.RegionKey, target.Nonce, req.Method, req.URL.RequestURI())
+	if err != nil {
+		return
+	}
+	req.Header.Set(HeaderUser, f.PersonalKey.Public)
+	req.Header.Set(HeaderNonce, target.Nonce)
+	req.Header.Set(HeaderProof, proof)
+	return
+}
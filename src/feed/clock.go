@@ -0,0 +1,43 @@
+package feed
+
+import (
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/schollz/kiki/src/letter"
+)
+
+// checkTimestamp checks that the envelope was not made too far in the future or in
+// the past. Edits are decided and storage is purged by the time of the envelopes, so
+// a sender with a clock far in the future would win every edit and never be purged.
+func (f *Feed) checkTimestamp(e letter.Envelope, now time.Time) (err error) {
+	if f.Settings.MaxClockSkew > 0 && e.Timestamp.After(now.Add(time.Duration(f.Settings.MaxClockSkew)*time.Second)) {
+		return errors.Errorf("timestamp %s is more than %ds in the future", e.Timestamp.Format(time.RFC3339), f.Settings.MaxClockSkew)
+	}
+	if f.Settings.MaxEnvelopeAge > 0 && e.Timestamp.Before(now.Add(-time.Duration(f.Settings.MaxEnvelopeAge)*time.Second)) {
+		return errors.Errorf("timestamp %s is more than %ds in the past", e.Timestamp.Format(time.RFC3339), f.Settings.MaxEnvelopeAge)
+	}
+	return
+}
+
+// clockOffset estimates how far the clock of a peer is ahead of ours, from the time
+// it reported when it answered a request that was sent and received at the given
+// times. The offset is logged as a warning when it is more than the clock skew
+// that envelopes are allowed to have.
+func (f *Feed) clockOffset(address string, theirs, sent, received time.Time) (offset time.Duration) {
+	if theirs.IsZero() {
+		// peers from before the time was reported
+		return
+	}
+	offset = theirs.Sub(sent.Add(received.Sub(sent) / 2))
+	skew := offset
+	if skew < 0 {
+		skew = -skew
+	}
+	if f.Settings.MaxClockSkew > 0 && skew > time.Duration(f.Settings.MaxClockSkew)*time.Second {
+		f.logger.Log.Warnf("clock of %s is off by %s, its envelopes may be quarantined", address, offset)
+	} else {
+		f.logger.Log.Debugf("clock of %s is off by %s", address, offset)
+	}
+	return
+}
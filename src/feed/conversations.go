@@ -0,0 +1,234 @@
+package feed
+
+import (
+	"crypto/sha256"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/mr-tron/base58/base58"
+	"github.com/pkg/errors"
+	"github.com/schollz/kiki/src/letter"
+)
+
+// Conversation is a thread of the posts that are sent only between the same
+// people, without going to the public, to friends or to a group.
+type Conversation struct {
+	ID string `json:"id"`
+	// Participants are the public keys of everyone in the conversation, you included
+	Participants []string `json:"participants"`
+	// Recipients are the participants other than you, and Names are their names
+	Recipients []string `json:"recipients"`
+	Names      []string `json:"names"`
+	Messages   int      `json:"messages"`
+	Unread     int      `json:"unread"`
+	Latest     Message  `json:"latest"`
+}
+
+// Message is a post in a conversation.
+type Message struct {
+	ID         string `json:"id"`
+	FirstID    string `json:"first_id"`
+	ReplyTo    string `json:"reply_to"`
+	Sender     string `json:"sender"`
+	SenderName string `json:"sender_name"`
+	Content    string `json:"content"`
+	Timestamp  int64  `json:"timestamp"`
+	Unread     bool   `json:"unread"`
+}
+
+// conversationID is the same for every set of participants, in any order.
+func conversationID(participants []string) string {
+	h := sha256.Sum256([]byte(strings.Join(participants, ",")))
+	return base58.FastBase58Encoding(h[:])
+}
+
+// sharedKeys returns the public keys that letters are sealed with when they are not
+// only for the people they are sent to, which are the region key and the friends
+// and group keys. They are kept until a key is added.
+func (f *Feed) sharedKeys() (shared map[string]struct{}, err error) {
+	f.keyCache.Lock()
+	defer f.keyCache.Unlock()
+	if cached, found := f.caching.Get("shared-keys"); found {
+		return cached.(map[string]struct{}), nil
+	}
+	keys, err := f.db.GetKeys()
+	if err != nil {
+		return
+	}
+	shared = map[string]struct{}{f.RegionKey.Public: {}}
+	for _, key := range keys {
+		shared[key.Public] = struct{}{}
+	}
+	f.caching.Set("shared-keys", shared, -1)
+	return
+}
+
+// conversationEnvelopes returns the latest version of the posts in each
+// conversation, the latest first, along with who is in each conversation. Only the
+// texts from the senders are looked at, or every text if there are no senders.
+func (f *Feed) conversationEnvelopes(senders ...string) (threads map[string][]letter.Envelope, participants map[string][]string, err error) {
+	es, err := f.db.GetTexts(senders...)
+	if err != nil {
+		return
+	}
+	// letters that are sealed with a key that is shared with others are not private
+	shared, err := f.sharedKeys()
+	if err != nil {
+		return
+	}
+
+	threads = make(map[string][]letter.Envelope)
+	participants = make(map[string][]string)
+	for _, e := range es {
+		people := map[string]struct{}{e.Sender.Public: {}}
+		private := true
+		for _, to := range e.Letter.To {
+			if _, ok := shared[to]; ok {
+				private = false
+				break
+			}
+			people[to] = struct{}{}
+		}
+		if _, ok := people[f.PersonalKey.Public]; !private || !ok || len(people) < 2 {
+			continue
+		}
+		sorted := make([]string, 0, len(people))
+		for person := range people {
+			sorted = append(sorted, person)
+		}
+		sort.Strings(sorted)
+		id := conversationID(sorted)
+		threads[id] = append(threads[id], e)
+		participants[id] = sorted
+		// the participants of a conversation never change, since they make its ID
+		f.caching.Set("conversation-"+id, sorted, -1)
+	}
+	return
+}
+
+// readUntil returns the time of the latest message of the conversation that was read.
+func (f *Feed) readUntil(id string) (t time.Time) {
+	f.db.Get("conversations", id, &t)
+	return
+}
+
+// unread tells whether someone else sent the post after the conversation was last read.
+func (f *Feed) unread(e letter.Envelope, readUntil time.Time) bool {
+	return e.Sender.Public != f.PersonalKey.Public && e.Timestamp.After(readUntil)
+}
+
+func (f *Feed) makeMessage(e letter.Envelope, readUntil time.Time) Message {
+	return Message{
+		ID:         e.ID,
+		FirstID:    e.Letter.FirstID,
+		ReplyTo:    e.Letter.ReplyTo,
+		Sender:     e.Sender.Public,
+		SenderName: f.db.GetName(e.Sender.Public),
+		Content:    e.Letter.Content,
+		Timestamp:  e.Timestamp.Unix(),
+		Unread:     f.unread(e, readUntil),
+	}
+}
+
+// GetConversations returns the conversations that you are in, the latest first.
+func (f *Feed) GetConversations() (cs []Conversation, err error) {
+	threads, participants, err := f.conversationEnvelopes()
+	if err != nil {
+		return
+	}
+	cs = make([]Conversation, 0, len(threads))
+	// the messages only have the time to the second, so the conversations are
+	// sorted by the full time of their latest envelope
+	latest := make(map[string]time.Time, len(threads))
+	for id, es := range threads {
+		c := Conversation{
+			ID:           id,
+			Participants: participants[id],
+			Recipients:   []string{},
+			Names:        []string{},
+			Messages:     len(es),
+		}
+		for _, person := range c.Participants {
+			if person == f.PersonalKey.Public {
+				continue
+			}
+			name := f.db.GetName(person)
+			if name == "" {
+				name = person
+			}
+			c.Recipients = append(c.Recipients, person)
+			c.Names = append(c.Names, name)
+		}
+		readUntil := f.readUntil(id)
+		for _, e := range es {
+			if f.unread(e, readUntil) {
+				c.Unread++
+			}
+		}
+		c.Latest = f.makeMessage(es[0], readUntil)
+		latest[id] = es[0].Timestamp
+		cs = append(cs, c)
+	}
+	sort.Slice(cs, func(i, j int) bool {
+		return latest[cs[i].ID].After(latest[cs[j].ID])
+	})
+	return
+}
+
+// conversation returns the posts of a conversation, the latest first. Once it is
+// known who is in the conversation, only their texts are looked at.
+func (f *Feed) conversation(id string) (es []letter.Envelope, err error) {
+	var senders []string
+	if cached, found := f.caching.Get("conversation-" + id); found {
+		senders = cached.([]string)
+	}
+	threads, _, err := f.conversationEnvelopes(senders...)
+	if err != nil {
+		return
+	}
+	es, ok := threads[id]
+	if !ok {
+		err = errors.New("no such conversation")
+	}
+	return
+}
+
+// conversationPosts returns the posts of a conversation that are not replies, which
+// are shown with their replies in the feed.
+func (f *Feed) conversationPosts(id string) (posts []letter.Envelope, err error) {
+	es, err := f.conversation(id)
+	if err != nil {
+		return
+	}
+	posts = []letter.Envelope{}
+	for _, e := range es {
+		if e.Letter.ReplyTo == "" {
+			posts = append(posts, e)
+		}
+	}
+	return
+}
+
+// GetMessages returns the messages of a conversation, the latest first.
+func (f *Feed) GetMessages(id string) (messages []Message, err error) {
+	es, err := f.conversation(id)
+	if err != nil {
+		return
+	}
+	readUntil := f.readUntil(id)
+	messages = make([]Message, len(es))
+	for i, e := range es {
+		messages[i] = f.makeMessage(e, readUntil)
+	}
+	return
+}
+
+// MarkConversationRead marks every message that is in a conversation as read.
+func (f *Feed) MarkConversationRead(id string) (err error) {
+	es, err := f.conversation(id)
+	if err != nil {
+		return
+	}
+	return f.db.Set("conversations", id, es[0].Timestamp)
+}
@@ -0,0 +1,94 @@
+package feed
+
+import (
+	"testing"
+
+	"github.com/schollz/kiki/src/letter"
+	"github.com/schollz/kiki/src/purpose"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestConversations(t *testing.T) {
+	alice, bob, carol := newTestFeed(t, "alice"), newTestFeed(t, "bob"), newTestFeed(t, "carol")
+	send := func(g *Feed, l letter.Letter) letter.Envelope {
+		if l.Purpose == "" {
+			l.Purpose = purpose.ShareText
+		}
+		e, err := g.ProcessLetter(l)
+		assert.Nil(t, err)
+		return e
+	}
+
+	first := send(alice, letter.Letter{To: []string{bob.PersonalKey.Public}, Content: "hi bob"})
+	send(bob, letter.Letter{To: []string{alice.PersonalKey.Public}, Content: "hi alice", ReplyTo: first.ID})
+	send(alice, letter.Letter{To: []string{bob.PersonalKey.Public, carol.PersonalKey.Public}, Content: "hi both"})
+	// posts that are not only between people are not in conversations
+	send(alice, letter.Letter{To: []string{"public"}, Content: "hi everyone"})
+	send(alice, letter.Letter{To: []string{"friends"}, Content: "hi friends"})
+	send(alice, letter.Letter{To: []string{"self"}, Content: "a note"})
+	send(alice, letter.Letter{To: []string{bob.PersonalKey.Public}, Purpose: purpose.ActionName, Content: "alice"})
+	shareAll(t, alice, bob, carol)
+
+	cs, err := bob.GetConversations()
+	assert.Nil(t, err)
+	assert.Equal(t, 2, len(cs))
+	assert.Equal(t, []string{alice.PersonalKey.Public}, cs[1].Recipients)
+	assert.Equal(t, []string{"alice"}, cs[1].Names)
+	assert.Equal(t, 2, cs[1].Messages)
+	assert.Equal(t, 1, cs[1].Unread)
+	assert.Equal(t, "<p>hi alice</p>", cs[1].Latest.Content)
+	assert.Equal(t, 3, len(cs[0].Participants))
+	assert.Equal(t, 2, len(cs[0].Recipients))
+
+	// everyone in a conversation knows it by the same ID
+	aliceCs, err := alice.GetConversations()
+	assert.Nil(t, err)
+	assert.Equal(t, 2, len(aliceCs))
+	assert.Equal(t, cs[1].ID, aliceCs[1].ID)
+	assert.Equal(t, 1, aliceCs[1].Unread)
+	carolCs, err := carol.GetConversations()
+	assert.Nil(t, err)
+	assert.Equal(t, 1, len(carolCs))
+	assert.Equal(t, cs[0].ID, carolCs[0].ID)
+
+	// reading a conversation marks its messages as read, until someone writes again
+	messages, err := bob.GetMessages(cs[1].ID)
+	assert.Nil(t, err)
+	assert.Equal(t, 2, len(messages))
+	assert.Equal(t, "<p>hi alice</p>", messages[0].Content)
+	assert.False(t, messages[0].Unread)
+	assert.Equal(t, "<p>hi bob</p>", messages[1].Content)
+	assert.True(t, messages[1].Unread)
+	assert.Nil(t, bob.MarkConversationRead(cs[1].ID))
+	cs, err = bob.GetConversations()
+	assert.Nil(t, err)
+	assert.Equal(t, 0, cs[1].Unread)
+
+	// edits replace the message that they edit
+	send(alice, letter.Letter{To: []string{bob.PersonalKey.Public}, Content: "hello bob", FirstID: first.ID})
+	shareAll(t, alice, bob, carol)
+	messages, err = bob.GetMessages(cs[1].ID)
+	assert.Nil(t, err)
+	assert.Equal(t, 2, len(messages))
+	assert.Equal(t, "<p>hello bob</p>", messages[0].Content)
+	assert.True(t, messages[0].Unread)
+
+	// the conversation is shown in the feed, with the replies under the posts
+	posts, err := bob.ShowFeed(ShowFeedParameters{Conversation: cs[1].ID})
+	assert.Nil(t, err)
+	assert.Equal(t, 1, len(posts))
+	assert.Equal(t, 1, len(posts[0].Comments))
+
+	// keys that are shared after the conversations were listed are known right away
+	g, err := alice.NewGroup("pair", []string{bob.PersonalKey.Public})
+	assert.Nil(t, err)
+	send(alice, letter.Letter{To: []string{"group:" + g.ID}, Content: "hi group"})
+	shareAll(t, alice, bob, carol)
+	cs, err = bob.GetConversations()
+	assert.Nil(t, err)
+	assert.Equal(t, 2, len(cs))
+
+	_, err = bob.GetMessages("nothing")
+	assert.NotNil(t, err)
+	assert.NotNil(t, bob.MarkConversationRead("nothing"))
+}
@@ -0,0 +1,159 @@
+package feed
+
+import (
+	"sync"
+	"time"
+
+	"github.com/schollz/kiki/src/letter"
+	"github.com/schollz/kiki/src/purpose"
+)
+
+// The types of events
+const (
+	EventPost    = "post"    // a new post
+	EventEdit    = "edit"    // a post was edited or deleted
+	EventComment = "comment" // a new comment on a post
+	EventLike    = "like"    // a post was liked
+	EventFollow  = "follow"  // someone followed someone
+	EventPeer    = "peer"    // a new peer was connected
+	EventSynced  = "synced"  // syncing with a peer finished
+	// EventNotification is a new notification, of the type in Notification
+	EventNotification = "notification"
+	// EventReset is sent first when events were missed since the given event ID,
+	// so that the client fetches everything again
+	EventReset = "reset"
+)
+
+const (
+	// eventBacklog is how many events are kept for clients that resume
+	eventBacklog = 1000
+	// eventBuffer is how many events a subscriber can fall behind before it is
+	// dropped, after which it can resume from the last event it got
+	eventBuffer = 100
+)
+
+// Event is something that happened, as it is streamed to clients. The ID of events
+// always increases, also across restarts.
+type Event struct {
+	ID   int64     `json:"id"`
+	Type string    `json:"type"`
+	Time time.Time `json:"time"`
+	// User is who sent the letter of the event
+	User string `json:"user,omitempty"`
+	// PostID is the first ID of the post that the event is about
+	PostID  string `json:"post_id,omitempty"`
+	ReplyTo string `json:"reply_to,omitempty"`
+	// Target is who was followed
+	Target       string `json:"target,omitempty"`
+	Peer         string `json:"peer,omitempty"`
+	Downloaded   int    `json:"downloaded,omitempty"`
+	Uploaded     int    `json:"uploaded,omitempty"`
+	Notification string `json:"notification,omitempty"`
+}
+
+// events keeps the latest events and the channels of the subscribers.
+type events struct {
+	lastID int64
+	// since is the ID from which on every event is in the backlog
+	since       int64
+	backlog     []Event
+	subscribers map[chan Event]struct{}
+	sync.Mutex
+}
+
+// init starts the events, so that clients resuming from before now are reset.
+func (es *events) init() {
+	if es.subscribers == nil {
+		es.since = time.Now().UnixNano()
+		es.subscribers = make(map[chan Event]struct{})
+	}
+}
+
+// emit gives the event an ID and sends it to every subscriber.
+func (f *Feed) emit(e Event) {
+	f.events.Lock()
+	defer f.events.Unlock()
+	f.events.init()
+	e.Time = time.Now().UTC()
+	e.ID = e.Time.UnixNano()
+	if e.ID <= f.events.lastID {
+		e.ID = f.events.lastID + 1
+	}
+	f.events.lastID = e.ID
+
+	f.events.backlog = append(f.events.backlog, e)
+	if len(f.events.backlog) > eventBacklog {
+		f.events.since = f.events.backlog[0].ID + 1
+		f.events.backlog = f.events.backlog[1:]
+	}
+	for ch := range f.events.subscribers {
+		select {
+		case ch <- e:
+		default:
+			f.logger.Log.Debug("dropping subscriber that fell behind")
+			delete(f.events.subscribers, ch)
+			close(ch)
+		}
+	}
+}
+
+// emitLetter emits the event of a letter that was opened, and fires the webhooks
+// of it, once for each envelope.
+func (f *Feed) emitLetter(e letter.Envelope) {
+	ev := Event{User: e.Sender.Public}
+	switch {
+	case e.Letter.Purpose == purpose.ShareText && e.Letter.FirstID != e.ID:
+		ev.Type, ev.PostID, ev.ReplyTo = EventEdit, e.Letter.FirstID, e.Letter.ReplyTo
+	case e.Letter.Purpose == purpose.ShareText && e.Letter.ReplyTo != "":
+		ev.Type, ev.PostID, ev.ReplyTo = EventComment, e.Letter.FirstID, e.Letter.ReplyTo
+	case e.Letter.Purpose == purpose.ShareText:
+		ev.Type, ev.PostID = EventPost, e.Letter.FirstID
+	case e.Letter.Purpose == purpose.ActionLike:
+		ev.Type, ev.PostID = EventLike, e.Letter.Content
+	case e.Letter.Purpose == purpose.ActionFollow:
+		ev.Type, ev.Target = EventFollow, e.Letter.Content
+	default:
+		return
+	}
+	// letters that you write are emitted when they are written and again when
+	// they are opened
+	if f.caching.Add("event-"+e.ID, struct{}{}, time.Hour) != nil {
+		return
+	}
+	f.emit(ev)
+	f.fireWebhooks(e)
+}
+
+// Subscribe returns the events after lastID, and a channel with the events that
+// happen from now on, which is closed if the subscriber falls behind. If lastID is
+// 0 no events are returned, and if events after it were missed a reset event is
+// returned first. The subscription ends with cancel.
+func (f *Feed) Subscribe(lastID int64) (backlog []Event, ch chan Event, cancel func()) {
+	f.events.Lock()
+	defer f.events.Unlock()
+	f.events.init()
+	backlog = []Event{}
+	if lastID > 0 {
+		// every event from since on is in the backlog
+		if lastID < f.events.since-1 {
+			backlog = append(backlog, Event{ID: f.events.since - 1, Type: EventReset, Time: time.Now().UTC()})
+		}
+		for _, e := range f.events.backlog {
+			if e.ID > lastID {
+				backlog = append(backlog, e)
+			}
+		}
+	}
+
+	ch = make(chan Event, eventBuffer)
+	f.events.subscribers[ch] = struct{}{}
+	cancel = func() {
+		f.events.Lock()
+		defer f.events.Unlock()
+		if _, ok := f.events.subscribers[ch]; ok {
+			delete(f.events.subscribers, ch)
+			close(ch)
+		}
+	}
+	return
+}
@@ -0,0 +1,90 @@
+package feed
+
+import (
+	"testing"
+
+	"github.com/schollz/kiki/src/letter"
+	"github.com/schollz/kiki/src/purpose"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestEvents(t *testing.T) {
+	alice, bob := newTestFeed(t, "alice"), newTestFeed(t, "bob")
+	_, live, cancel := bob.Subscribe(0)
+	defer cancel()
+	next := func() Event {
+		select {
+		case e := <-live:
+			return e
+		default:
+			t.Fatal("no event")
+		}
+		return Event{}
+	}
+
+	// letters are emitted when they are written
+	post, err := alice.ProcessLetter(letter.Letter{To: []string{"public"}, Purpose: purpose.ShareText, Content: "hello"})
+	assert.Nil(t, err)
+	_, err = alice.ProcessLetter(letter.Letter{To: []string{"public"}, Purpose: purpose.ShareText, Content: "hello again", FirstID: post.ID})
+	assert.Nil(t, err)
+	_, err = alice.ProcessLetter(letter.Letter{To: []string{"public"}, Purpose: purpose.ActionFollow, Content: bob.PersonalKey.Public})
+	assert.Nil(t, err)
+
+	// and when they are opened, once
+	syncFeeds(t, alice, bob)
+	bob.UnsealLetters()
+	types := map[string]int{}
+	for len(live) > 0 {
+		e := next()
+		types[e.Type]++
+		assert.Equal(t, alice.PersonalKey.Public, e.User)
+		switch e.Type {
+		case EventPost, EventEdit:
+			assert.Equal(t, post.ID, e.PostID)
+		case EventFollow:
+			assert.Equal(t, bob.PersonalKey.Public, e.Target)
+		}
+	}
+	// the follow of bob is also a notification for him
+	assert.Equal(t, map[string]int{EventPost: 1, EventEdit: 1, EventFollow: 1, EventNotification: 1}, types)
+
+	comment, err := bob.ProcessLetter(letter.Letter{To: []string{"public"}, Purpose: purpose.ShareText, Content: "hi alice", ReplyTo: post.ID})
+	assert.Nil(t, err)
+	e := next()
+	assert.Equal(t, EventComment, e.Type)
+	assert.Equal(t, comment.ID, e.PostID)
+	assert.Equal(t, post.ID, e.ReplyTo)
+	_, err = bob.ProcessLetter(letter.Letter{To: []string{"public"}, Purpose: purpose.ActionLike, Content: post.ID})
+	assert.Nil(t, err)
+	like := next()
+	assert.Equal(t, EventLike, like.Type)
+	assert.Equal(t, post.ID, like.PostID)
+	assert.True(t, like.ID > e.ID)
+	bob.UnsealLetters()
+	assert.Equal(t, 0, len(live))
+
+	// clients resume after the last event that they got
+	backlog, _, cancel2 := bob.Subscribe(e.ID)
+	cancel2()
+	assert.Equal(t, []Event{like}, backlog)
+
+	// clients that missed events are reset
+	backlog, _, cancel2 = bob.Subscribe(1)
+	cancel2()
+	assert.Equal(t, EventReset, backlog[0].Type)
+	// the welcome post of bob and the six events from above
+	assert.Equal(t, 8, len(backlog))
+	backlog, _, cancel2 = bob.Subscribe(backlog[0].ID)
+	cancel2()
+	assert.Equal(t, 7, len(backlog))
+
+	// subscribers that fall behind are dropped
+	for i := 0; i < eventBuffer+1; i++ {
+		bob.emit(Event{Type: EventSynced})
+	}
+	for range live {
+	}
+	_, ok := <-live
+	assert.False(t, ok)
+	cancel()
+}
@@ -18,19 +18,18 @@ import (
 	"time"
 
 	"github.com/blevesearch/bleve"
-	"github.com/microcosm-cc/bluemonday"
 
 	"github.com/pkg/errors"
 	cache "github.com/robfig/go-cache"
 	strip "github.com/schollz/html-strip-tags-go"
+	"github.com/schollz/kiki/src/blob"
 	"github.com/schollz/kiki/src/database"
 	"github.com/schollz/kiki/src/keypair"
 	"github.com/schollz/kiki/src/letter"
 	"github.com/schollz/kiki/src/logging"
 	"github.com/schollz/kiki/src/purpose"
+	"github.com/schollz/kiki/src/symmetric"
 	"github.com/schollz/kiki/src/utils"
-	"github.com/schollz/kiki/src/web"
-	blackfriday "gopkg.in/russross/blackfriday.v2"
 )
 
 func (f *Feed) Debug(b bool) {
@@ -43,8 +42,9 @@ func (f *Feed) Debug(b bool) {
 	database.Debug(b)
 }
 
-// New generates a new feed based on the location to find the identity file, the database, and the settings
-func New(alias, locationToSaveData, regionKeyPublic, regionKeyPrivate string, debug bool) (f *Feed, err error) {
+// New generates a new feed based on the location to find the identity file, the database, and the settings.
+// The envelopes are kept in a sqlite database in that location, unless a store is given.
+func New(alias, locationToSaveData, regionKeyPublic, regionKeyPrivate string, debug bool, store ...database.Store) (f *Feed, err error) {
 	locationToSaveData, err = filepath.Abs(locationToSaveData)
 	if err != nil {
 		return
@@ -59,18 +59,54 @@ func New(alias, locationToSaveData, regionKeyPublic, regionKeyPrivate string, de
 	f.locationToKikiDB = path.Join(locationToSaveData, "db", alias+".db")
 	f.locationToKikiSearch = path.Join(locationToSaveData, "search", alias)
 	f.locationToKikiSettings = path.Join(locationToSaveData, "keys", alias+".json")
+	f.locationToKikiBlobs = path.Join(locationToSaveData, "blobs", alias)
 	os.MkdirAll(path.Join(locationToSaveData, "db"), 0755)
 	os.MkdirAll(path.Join(locationToSaveData, "search", alias), 0755)
 	os.MkdirAll(path.Join(locationToSaveData, "keys"), 0755)
-	f.db = database.Setup(f.locationToKikiDB)
+
+	// the personal key is needed before the database is opened, since the
+	// database is encrypted with it
+	f.logger.Log.Infof("feed located at: '%s'", f.locationToKikiSettings)
+	bFeed, errLoad := ioutil.ReadFile(f.locationToKikiSettings)
+	if errLoad != nil {
+		f.PersonalKey = keypair.New()
+	} else {
+		err = json.Unmarshal(bFeed, &f)
+		if err != nil {
+			return
+		}
+	}
+
+	if len(store) > 0 {
+		f.db = store[0]
+	} else {
+		var key [32]byte
+		key, err = f.atRestKey()
+		if err != nil {
+			return
+		}
+		f.db, err = database.Setup(f.locationToKikiDB, key)
+		if err != nil {
+			err = errors.Wrap(err, "could not open database")
+			return
+		}
+	}
+	f.blobs, err = blob.New(f.locationToKikiBlobs)
+	if err != nil {
+		err = errors.Wrap(err, "could not open blob store")
+		return
+	}
+	err = f.moveImagesToBlobs()
+	if err != nil {
+		err = errors.Wrap(err, "could not move images to the blob store")
+		return
+	}
 	f.caching = cache.New(1*time.Minute, 5*time.Minute)
 	f.servers.Lock()
 	f.servers.connected = make(map[string]User)
 	f.servers.blockedUsers = make(map[string]struct{})
-	f.servers.syncingCount = 0
 	f.servers.Unlock()
-	f.logger.Log.Infof("feed located at: '%s'", f.locationToKikiSettings)
-	bFeed, errLoad := ioutil.ReadFile(f.locationToKikiSettings)
+	f.updates = make(chan struct{}, 1)
 	if errLoad != nil {
 		f.logger.Log.Info("generating new feed")
 
@@ -81,12 +117,8 @@ func New(alias, locationToSaveData, regionKeyPublic, regionKeyPrivate string, de
 			return
 		}
 
-		// generate a new personal key
-		var err2 error
-		f.PersonalKey = keypair.New()
-
 		// add the friends key
-		err2 = f.AddFriendsKey()
+		err2 := f.AddFriendsKey()
 		if err2 != nil {
 			err = errors.Wrap(err2, "add the friends key")
 			return
@@ -136,12 +168,6 @@ If you need any help, try using the help above.
 			err = errors.Wrap(err2, "setup")
 			return
 		}
-
-	} else {
-		err = json.Unmarshal(bFeed, &f)
-		if err != nil {
-			return
-		}
 	}
 
 	err = f.Save()
@@ -154,6 +180,19 @@ If you need any help, try using the help above.
 	return
 }
 
+// PassphraseEnv is the environment variable with the passphrase that opened letters
+// are encrypted with in the database. Without it, they are encrypted with a key that
+// is derived from the personal key.
+const PassphraseEnv = "KIKI_PASSPHRASE"
+
+// atRestKey is the key that opened letters are encrypted with in the database.
+func (f *Feed) atRestKey() (key [32]byte, err error) {
+	if passphrase := os.Getenv(PassphraseEnv); passphrase != "" {
+		return symmetric.KeyFromPassphrase(passphrase, []byte(f.PersonalKey.Public))
+	}
+	return f.PersonalKey.DeriveKey("at-rest")
+}
+
 func (f *Feed) SetRegionKey(public, private string) (err error) {
 	f.RegionKey, err = keypair.FromPair(public, private) // define region key
 	if err != nil {
@@ -181,6 +220,7 @@ func (f *Feed) Save() (err error) {
 
 func (f *Feed) Cleanup() {
 	f.logger.Log.Info("cleaning up...")
+	f.db.Close()
 }
 
 func (f *Feed) UpdateBlockedUsers() (err error) {
@@ -199,36 +239,33 @@ func (f *Feed) UpdateBlockedUsers() (err error) {
 	return
 }
 
+// SignalUpdate tells that envelopes were uploaded, so that everything is updated
+// once the uploads stop.
 func (f *Feed) SignalUpdate() {
 	f.logger.Log.Debug("signaling")
-	f.servers.Lock()
-	f.servers.syncingCount++
-	f.servers.Unlock()
+	select {
+	case f.updates <- struct{}{}:
+	default:
+		// an update is already signaled
+	}
 }
 
+// updateQuiet is how long there must be no uploads before everything is updated
+const updateQuiet = 3 * time.Second
+
+// UpdateOnUpload updates everything after uploads were signaled, once there have
+// been no more for a while, so that it does not update in the middle of a sync.
 func (f *Feed) UpdateOnUpload() {
-	for {
-		time.Sleep(1 * time.Second)
-		currentCount := 0
-		f.servers.RLock()
-		currentCount = f.servers.syncingCount
-		f.servers.RUnlock()
-		if currentCount > 0 {
-			f.logger.Log.Debug("going to try to sync!")
-			// wait three seconds and see if we have the same current count
-			time.Sleep(3 * time.Second)
-			f.servers.RLock()
-			currentCount2 := f.servers.syncingCount
-			f.servers.RUnlock()
-			if currentCount != currentCount2 {
-				continue
+	for range f.updates {
+		for quiet := false; !quiet; {
+			select {
+			case <-f.updates:
+			case <-time.After(updateQuiet):
+				quiet = true
 			}
-			// if the count is stabilized, then do syncing
-			f.UpdateEverything()
-			f.servers.Lock()
-			f.servers.syncingCount = 0
-			f.servers.Unlock()
 		}
+		f.logger.Log.Debug("updating after uploads")
+		f.UpdateEverything()
 	}
 }
 
@@ -251,8 +288,14 @@ func (f *Feed) UpdateEverythingAndSync() {
 
 func (f *Feed) UpdateEverything() {
 	f.logger.Log.Debug("updating everything")
+	// delete envelopes that have expired
+	err := f.db.DeleteExpired()
+	if err != nil {
+		f.logger.Log.Warn(err)
+	}
+
 	// unseal any new letters
-	err := f.UnsealLetters()
+	err = f.UnsealLetters()
 	if err != nil {
 		f.logger.Log.Warn(err)
 	}
@@ -290,6 +333,13 @@ func (f *Feed) UpdateEverything() {
 			f.logger.Log.Error(err)
 		}
 	}
+	f.resetStorageUsage()
+
+	// remove images that were only used by deleted envelopes
+	err = f.CollectBlobs()
+	if err != nil {
+		f.logger.Log.Error(err)
+	}
 
 	// determine the available hashtags
 	err = f.DetermineHashtags()
@@ -305,12 +355,12 @@ func (f *Feed) UpdateEverything() {
 
 }
 
+// hashtagPattern finds the hashtags in the content of a letter
+var hashtagPattern = regexp.MustCompile(`(\#[a-z-A-Z]+\b)`)
+
 // DetermineHashtags will go through and find all the hashtags
 func (f *Feed) DetermineHashtags() (err error) {
-	r, err := regexp.Compile(`(\#[a-z-A-Z]+\b)`)
-	if err != nil {
-		return
-	}
+	r := hashtagPattern
 	es, err := f.db.GetAllEnvelopes(true)
 	if err != nil {
 		return
@@ -388,6 +438,10 @@ func (f *Feed) ProcessLetter(l letter.Letter) (ue letter.Envelope, err error) {
 		err = errors.New("cannot post with region key")
 		return
 	}
+	if l.ExpiresIn < 0 {
+		err = errors.New("expiry can not be negative")
+		return
+	}
 	f.logger.Log.Debugf("%+v\n", l)
 	if l.FirstID != "" {
 		e, err2 := f.db.GetEnvelopeFromID(l.FirstID)
@@ -404,7 +458,7 @@ func (f *Feed) ProcessLetter(l letter.Letter) (ue letter.Envelope, err error) {
 		}
 	}
 
-	if strings.Contains(l.Purpose, "action-") {
+	if purpose.IsAction(l.Purpose) {
 		// actions are always public
 		l.To = []string{f.RegionKey.Public}
 		if l.Purpose == purpose.ActionBlock && l.Content == f.PersonalKey.Public {
@@ -426,20 +480,24 @@ func (f *Feed) ProcessLetter(l letter.Letter) (ue letter.Envelope, err error) {
 				// automatically done when adding any letter
 				// this just put here for pedantic reasons
 			case "friends":
-				friendsKeyPairs, err2 := f.db.GetKeysFromSender(f.PersonalKey.Public)
+				// only the latest friends key, since older ones may be known by
+				// people who are no longer friends
+				friendsKeyPair, err2 := f.db.GetLatestKeyForFriends(f.PersonalKey.Public)
 				if err2 != nil {
 					err = err2
 					return
 				}
-				alreadyAdded := make(map[string]struct{})
-				for _, friendsKeyPair := range friendsKeyPairs {
-					if _, ok := alreadyAdded[friendsKeyPair.Public]; ok {
-						continue
+				newTo = append(newTo, friendsKeyPair.Public)
+			default:
+				if strings.HasPrefix(to, groupPrefix) {
+					g, err2 := f.GetGroup(strings.TrimPrefix(to, groupPrefix))
+					if err2 != nil {
+						err = err2
+						return
 					}
-					newTo = append(newTo, friendsKeyPair.Public)
-					alreadyAdded[friendsKeyPair.Public] = struct{}{}
+					newTo = append(newTo, g.Key)
+					continue
 				}
-			default:
 				_, err2 := keypair.FromPublic(to)
 				if err2 != nil {
 					f.logger.Log.Debugf("Not a valid public key: '%s'", to)
@@ -453,133 +511,67 @@ func (f *Feed) ProcessLetter(l letter.Letter) (ue letter.Envelope, err error) {
 		l.To = newTo
 	}
 
-	// // determine if their are any images in envelope letter content that should be spliced out
+	// prepare the content the way the purpose wants it, which can split images out
+	// into letters of their own that are sent to the same people
 	l.Content = strings.Split(l.Content, `<div class="medium-insert-buttons"`)[0]
-	if l.Purpose == purpose.ShareText || l.Purpose == purpose.ActionProfile || l.Purpose == purpose.ActionImage {
-		originalContent := l.Content
-		l.Content = string(blackfriday.Run([]byte(l.Content)))
-		newHTML, images, err2 := web.CaptureBase64Images(l.Content)
-		if err2 != nil {
-			err = err2
+	l.Content, err = purpose.Transform(l.Purpose, l.Content, func(p string, data []byte) (id string, err error) {
+		e, err := letter.Letter{
+			To:        l.To,
+			Content:   base64.StdEncoding.EncodeToString(data),
+			Purpose:   p,
+			ExpiresIn: l.ExpiresIn,
+		}.Seal(f.PersonalKey, f.RegionKey)
+		if err != nil {
 			return
 		}
-		f.logger.Log.Debugf(l.Content)
-		f.logger.Log.Debugf("found %d images from html", len(images))
-		for name := range images {
-			p := purpose.SharePNG
-			if strings.Contains(name, ".jpg") {
-				p = purpose.ShareJPG
-			}
-			newLetter := letter.Letter{
-				To:      l.To,
-				Content: base64.StdEncoding.EncodeToString(images[name]),
-				Purpose: p,
-			}
-			f.logger.Log.Debug("sealing letter")
-			newEnvelope, err2 := newLetter.Seal(f.PersonalKey, f.RegionKey)
-			if err2 != nil {
-				err = err2
-				return
-			}
-			// seal and add envelope
-			f.logger.Log.Debug("adding letter")
-			err2 = f.db.AddEnvelope(newEnvelope)
-			if err2 != nil {
-				// should throw error if its already added, so don't worry about
-				f.logger.Log.Warn(err2)
-			}
-			if l.Purpose == purpose.ActionImage {
-				l.Content = newEnvelope.ID
-				break
-			}
-			f.logger.Log.Debugf("newHTML: %s", newHTML)
-			f.logger.Log.Debugf("name: %s", name)
-			f.logger.Log.Debugf("newEnvelope.ID: '%s'", newEnvelope.ID)
-			newHTML = strings.Replace(newHTML, name, newEnvelope.ID, 1)
-			l.Content = newHTML
-		}
-		newHTML, images, err2 = web.CaptureBase64ImagesFromMarkdown(l.Content)
-		if err2 != nil {
-			err = err2
+		err = e.Mint(f.Settings.StampDifficulty)
+		if err != nil {
 			return
 		}
-		f.logger.Log.Debugf("found %d images from markdown", len(images))
-		for name := range images {
-			p := purpose.SharePNG
-			if strings.Contains(name, ".jpg") {
-				p = purpose.ShareJPG
-			}
-			newLetter := letter.Letter{
-				To:      l.To,
-				Content: base64.StdEncoding.EncodeToString(images[name]),
-				Purpose: p,
-			}
-			f.logger.Log.Debug("sealing letter")
-			newEnvelope, err2 := newLetter.Seal(f.PersonalKey, f.RegionKey)
-			if err2 != nil {
-				err = err2
-				return
-			}
-			// seal and add envelope
-			f.logger.Log.Debug("adding letter")
-			err2 = f.db.AddEnvelope(newEnvelope)
-			if err2 != nil {
-				// should throw error if its already added, so don't worry about
-				f.logger.Log.Warn(err2)
-			}
-			if l.Purpose == purpose.ActionImage {
-				l.Content = newEnvelope.ID
-				break
-			}
-			f.logger.Log.Debugf("newHTML: %s", newHTML)
-			f.logger.Log.Debugf("name: %s", name)
-			f.logger.Log.Debugf("newEnvelope.ID: '%s'", newEnvelope.ID)
-			newHTML = strings.Replace(newHTML, name, newEnvelope.ID, 1)
-			l.Content = newHTML
-		}
-		if l.Purpose == purpose.ShareText || l.Purpose == purpose.ActionProfile {
-			// sanitize
-			f.logger.Log.Debugf("BEFORE SANITIZE: %s", l.Content)
-			p := bluemonday.UGCPolicy()
-			p.AllowRelativeURLs(true)
-			p.AddTargetBlankToFullyQualifiedLinks(true)
-			p.AllowElements("i")
-			p.AllowAttrs("class").OnElements("i")
-			p.AllowElements("img")
-			p.AllowAttrs("class").OnElements("img")
-			p.AllowElements("div")
-			p.AllowAttrs("class").OnElements("div")
-			l.Content = p.Sanitize(l.Content)
-			// replace hashtags with links to the hash tags
-			r, _ := regexp.Compile(`(\#[a-z-A-Z]+\b)`)
-			tags := r.FindAllString(l.Content, -1)
-			tagMap := make(map[string]struct{})
-			for _, tag := range tags {
-				tagMap[tag] = struct{}{}
-			}
-			for tag := range tagMap {
-				l.Content = strings.Replace(l.Content, tag, fmt.Sprintf(`<a href="/?hashtag=%s" class="hashtag">%s</a>`, tag[1:], tag), -1)
-			}
-		} else if l.Purpose == purpose.ActionImage && len(images) == 0 {
-			// if you get to here, revert to oroginal
-			l.Content = originalContent
+		err = f.db.AddEnvelope(e)
+		if err != nil {
+			// should throw error if its already added, so don't worry about
+			f.logger.Log.Warn(err)
 		}
+		return e.ID, nil
+	})
+	if err != nil {
+		return
 	}
 	l.Content = strings.TrimSpace(l.Content)
-
-	// remove tags from name change
-	if l.Purpose == purpose.ActionName {
-		l.Content = strip.StripTags(l.Content)
-	}
 	if strip.StripTags(l.Content) == "" && !strings.Contains(l.Content, "img") {
 		l.Content = ""
 	}
+	err = purpose.Validate(l.Purpose, l.Content)
+	if err != nil {
+		return
+	}
 
 	// seal the letter
 	e, err := l.Seal(f.PersonalKey, f.RegionKey)
 	if err != nil {
 		return
 	}
+	// an action that is sent again, like following after unfollowing, would have the
+	// same ID as before, so it continues from the letter that it repeats
+	for purpose.IsAction(l.Purpose) {
+		if _, errGet := f.db.GetEnvelopeFromID(e.ID); errGet != nil {
+			break
+		}
+		l.FirstID = e.ID
+		e, err = l.Seal(f.PersonalKey, f.RegionKey)
+		if err != nil {
+			return
+		}
+	}
+	difficulty := f.Settings.StampDifficulty
+	if purpose.IsAction(l.Purpose) {
+		difficulty = f.Settings.ActionStampDifficulty
+	}
+	err = e.Mint(difficulty)
+	if err != nil {
+		return
+	}
 	err = f.db.AddEnvelope(e)
 	if err != nil {
 		err = errors.Wrap(err, "processing letter")
@@ -588,17 +580,69 @@ func (f *Feed) ProcessLetter(l letter.Letter) (ue letter.Envelope, err error) {
 	ue, err = e.Unseal([]keypair.KeyPair{f.PersonalKey}, f.RegionKey)
 	if err != nil {
 		err = errors.Wrap(err, "processing envelope")
+		return
 	}
-
+	f.emitLetter(ue)
 	return
 }
 
+// InvalidEnvelopeError is returned for envelopes that have a bad stamp or signature,
+// which anyone could have made up.
+type InvalidEnvelopeError struct {
+	err error
+}
+
+func (e InvalidEnvelopeError) Error() string {
+	return e.err.Error()
+}
+
 // ProcessEnvelope will determine whether the incoming letter is valid and can be submitted to the database.
-func (f *Feed) ProcessEnvelope(e letter.Envelope) (err error) {
+// The peer is the address of the carrier that the envelope came from. The checks are
+// only done once the envelope is validated, so that they can trust its sender.
+func (f *Feed) ProcessEnvelope(e letter.Envelope, peer string, checks ...func(letter.Envelope) error) (err error) {
+	// check the proof-of-work stamp before anything is looked up or stored
+	err = f.checkStamp(e)
+	if err != nil {
+		return errors.Wrap(InvalidEnvelopeError{err}, "ProcessEnvelope")
+	}
+
+	// check if envelope was already put in quarantine
+	if f.isQuarantined(e.ID) {
+		return errors.New("envelope is quarantined")
+	}
+
 	// check if envelope has a valid signature
 	err = e.Validate(f.RegionKey)
 	if err != nil {
-		return errors.Wrap(err, "ProcessEnvelope, not validated")
+		return errors.Wrap(InvalidEnvelopeError{err}, "ProcessEnvelope, not validated")
+	}
+	for _, check := range checks {
+		err = check(e)
+		if err != nil {
+			return
+		}
+	}
+
+	// check if envelope has expired, which is only trusted once it is validated
+	if e.Expired() {
+		return errors.New("envelope has expired")
+	}
+
+	// check that the envelope was made at a plausible time, otherwise keep it out
+	err = f.checkTimestamp(e, time.Now())
+	if err != nil {
+		f.recordOrigin(e.ID, peer)
+		errQuarantine := f.quarantine(e, err.Error())
+		if errQuarantine != nil {
+			f.logger.Log.Warn(errQuarantine)
+		}
+		return errors.Wrap(err, "ProcessEnvelope, implausible timestamp")
+	}
+
+	// check that the envelope is signed by the key we know for the sender
+	err = f.checkSigningKey(e)
+	if err != nil {
+		return errors.Wrap(err, "ProcessEnvelope, bad signing key")
 	}
 
 	// check if envelope comes from blocked user
@@ -609,10 +653,6 @@ func (f *Feed) ProcessEnvelope(e letter.Envelope) (err error) {
 	}
 	f.servers.RUnlock()
 
-	// check if the storage limits are exceeded for this envelope
-	// and then only accept if it is a newer envelope
-	// TODO
-
 	// check if envelope already exists
 	_, errGet := f.GetEnvelope(e.ID)
 	if errGet == nil {
@@ -621,14 +661,94 @@ func (f *Feed) ProcessEnvelope(e letter.Envelope) (err error) {
 		return nil
 	}
 
+	// check that the envelope fits in the storage of the sender, which can make
+	// room for it by purging older envelopes of the sender
+	err = f.reserveStorage(e)
+	if err != nil {
+		return errors.Wrap(err, "ProcessEnvelope")
+	}
+
 	err = f.db.AddEnvelope(e)
 	if err != nil {
+		f.releaseStorage(e)
 		return
 	}
 
+	err = f.recordOrigin(e.ID, peer)
 	return
 }
 
+// signingKeyPin is the signing key first seen for a sender, and the time and
+// version of the envelope it was first seen on. Pins from before VersionXEdDSA do
+// not have a version.
+type signingKeyPin struct {
+	SigningKey string    `json:"signing_key"`
+	Since      time.Time `json:"since"`
+	Version    int       `json:"version,omitempty"`
+}
+
+// checkSigningKey pins the signing key of a sender the first time it is seen on a
+// VersionXEdDSA envelope, which only the sender can sign, along with the earliest
+// time of their signed envelopes. Once the sender is pinned, unsigned envelopes are
+// only taken if they are from before that time, so that the posts that they made
+// before they upgraded keep working in whatever order they arrive. The timestamp of
+// unsigned envelopes is not signed, so someone with the private region key can
+// still backdate one to before the pin, but not pass it off as a new post. Once the
+// sender uses VersionXEdDSA, VersionSigned envelopes are refused as well, since
+// anyone with the private region key can sign them for the sender.
+func (f *Feed) checkSigningKey(e letter.Envelope) (err error) {
+	var pin signingKeyPin
+	errGet := f.db.Get("signing-keys", e.Sender.Public, &pin)
+	if errGet != nil {
+		if e.Version == letter.VersionXEdDSA {
+			f.logger.Log.Debugf("pinning signing key for %s", e.Sender.Public)
+			return f.pinSigningKey(e, e.Timestamp)
+		}
+		return nil
+	}
+	switch {
+	case e.Version < letter.VersionSigned:
+		if !e.Timestamp.Before(pin.Since) {
+			return errors.New("unsigned envelope from a sender that signs envelopes")
+		}
+		return nil
+	case e.Version == letter.VersionXEdDSA:
+		if pin.Version != letter.VersionXEdDSA {
+			f.logger.Log.Debugf("pinning XEdDSA signing key for %s", e.Sender.Public)
+			return f.pinSigningKey(e, earliest(pin.Since, e.Timestamp))
+		}
+	case pin.Version == letter.VersionXEdDSA:
+		return errors.New("envelope not signed with XEdDSA from a sender that uses it")
+	}
+	if pin.SigningKey != e.SigningKey {
+		return errors.New("signing key does not match the one known for the sender")
+	}
+	// signed envelopes that arrive out of order move the pin back to the earliest
+	if e.Timestamp.Before(pin.Since) {
+		pin.Since = e.Timestamp
+		return f.db.Set("signing-keys", e.Sender.Public, pin)
+	}
+	return
+}
+
+// pinSigningKey keeps the signing key of the envelope for its sender, from the time
+// since.
+func (f *Feed) pinSigningKey(e letter.Envelope, since time.Time) error {
+	return f.db.Set("signing-keys", e.Sender.Public, signingKeyPin{
+		SigningKey: e.SigningKey,
+		Since:      since,
+		Version:    e.Version,
+	})
+}
+
+// earliest returns the earlier of the times.
+func earliest(a, b time.Time) time.Time {
+	if b.Before(a) {
+		return b
+	}
+	return a
+}
+
 // UnsealLetters will go through unopened envelopes and open them and then add them to the f.db. Also go through and purge bad letters (invalidated letters)
 func (f *Feed) UnsealLetters() (err error) {
 	lettersToPurge := []string{}
@@ -648,21 +768,51 @@ func (f *Feed) UnsealLetters() (err error) {
 	keysToTry = append([]keypair.KeyPair{f.RegionKey}, keysToTry...)
 	// add personal key last
 	keysToTry = append(keysToTry, f.PersonalKey)
+	opened := []letter.Envelope{}
 	for _, envelope := range envelopes {
 		if err := envelope.Validate(f.RegionKey); err != nil {
-			// add to purge
+			// add to purge, without opening it
 			lettersToPurge = append(lettersToPurge, envelope.ID)
+			continue
 		}
 		ue, err := envelope.Unseal(keysToTry, f.RegionKey)
 		if err != nil {
 			// this user is not a recipient, just continue
 			continue
 		}
+		// the ID must be the hash of the letter inside, and the content must be
+		// what the purpose says
+		err = ue.VerifyID()
+		if err == nil {
+			err = purpose.Validate(ue.Letter.Purpose, ue.Letter.Content)
+		}
+		if err != nil {
+			err = f.quarantine(envelope, err.Error())
+			if err != nil {
+				f.logger.Log.Warn(err)
+			}
+			continue
+		}
+		ue, err = f.storeImage(ue)
+		if err != nil {
+			f.logger.Log.Warn(err)
+			continue
+		}
 		err = f.db.UpdateEnvelope(ue)
 		if err != nil {
 			continue
 		}
+		if ue.Letter.Purpose == purpose.ShareKey {
+			f.forgetKeys()
+		}
+		f.emitLetter(ue)
+		opened = append(opened, ue)
+		err = f.addGroupKey(ue)
+		if err != nil {
+			f.logger.Log.Warn(err)
+		}
 	}
+	f.notify(opened)
 
 	// purge invalid letters
 	if len(lettersToPurge) > 0 {
@@ -700,7 +850,7 @@ func (f *Feed) GetUser(public ...string) (u User) {
 		Name:           strip.StripTags(name),
 		PublicKey:      publicKey,
 		PublicHash:     utils.StringToReadableHash(publicKey),
-		Profile:        template.HTML(profile),
+		Profile:        purpose.Render(purpose.ActionProfile, profile),
 		ProfileContent: template.HTMLAttr(fmt.Sprintf(`data-content="%s"`, strings.Replace(profile, `"`, `'`, -1))),
 		Image:          image,
 		Followers:      followers,
@@ -731,8 +881,33 @@ func (f *Feed) GetUserFriends() (u UserFriends) {
 	return
 }
 
-// UpdateFriends will post keys to friends
+// UpdateFriends will post keys to friends. When someone is no longer a friend, a new
+// friends key is made first, so that they can not open the letters for friends that follow.
 func (f *Feed) UpdateFriends() (err error) {
+	friends, err := f.currentFriends()
+	if err != nil {
+		return
+	}
+	var lastFriends []string
+	if f.db.Get("globals", "friends", &lastFriends) == nil {
+		isFriend := make(map[string]struct{})
+		for _, friend := range friends {
+			isFriend[friend] = struct{}{}
+		}
+		for _, friend := range lastFriends {
+			if _, ok := isFriend[friend]; ok {
+				continue
+			}
+			f.logger.Log.Infof("%s is no longer a friend, making a new friends key", friend)
+			err = f.AddFriendsKey()
+			if err != nil {
+				err = errors.Wrap(err, "can't rotate friends key")
+				return
+			}
+			break
+		}
+	}
+
 	friendsKey, err := f.db.GetLatestKeyForFriends(f.PersonalKey.Public)
 	if err != nil {
 		err = errors.Wrap(err, "can't get latest key")
@@ -743,7 +918,6 @@ func (f *Feed) UpdateFriends() (err error) {
 		err = errors.Wrap(err, "can't marshal")
 		return
 	}
-	_, _, friends := f.db.Friends(f.PersonalKey.Public)
 	for _, friend := range friends {
 		l := letter.Letter{
 			To:      []string{friend},
@@ -753,15 +927,37 @@ func (f *Feed) UpdateFriends() (err error) {
 		f.logger.Log.Debugf("Adding letter for friend %s", friend)
 		f.ProcessLetter(l)
 	}
+	return f.db.Set("globals", "friends", friends)
+}
+
+// currentFriends returns the friends that are not blocked.
+func (f *Feed) currentFriends() (friends []string, err error) {
+	blockedUsers, err := f.db.ListBlockedUsers(f.PersonalKey.Public)
+	if err != nil {
+		return
+	}
+	blocked := make(map[string]struct{})
+	for _, blockedUser := range blockedUsers {
+		blocked[blockedUser] = struct{}{}
+	}
+	_, _, allFriends := f.db.Friends(f.PersonalKey.Public)
+	friends = []string{}
+	for _, friend := range allFriends {
+		if _, ok := blocked[friend]; !ok {
+			friends = append(friends, friend)
+		}
+	}
 	return
 }
 
 type ShowFeedParameters struct {
-	ID      string // view a single post
-	Hashtag string // filter by channel
-	User    string // filter by user
-	Search  string // filter by search term
-	Latest  bool   // get the latest
+	ID           string // view a single post
+	Hashtag      string // filter by channel
+	User         string // filter by user
+	Search       string // filter by search term
+	Group        string // view the conversation of a group
+	Conversation string // view a conversation of private posts
+	Latest       bool   // get the latest
 }
 
 func (f *Feed) ShowFeed(p ShowFeedParameters) (posts []Post, err error) {
@@ -780,6 +976,10 @@ func (f *Feed) ShowFeed(p ShowFeedParameters) (posts []Post, err error) {
 	} else if p.User != "" {
 		f.logger.Log.Debugf("gettting posts for '%s'", p.User)
 		envelopes, err = f.db.GetBasicPostsForUser(p.User)
+	} else if p.Group != "" {
+		envelopes, err = f.groupConversation(p.Group)
+	} else if p.Conversation != "" {
+		envelopes, err = f.conversationPosts(p.Conversation)
 	} else if p.Search != "" {
 		posts, err = f.SearchIndexedPosts(p.Search)
 		if err != nil {
@@ -845,7 +1045,7 @@ func (f *Feed) MakePostWithComments(e letter.Envelope) (post Post) {
 }
 
 // This is needed for http rest api
-func (self Feed) GetDatabase() database.DatabaseAPI {
+func (self *Feed) GetDatabase() database.Store {
 	return self.db
 }
 
@@ -870,6 +1070,11 @@ func (self Feed) GetDatabase() database.DatabaseAPI {
 // 	return user, err
 // }
 
+// IsPublic tells whether the letter was sent to the public.
+func (f *Feed) IsPublic(e letter.Envelope) bool {
+	return containsString(e.Letter.To, f.RegionKey.Public)
+}
+
 func (f *Feed) MakePost(e letter.Envelope) (post BasicPost) {
 	recipients := []string{}
 	for _, to := range e.Letter.To {
@@ -877,6 +1082,10 @@ func (f *Feed) MakePost(e letter.Envelope) (post BasicPost) {
 			recipients = []string{"Public"}
 			break
 		}
+		if g, ok := f.groupOfKey(to); ok {
+			recipients = []string{strip.StripTags(g.Name)}
+			break
+		}
 		friendsName := strip.StripTags(f.db.GetFriendsName(to))
 		if friendsName != "" {
 			recipients = []string{friendsName}
@@ -898,7 +1107,7 @@ func (f *Feed) MakePost(e letter.Envelope) (post BasicPost) {
 	post = BasicPost{
 		ID:         e.ID,
 		Recipients: strings.Join(recipients, ", "),
-		Content:    template.HTML(e.Letter.Content),
+		Content:    purpose.Render(e.Letter.Purpose, e.Letter.Content),
 		Date:       convertedTime,
 		TimeAgo:    utils.TimeAgo(convertedTime),
 		FirstID:    e.Letter.FirstID,
@@ -937,8 +1146,9 @@ func (f *Feed) AddFriendsKey() (err error) {
 	}
 	myfriendsByte, err := json.Marshal(myfriends)
 
-	// share the friends key with yourself
-	_, err = f.ProcessLetter(letter.Letter{
+	// share the friends key with yourself, and open it right away so that it is
+	// used for the letters that follow
+	ue, err := f.ProcessLetter(letter.Letter{
 		To:      []string{"self"},
 		Purpose: purpose.ShareKey,
 		Content: string(myfriendsByte),
@@ -947,6 +1157,12 @@ func (f *Feed) AddFriendsKey() (err error) {
 		err = errors.Wrap(err, "AddFriendsKey, processing letter")
 		return
 	}
+	err = f.db.UpdateEnvelope(ue)
+	if err != nil {
+		err = errors.Wrap(err, "AddFriendsKey, opening letter")
+		return
+	}
+	f.forgetKeys()
 
 	if err != nil {
 		err = errors.Wrap(err, "AddFriendsKey, processing public letter")
@@ -956,30 +1172,31 @@ func (f *Feed) AddFriendsKey() (err error) {
 	return
 }
 
-// GetEnvelope will return an envelope with the given ID
+// GetEnvelope will return an envelope with the given ID, unless it has expired
 func (f *Feed) GetEnvelope(id string) (e letter.Envelope, err error) {
-	return f.db.GetEnvelopeFromID(id)
+	e, err = f.db.GetEnvelopeFromID(id)
+	if err == nil && e.Expired() {
+		err = errors.New("envelope has expired")
+	}
+	return
 }
 
-// GetIDs will return an envelope with the given ID
-func (f *Feed) GetIDs(pubkey, signature string) (ids []string, err error) {
-	requester, err := keypair.FromPublic(pubkey)
-	if err != nil {
-		return
+// GetIDs will return the IDs of all the envelopes, or only the IDs of envelopes received after
+// the since cursor. The cursor of the latest envelope is returned to be used in the next request.
+// The requester must already be authenticated.
+func (f *Feed) GetIDs(requester string, since ...int64) (ids []string, cursor int64, err error) {
+	if len(since) > 0 {
+		return f.db.GetIDsSince(since[0])
 	}
-	// validate the person against the current region key
-	err = f.RegionKey.Validate(signature, requester)
+	ids, cursor, err = f.db.GetIDsSince(0)
 	if err != nil {
 		return
 	}
-	idMap, err := f.db.GetIDs()
-	ids = make([]string, len(idMap))
-	i := 0
-	for id := range idMap {
-		ids[i] = id
-		i++
+	idMap := make(map[string]struct{})
+	for _, id := range ids {
+		idMap[id] = struct{}{}
 	}
-	err = f.db.Set("GetIDs", requester.Public, idMap)
+	err = f.db.Set("GetIDs", requester, idMap)
 	return
 }
 
@@ -995,7 +1212,16 @@ func (f *Feed) GetConnected() (us []User) {
 	return
 }
 
-// Sync will try to sync with the respective address
+// syncCursor records how far a sync with a peer has gotten. Theirs is the cursor of
+// the peer for their envelopes, Mine is the cursor of my envelopes that they were sent.
+type syncCursor struct {
+	Theirs int64 `json:"theirs"`
+	Mine   int64 `json:"mine"`
+}
+
+// Sync will try to sync with the respective address. If the address has been synced before
+// only the envelopes that are new since then are exchanged, otherwise all the IDs are
+// compared.
 func (f *Feed) Sync(address string) (err error) {
 	f.logger.Log.Infof("syncing with %s", address)
 
@@ -1006,17 +1232,23 @@ func (f *Feed) Sync(address string) (err error) {
 	}
 
 	// get the list
+	var cursor syncCursor
+	incremental := f.db.Get("sync-cursors", address, &cursor) == nil
 	var target Response
-	signature, err := f.PersonalKey.Signature(f.RegionKey)
-	if err != nil {
-		return
+	query := fmt.Sprintf("%s/list", address)
+	if incremental {
+		query += fmt.Sprintf("?since=%d", cursor.Theirs)
 	}
-	query := fmt.Sprintf("%s/list?user_pub=%s&signature=%s", address, f.PersonalKey.Public, signature)
 	f.logger.Log.Debug(query)
 	req, err := http.NewRequest("GET", query, nil)
 	if err != nil {
 		return
 	}
+	err = f.authorize(address, req)
+	if err != nil {
+		return errors.Wrap(err, "could not authorize")
+	}
+	sent := time.Now()
 	resp, err := http.DefaultClient.Do(req)
 	if err != nil {
 		return
@@ -1029,6 +1261,7 @@ func (f *Feed) Sync(address string) (err error) {
 	if "ok" != target.Status {
 		return errors.New(target.Error)
 	}
+	f.clockOffset(address, target.ServerTime, sent, time.Now())
 	// check that they have a valid identity
 	targetKey, err := keypair.FromPublic(target.PersonalPublicKey)
 	if err != nil {
@@ -1039,36 +1272,73 @@ func (f *Feed) Sync(address string) (err error) {
 		return errors.Wrap(err, "invalid isgnature")
 	}
 
-	f.logger.Log.Debugf("got %d IDs from %s", len(target.IDs), address)
+	f.logger.Log.Debugf("got %d IDs from %s (incremental: %v)", len(target.IDs), address, target.Incremental)
 	targetIDs := make(map[string]struct{})
 	for _, id := range target.IDs {
 		targetIDs[id] = struct{}{}
 	}
+
+	// determine which of my envelopes they might need. Peers that do not
+	// know about cursors always send their whole list.
+	myNewIDs, myCursor, err := f.db.GetIDsSince(0)
+	if err != nil {
+		return
+	}
+	if target.Incremental {
+		myNewIDs, myCursor, err = f.db.GetIDsSince(cursor.Mine)
+		if err != nil {
+			return
+		}
+	}
+
 	// check whether I need any of their envelopes
+	var theirNewIDs []string
 	for theirID := range targetIDs {
 		if _, ok := myIDs[theirID]; ok {
 			continue
 		}
 		f.logger.Log.Debugf("%s has new envelope: %s", address, theirID)
-		err = f.DownloadEnvelope(address, theirID)
-		if err != nil {
-			return
-		}
+		theirNewIDs = append(theirNewIDs, theirID)
+	}
+	err = f.DownloadAll(address, theirNewIDs)
+	if err != nil {
+		return
 	}
 
 	// check whether they need any of my envelopes
-	for myID := range myIDs {
+	var idsToUpload []string
+	for _, myID := range myNewIDs {
 		if _, ok := targetIDs[myID]; ok {
 			continue
 		}
+		if target.Incremental {
+			// don't send back what came from them
+			var origin string
+			if f.db.Get("origins", myID, &origin) == nil && origin == address {
+				continue
+			}
+		}
 		f.logger.Log.Debugf("my envelope %s is new to %s", myID, address)
-		err = f.UploadEnvelope(address, myID)
+		idsToUpload = append(idsToUpload, myID)
+	}
+	err = f.UploadAll(address, idsToUpload)
+	if err != nil {
+		return
+	}
+
+	// remember where to start next time, if the peer supports it
+	if target.Cursor > 0 {
+		err = f.db.Set("sync-cursors", address, syncCursor{Theirs: target.Cursor, Mine: myCursor})
 		if err != nil {
 			return
 		}
 	}
 
 	err = f.AddAddressToServers(address, target)
+	if err != nil {
+		return
+	}
+	f.emit(Event{Type: EventSynced, Peer: address, Downloaded: len(theirNewIDs), Uploaded: len(idsToUpload)})
 	return
 }
 
@@ -1106,6 +1376,11 @@ func (f *Feed) UploadEnvelope(address, id string) (err error) {
 	if err != nil {
 		return
 	}
+	if target.Status == "rejected" {
+		// the peer has no room for it, which does not stop the rest from being uploaded
+		f.logger.Log.Debugf("%s rejected %s: %s", address, id, target.Error)
+		return
+	}
 	if "ok" != target.Status {
 		return errors.New(target.Error)
 	}
@@ -1140,7 +1415,7 @@ func (f *Feed) DownloadEnvelope(address, id string) (err error) {
 
 	f.logger.Log.Debugf("downloaded %s from %s", target.Envelope.ID, address)
 
-	err = f.ProcessEnvelope(target.Envelope)
+	err = f.ProcessEnvelope(target.Envelope, address)
 	if err != nil {
 		f.logger.Log.Error(errors.Wrap(err, "processing envlope"))
 		err = nil
@@ -1159,7 +1434,10 @@ func (f *Feed) AddAddressToServers(address string, r Response) (err error) {
 	}
 	f.servers.Lock()
 	defer f.servers.Unlock()
-	f.logger.Log.Debugf("connected to new server %s: %+v", address, u)
+	if _, ok := f.servers.connected[address]; !ok {
+		f.logger.Log.Debugf("connected to new server %s: %+v", address, u)
+		f.emit(Event{Type: EventPeer, Peer: address, User: u.PublicKey})
+	}
 	f.servers.connected[address] = u
 	alreadyRecorded := false
 	for _, currentAddress := range f.Settings.AvailableServers {
@@ -1181,11 +1459,6 @@ func (f *Feed) PurgeOverflowingStorage() (err error) {
 	if err != nil {
 		return
 	}
-	_, _, friendsList := f.db.Friends(f.PersonalKey.Public)
-	friendsMap := make(map[string]struct{})
-	for _, friend := range friendsList {
-		friendsMap[friend] = struct{}{}
-	}
 
 	for _, user := range users {
 		// skip personal user
@@ -1193,69 +1466,23 @@ func (f *Feed) PurgeOverflowingStorage() (err error) {
 			continue
 		}
 
-		// determine limit
-		limit := f.Settings.StoragePerPublicPerson
-		if _, ok := friendsMap[user]; ok {
-			limit = f.Settings.StoragePerFriend
+		// the storage is locked so that envelopes of the user are not counted while purging
+		f.usage.Lock()
+		currentSpace, err2 := f.usageOf(user)
+		if err2 == nil && currentSpace >= f.Settings.StoragePerPublicPerson {
+			limit := f.storageLimit(user)
+			if currentSpace >= limit {
+				currentSpace, err2 = f.purgeUser(user, limit)
+				f.usage.bytes[user] = currentSpace
+			}
 		}
-
-		currentSpace, err2 := f.db.DiskSpaceForUser(user)
 		if err2 != nil {
-			return err2
-		}
-		f.logger.Log.Debugf("user: %s: space: %d / %d", user, currentSpace, limit)
-
-		// don't proceed if the current space does not exceed
-		if currentSpace < limit {
-			continue
+			delete(f.usage.bytes, user)
 		}
-
-		// first purge repeated actions (changing names multiple times)
-		err2 = f.db.DeleteOldActions(user)
+		f.usage.Unlock()
 		if err2 != nil {
 			return err2
 		}
-
-		// then purge edits
-		if currentSpace > limit {
-			err2 = f.db.DeleteUsersEdits(user)
-			if err2 != nil {
-				return err2
-			}
-		}
-		for {
-			f.logger.Log.Debugf("user: %s: space: %d / %d", user, currentSpace, limit)
-			if currentSpace < limit {
-				break
-			}
-			err = f.db.DeleteUsersOldestLargestPost(user)
-			if err != nil {
-				f.logger.Log.Debug(err)
-				break
-			}
-			currentSpace, err2 = f.db.DiskSpaceForUser(user)
-			if err2 != nil {
-				f.logger.Log.Debug(err2)
-				break
-			}
-		}
-		for {
-			f.logger.Log.Debugf("user: %s: space: %d / %d", user, currentSpace, limit)
-			if currentSpace < limit {
-				break
-			}
-			err = f.db.DeleteUsersOldestPost(user)
-			if err != nil {
-				f.logger.Log.Debug(err)
-				break
-			}
-			currentSpace, err2 = f.db.DiskSpaceForUser(user)
-			if err2 != nil {
-				f.logger.Log.Debug(err2)
-				break
-			}
-		}
-
 	}
 	return
 }
@@ -1,25 +1,53 @@
 package feed
 
 import (
+	"fmt"
+	"io/ioutil"
+	"os"
 	"testing"
+	"time"
 
 	// "github.com/schollz/kiki/src/logging"
 
+	"github.com/schollz/kiki/src/database"
+	"github.com/schollz/kiki/src/letter"
+	"github.com/schollz/kiki/src/purpose"
 	"github.com/stretchr/testify/assert"
 )
 
 var f *Feed
 
-// BenchmarkGetUser-4         	     100	  17057282 ns/op
-// BenchmarkShowFeed-4        	      20	  91682205 ns/op
-// BenchmarkGetBasicPosts-4   	    2000	    666736 ns/op
+// BenchmarkGetUser reads from sqlite, the baseline is the same benchmark on the
+// database that was opened for every query, both from the same run:
+//
+// BenchmarkGetUser (baseline)	    2601	   1152965 ns/op
+// BenchmarkGetUser           	    4989	    485130 ns/op
+// BenchmarkShowFeed          	   15265	    155136 ns/op
+// BenchmarkGetBasicPosts     	   96973	     29021 ns/op
 
-func init() {
-	var err error
-	f, err = New("testdb", "4NfD9kWESGycUdbhbrFygNDjFun6NPk6utpkviyE1Ai6", "btbsjnjTtgi3aL9z2X8bqb1URVnCo3zqg4fC4co2JEu", false)
+func TestMain(m *testing.M) {
+	dir, err := ioutil.TempDir("", "kiki-feed")
 	if err != nil {
 		panic(err)
 	}
+	f, err = New("test", dir, "4NfD9kWESGycUdbhbrFygNDjFun6NPk6utpkviyE1Ai6", "btbsjnjTtgi3aL9z2X8bqb1URVnCo3zqg4fC4co2JEu", false, database.NewMemory())
+	if err != nil {
+		panic(err)
+	}
+	for i := 0; i < 20; i++ {
+		_, err = f.ProcessLetter(letter.Letter{
+			To:      []string{"public"},
+			Purpose: purpose.ShareText,
+			Content: fmt.Sprintf("hello #kiki %d", i),
+		})
+		if err != nil {
+			panic(err)
+		}
+	}
+	code := m.Run()
+	f.Cleanup()
+	os.RemoveAll(dir)
+	os.Exit(code)
 }
 
 func TestShowFeed(t *testing.T) {
@@ -31,14 +59,38 @@ func TestShowFeed(t *testing.T) {
 
 func TestGetUser(t *testing.T) {
 	u := f.GetUser()
-	assert.Equal(t, "8cjeQPadXXCTGe9WbqER44CqduSHpqepX4tgAoEEFH4w", u.PublicKey)
+	assert.Equal(t, f.PersonalKey.Public, u.PublicKey)
 	u = f.GetUser()
-	assert.Equal(t, "8cjeQPadXXCTGe9WbqER44CqduSHpqepX4tgAoEEFH4w", u.PublicKey)
+	assert.Equal(t, f.PersonalKey.Public, u.PublicKey)
 }
 
+// BenchmarkGetUser reads the user from sqlite every time, since GetUser caches it
+// for a few seconds.
 func BenchmarkGetUser(b *testing.B) {
+	dir, err := ioutil.TempDir("", "kiki-feed")
+	if err != nil {
+		b.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	g, err := New("benchmark", dir, f.RegionKey.Public, f.RegionKey.Private, false)
+	if err != nil {
+		b.Fatal(err)
+	}
+	defer g.Cleanup()
+	for i := 0; i < 20; i++ {
+		_, err = g.ProcessLetter(letter.Letter{
+			To:      []string{"public"},
+			Purpose: purpose.ShareText,
+			Content: fmt.Sprintf("hello #kiki %d", i),
+		})
+		if err != nil {
+			b.Fatal(err)
+		}
+	}
+	b.ResetTimer()
 	for i := 0; i < b.N; i++ {
-		f.GetUser()
+		g.caching.Delete("user-" + g.PersonalKey.Public)
+		g.GetUser()
 	}
 }
 
@@ -53,3 +105,328 @@ func BenchmarkGetBasicPosts(b *testing.B) {
 		f.db.GetBasicPosts()
 	}
 }
+
+func TestProcessLetterPurpose(t *testing.T) {
+	_, err := f.ProcessLetter(letter.Letter{Purpose: "share-nothing"})
+	assert.NotNil(t, err)
+	_, err = f.ProcessLetter(letter.Letter{Purpose: purpose.ActionFollow, Content: "not a key"})
+	assert.NotNil(t, err)
+
+	// purposes that are registered by an app can be sent
+	assert.Nil(t, purpose.Register(purpose.Purpose{Name: "share-test", Storage: purpose.StoragePost}))
+	e, err := f.ProcessLetter(letter.Letter{To: []string{"public"}, Purpose: "share-test", Content: "<b>bold</b>"})
+	assert.Nil(t, err)
+	assert.Equal(t, "<b>bold</b>", e.Letter.Content)
+	assert.Contains(t, e.Letter.To, f.RegionKey.Public)
+	assert.Nil(t, f.db.RemoveLetters([]string{e.ID}))
+}
+
+func TestUnfollow(t *testing.T) {
+	other := "btbsjnjTtgi3aL9z2X8bqb1URVnCo3zqg4fC4co2JEu"
+	_, err := f.ProcessLetter(letter.Letter{To: []string{"public"}, Purpose: purpose.ActionFollow, Content: other})
+	assert.Nil(t, err)
+	assert.Nil(t, f.UnsealLetters())
+	_, following, _ := f.db.Friends(f.PersonalKey.Public)
+	assert.Contains(t, following, other)
+
+	_, err = f.ProcessLetter(letter.Letter{To: []string{"public"}, Purpose: purpose.ActionUnfollow, Content: other})
+	assert.Nil(t, err)
+	assert.Nil(t, f.UnsealLetters())
+	_, following, _ = f.db.Friends(f.PersonalKey.Public)
+	assert.NotContains(t, following, other)
+
+	// following again after unfollowing
+	_, err = f.ProcessLetter(letter.Letter{To: []string{"public"}, Purpose: purpose.ActionFollow, Content: other})
+	assert.Nil(t, err)
+	assert.Nil(t, f.UnsealLetters())
+	_, following, _ = f.db.Friends(f.PersonalKey.Public)
+	assert.Contains(t, following, other)
+
+	_, err = f.ProcessLetter(letter.Letter{To: []string{"public"}, Purpose: purpose.ActionUnfollow, Content: other})
+	assert.Nil(t, err)
+	assert.Nil(t, f.UnsealLetters())
+	_, following, _ = f.db.Friends(f.PersonalKey.Public)
+	assert.NotContains(t, following, other)
+
+	_, err = f.ProcessLetter(letter.Letter{To: []string{"public"}, Purpose: purpose.ActionUnfollow, Content: "not a key"})
+	assert.NotNil(t, err)
+}
+
+// newTestFeed makes a feed in the same region as f, which is removed when the test ends.
+func newTestFeed(t *testing.T, alias string) *Feed {
+	dir, err := ioutil.TempDir("", "kiki-feed")
+	if err != nil {
+		t.Fatal(err)
+	}
+	g, err := New(alias, dir, f.RegionKey.Public, f.RegionKey.Private, false, database.NewMemory())
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() {
+		g.Cleanup()
+		os.RemoveAll(dir)
+	})
+	return g
+}
+
+// syncFeeds gives every envelope that one feed has to another feed, and opens them.
+func syncFeeds(t *testing.T, from, to *Feed) {
+	es, err := from.db.GetAllEnvelopes()
+	assert.Nil(t, err)
+	for _, e := range es {
+		e.Close()
+		to.ProcessEnvelope(e, "test")
+	}
+	assert.Nil(t, to.UnsealLetters())
+}
+
+// shareAll syncs every pair of the feeds, so each one has all of the envelopes.
+func shareAll(t *testing.T, feeds ...*Feed) {
+	for _, from := range feeds {
+		for _, to := range feeds {
+			if from != to {
+				syncFeeds(t, from, to)
+			}
+		}
+	}
+}
+
+// canOpen tells whether a feed has the envelope and could open it.
+func canOpen(g *Feed, id string) bool {
+	e, err := g.GetEnvelope(id)
+	return err == nil && e.Opened
+}
+
+func TestFriendsKeyRotation(t *testing.T) {
+	alice, bob, carol := newTestFeed(t, "alice"), newTestFeed(t, "bob"), newTestFeed(t, "carol")
+	follow := func(g, other *Feed, p string) {
+		_, err := g.ProcessLetter(letter.Letter{Purpose: p, Content: other.PersonalKey.Public})
+		assert.Nil(t, err)
+	}
+	post := func(content string) string {
+		e, err := alice.ProcessLetter(letter.Letter{To: []string{"friends"}, Purpose: purpose.ShareText, Content: content})
+		assert.Nil(t, err)
+		return e.ID
+	}
+	share := func() {
+		syncFeeds(t, bob, alice)
+		syncFeeds(t, carol, alice)
+		assert.Nil(t, alice.UpdateFriends())
+		syncFeeds(t, alice, bob)
+		syncFeeds(t, alice, carol)
+	}
+
+	// bob and carol are friends of alice and get her friends key
+	for _, g := range []*Feed{bob, carol} {
+		follow(alice, g, purpose.ActionFollow)
+		follow(g, alice, purpose.ActionFollow)
+	}
+	share()
+	firstKey, err := alice.db.GetLatestKeyForFriends(alice.PersonalKey.Public)
+	assert.Nil(t, err)
+	before := post("before")
+	share()
+	assert.True(t, canOpen(bob, before))
+	assert.True(t, canOpen(carol, before))
+
+	// sharing again does not make a new key while the friends stay the same
+	share()
+	key, err := alice.db.GetLatestKeyForFriends(alice.PersonalKey.Public)
+	assert.Nil(t, err)
+	assert.Equal(t, firstKey, key)
+
+	// once bob is no longer a friend, only carol can open new posts
+	follow(alice, bob, purpose.ActionUnfollow)
+	share()
+	key, err = alice.db.GetLatestKeyForFriends(alice.PersonalKey.Public)
+	assert.Nil(t, err)
+	assert.NotEqual(t, firstKey, key)
+	after := post("after")
+	share()
+	assert.False(t, canOpen(bob, after))
+	assert.True(t, canOpen(carol, after))
+	assert.True(t, canOpen(bob, before))
+
+	// blocking a friend also makes a new key
+	follow(alice, carol, purpose.ActionBlock)
+	share()
+	blocked := post("blocked")
+	share()
+	assert.False(t, canOpen(carol, blocked))
+}
+
+func TestExpiry(t *testing.T) {
+	alice, bob, carol := newTestFeed(t, "alice"), newTestFeed(t, "bob"), newTestFeed(t, "carol")
+	post := func(content string, expiresIn int64) letter.Envelope {
+		e, err := alice.ProcessLetter(letter.Letter{To: []string{"public"}, Purpose: purpose.ShareText, Content: content, ExpiresIn: expiresIn})
+		assert.Nil(t, err)
+		return e
+	}
+	_, err := alice.ProcessLetter(letter.Letter{To: []string{"public"}, Purpose: purpose.ShareText, Content: "never", ExpiresIn: -1})
+	assert.NotNil(t, err)
+
+	lasting := post("lasting", 0)
+	story := post("a story", 3600)
+	fleeting := post("fleeting", 1)
+	assert.True(t, lasting.Expires.IsZero())
+	assert.Equal(t, story.Timestamp.Add(time.Hour), story.Expires)
+	syncFeeds(t, alice, bob)
+	e, err := bob.GetEnvelope(story.ID)
+	assert.Nil(t, err)
+	assert.Equal(t, story.Expires, e.Expires)
+	_, err = bob.GetEnvelope(fleeting.ID)
+	assert.Nil(t, err)
+
+	time.Sleep(1100 * time.Millisecond)
+
+	// expired envelopes are not given out or taken in
+	_, err = alice.GetEnvelope(fleeting.ID)
+	assert.NotNil(t, err)
+	ids, _, err := alice.GetIDs("test", 0)
+	assert.Nil(t, err)
+	assert.NotContains(t, ids, fleeting.ID)
+	assert.Contains(t, ids, story.ID)
+	sealed, err := alice.db.GetEnvelopeFromID(fleeting.ID)
+	assert.Nil(t, err)
+	sealed.Close()
+	assert.NotNil(t, carol.ProcessEnvelope(sealed, "test"))
+
+	// and every carrier deletes them
+	bob.UpdateEverything()
+	_, err = bob.db.GetEnvelopeFromID(fleeting.ID)
+	assert.NotNil(t, err)
+	for _, id := range []string{lasting.ID, story.ID} {
+		_, err = bob.GetEnvelope(id)
+		assert.Nil(t, err)
+	}
+}
+
+func TestImplausibleTimestamps(t *testing.T) {
+	alice, bob := newTestFeed(t, "alice"), newTestFeed(t, "bob")
+	// legacy envelopes do not sign their timestamp, so it can be set to anything
+	legacy := func(content string, timestamp time.Time) letter.Envelope {
+		e, err := letter.Letter{To: []string{alice.RegionKey.Public}, Purpose: purpose.ShareText, Content: content}.Seal(alice.PersonalKey, alice.RegionKey)
+		assert.Nil(t, err)
+		e.Version = 0
+		e.SigningKey = ""
+		e.EnvelopeSignature = ""
+		e.Signature, err = alice.PersonalKey.Signature(alice.RegionKey)
+		assert.Nil(t, err)
+		e.Timestamp = timestamp
+		return e
+	}
+	now := time.Now().UTC()
+
+	assert.Nil(t, bob.ProcessEnvelope(legacy("now", now.Add(time.Minute)), "peer"))
+	assert.Nil(t, bob.ProcessEnvelope(legacy("long ago", now.AddDate(-1, 0, 0)), "peer"))
+
+	// envelopes from too far in the future are quarantined
+	future := legacy("the future", now.Add(time.Hour))
+	assert.NotNil(t, bob.ProcessEnvelope(future, "peer"))
+	_, err := bob.GetEnvelope(future.ID)
+	assert.NotNil(t, err)
+	qs, err := bob.GetQuarantined()
+	assert.Nil(t, err)
+	assert.Equal(t, 1, len(qs))
+	assert.Equal(t, future.ID, qs[0].ID)
+	assert.Equal(t, "peer", qs[0].Peer)
+	assert.Contains(t, qs[0].Reason, "in the future")
+
+	// the bounds can be changed
+	bob.Settings.MaxClockSkew = 0
+	bob.Settings.MaxEnvelopeAge = 24 * 60 * 60
+	assert.Nil(t, bob.checkTimestamp(future, now))
+	old := legacy("last week", now.AddDate(0, 0, -7))
+	assert.NotNil(t, bob.ProcessEnvelope(old, "peer"))
+	assert.True(t, bob.isQuarantined(old.ID))
+
+	// the clock offset of a peer is estimated from the middle of the request
+	assert.Equal(t, 2*time.Second, bob.clockOffset("peer", now.Add(3*time.Second), now, now.Add(2*time.Second)))
+	assert.Equal(t, time.Duration(0), bob.clockOffset("peer", time.Time{}, now, now))
+}
+
+func TestSigningKeyPins(t *testing.T) {
+	alice, bob := newTestFeed(t, "alice"), newTestFeed(t, "bob")
+	legacy := func(content string, timestamp time.Time) letter.Envelope {
+		e, err := letter.Letter{To: []string{alice.RegionKey.Public}, Purpose: purpose.ShareText, Content: content}.Seal(alice.PersonalKey, alice.RegionKey)
+		assert.Nil(t, err)
+		e.Version = 0
+		e.SigningKey = ""
+		e.EnvelopeSignature = ""
+		e.Signature, err = alice.PersonalKey.Signature(alice.RegionKey)
+		assert.Nil(t, err)
+		e.Timestamp = timestamp
+		return e
+	}
+	signed := func(content string) letter.Envelope {
+		post, err := alice.ProcessLetter(letter.Letter{To: []string{"public"}, Purpose: purpose.ShareText, Content: content})
+		assert.Nil(t, err)
+		sealed, err := alice.db.GetEnvelopeFromID(post.ID)
+		assert.Nil(t, err)
+		sealed.Close()
+		return sealed
+	}
+	now := time.Now().UTC()
+
+	// unsigned envelopes are taken until the sender is pinned
+	assert.Nil(t, bob.ProcessEnvelope(legacy("before", now), "peer"))
+	first, second := signed("first"), signed("second")
+	assert.Nil(t, bob.ProcessEnvelope(second, "peer"))
+
+	// and afterwards only when they are from before the pin, whatever order they
+	// arrive in
+	assert.NotNil(t, bob.ProcessEnvelope(legacy("after", now.Add(time.Minute)), "peer"))
+	assert.Nil(t, bob.ProcessEnvelope(legacy("long ago", now.Add(-time.Hour)), "peer"))
+	between := first.Timestamp.Add(time.Nanosecond)
+	assert.Nil(t, bob.ProcessEnvelope(legacy("between", between), "peer"))
+
+	// signed envelopes that arrive late move the pin back
+	assert.Nil(t, bob.ProcessEnvelope(first, "peer"))
+	var pin signingKeyPin
+	assert.Nil(t, bob.db.Get("signing-keys", alice.PersonalKey.Public, &pin))
+	assert.True(t, pin.Since.Equal(first.Timestamp))
+	assert.NotNil(t, bob.ProcessEnvelope(legacy("between again", between), "peer"))
+}
+
+func TestUnsealInvalidLetters(t *testing.T) {
+	alice, bob := newTestFeed(t, "alice"), newTestFeed(t, "bob")
+	_, err := bob.ProcessLetter(letter.Letter{To: []string{"public"}, Purpose: purpose.ActionName, Content: "bob"})
+	assert.Nil(t, err)
+	assert.Nil(t, bob.UnsealLetters())
+	mention, err := alice.ProcessLetter(letter.Letter{To: []string{"public"}, Purpose: purpose.ShareText, Content: "hi @bob"})
+	assert.Nil(t, err)
+	forged, err := alice.db.GetEnvelopeFromID(mention.ID)
+	assert.Nil(t, err)
+	forged.Close()
+	forged.EnvelopeSignature, err = alice.PersonalKey.Sign([]byte("something else"))
+	assert.Nil(t, err)
+	assert.Nil(t, bob.db.AddEnvelope(forged))
+
+	// envelopes that are not valid are purged without being opened
+	_, live, cancel := bob.Subscribe(0)
+	defer cancel()
+	assert.Nil(t, bob.UnsealLetters())
+	assert.Empty(t, live)
+	_, err = bob.GetEnvelope(forged.ID)
+	assert.NotNil(t, err)
+	_, unread, err := bob.GetNotifications(false)
+	assert.Nil(t, err)
+	assert.Equal(t, 0, unread)
+}
+
+func TestAuthenticate(t *testing.T) {
+	alice, bob := newTestFeed(t, "alice"), newTestFeed(t, "bob")
+	nonce, err := bob.NewChallenge()
+	assert.Nil(t, err)
+
+	// the region key can not make a proof for someone else
+	forged, err := bob.RegionKey.Proof(bob.RegionKey, nonce, "GET", "/list")
+	assert.Nil(t, err)
+	assert.NotNil(t, bob.Authenticate(alice.PersonalKey.Public, nonce, forged, "GET", "/list"))
+
+	proof, err := alice.PersonalKey.Proof(alice.RegionKey, nonce, "GET", "/list")
+	assert.Nil(t, err)
+	assert.NotNil(t, bob.Authenticate(alice.PersonalKey.Public, nonce, proof, "GET", "/download"))
+	assert.Nil(t, bob.Authenticate(alice.PersonalKey.Public, nonce, proof, "GET", "/list"))
+	assert.NotNil(t, bob.Authenticate(alice.PersonalKey.Public, nonce, proof, "GET", "/list"))
+}
@@ -0,0 +1,263 @@
+package feed
+
+import (
+	"encoding/json"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/schollz/kiki/src/keypair"
+	"github.com/schollz/kiki/src/letter"
+	"github.com/schollz/kiki/src/purpose"
+)
+
+// groupPrefix addresses a letter to a group, as "group:<id>".
+const groupPrefix = "group:"
+
+// Group is a named group of people that share a group key. Letters to the group are
+// sealed with the latest group key, which is shared with the members the same way
+// that friends keys are. Only the owner of a group changes who is in it, and each
+// change makes a new group key.
+//
+// The private group keys are only kept in the share-key letters, so Key and Keys
+// are public keys.
+type Group struct {
+	ID      string   `json:"id"`
+	Name    string   `json:"name"`
+	Owner   string   `json:"owner"`
+	Members []string `json:"members"`
+	Key     string   `json:"key"`
+	// Keys are every group key that has been seen, the latest last, which are used
+	// to find the conversation of the group
+	Keys    []string  `json:"keys"`
+	Updated time.Time `json:"updated"`
+}
+
+// groupKey is the content of the share-key letter that gives a group key to the
+// members. The key is kept at the top so that the content is also a keypair.
+type groupKey struct {
+	Public  string   `json:"public"`
+	Private string   `json:"private"`
+	Group   string   `json:"group"`
+	Name    string   `json:"name"`
+	Members []string `json:"members"`
+}
+
+// NewGroup makes a group with the members and shares the group key with them.
+func (f *Feed) NewGroup(name string, members []string) (g Group, err error) {
+	name = strings.TrimSpace(name)
+	if name == "" {
+		err = errors.New("group needs a name")
+		return
+	}
+	g = Group{
+		ID:    keypair.New().Public,
+		Name:  name,
+		Owner: f.PersonalKey.Public,
+	}
+	return f.shareGroupKey(g, members)
+}
+
+// SetGroupMembers changes who is in a group that you own. A new group key is shared
+// with the members, so that people who left can not open the letters that follow.
+func (f *Feed) SetGroupMembers(id string, members []string) (g Group, err error) {
+	g, err = f.GetGroup(id)
+	if err != nil {
+		return
+	}
+	if g.Owner != f.PersonalKey.Public {
+		err = errors.New("only the owner can change the members of a group")
+		return
+	}
+	return f.shareGroupKey(g, members)
+}
+
+// shareGroupKey makes a new key for the group and sends it to the members.
+func (f *Feed) shareGroupKey(g Group, members []string) (Group, error) {
+	g.Members = []string{}
+	isMember := make(map[string]struct{})
+	for _, member := range members {
+		if _, ok := isMember[member]; ok || member == f.PersonalKey.Public {
+			continue
+		}
+		if _, err := keypair.FromPublic(member); err != nil {
+			return g, errors.Wrap(err, "bad member")
+		}
+		isMember[member] = struct{}{}
+		g.Members = append(g.Members, member)
+	}
+	sort.Strings(g.Members)
+
+	key := keypair.New()
+	bKey, err := json.Marshal(groupKey{
+		Public:  key.Public,
+		Private: key.Private,
+		Group:   g.ID,
+		Name:    g.Name,
+		Members: g.Members,
+	})
+	if err != nil {
+		return g, err
+	}
+	// the letter is opened right away so that the key is used for the letters that follow
+	ue, err := f.ProcessLetter(letter.Letter{
+		To:      g.Members,
+		Purpose: purpose.ShareKey,
+		Content: string(bKey),
+	})
+	if err != nil {
+		return g, errors.Wrap(err, "shareGroupKey")
+	}
+	err = f.db.UpdateEnvelope(ue)
+	if err != nil {
+		return g, errors.Wrap(err, "shareGroupKey")
+	}
+	err = f.addGroupKey(ue)
+	if err != nil {
+		return g, err
+	}
+	return f.GetGroup(g.ID)
+}
+
+// addGroupKey keeps the group that an opened share-key letter is for, if it is for a
+// group. The group belongs to whoever sent its first key, and later keys are only
+// taken from them.
+func (f *Feed) addGroupKey(e letter.Envelope) (err error) {
+	var gk groupKey
+	if e.Letter.Purpose != purpose.ShareKey || json.Unmarshal([]byte(e.Letter.Content), &gk) != nil || gk.Group == "" {
+		return
+	}
+	_, err = keypair.FromPair(gk.Public, gk.Private)
+	if err != nil {
+		return errors.Wrap(err, "addGroupKey")
+	}
+
+	g, errGet := f.GetGroup(gk.Group)
+	if errGet != nil {
+		g = Group{ID: gk.Group, Owner: e.Sender.Public}
+	} else if g.Owner != e.Sender.Public {
+		return errors.New("group key is not from the owner of the group")
+	}
+	for _, public := range g.Keys {
+		if public == gk.Public {
+			return
+		}
+	}
+	g.Keys = append(g.Keys, gk.Public)
+	if e.Timestamp.After(g.Updated) {
+		g.Name = gk.Name
+		g.Members = gk.Members
+		g.Key = gk.Public
+		g.Updated = e.Timestamp
+	}
+	err = f.db.Set("groups", g.ID, g)
+	f.forgetKeys()
+	return
+}
+
+// GetGroup returns a group that you are in.
+func (f *Feed) GetGroup(id string) (g Group, err error) {
+	err = f.db.Get("groups", id, &g)
+	if err != nil {
+		err = errors.Wrap(err, "no such group")
+	}
+	return
+}
+
+// GetGroups returns all the groups that you are in, sorted by name.
+func (f *Feed) GetGroups() (gs []Group) {
+	gs = []Group{}
+	ids, err := f.db.Keys("groups")
+	if err != nil {
+		return
+	}
+	for _, id := range ids {
+		g, err := f.GetGroup(id)
+		if err == nil {
+			gs = append(gs, g)
+		}
+	}
+	sort.Slice(gs, func(i, j int) bool {
+		return gs[i].Name < gs[j].Name
+	})
+	return
+}
+
+// forgetKeys drops the cached keys, after a key was added. The keys are only cached
+// while holding keyCache, so a cache that was being made before the key was added is
+// dropped too.
+func (f *Feed) forgetKeys() {
+	f.keyCache.Lock()
+	defer f.keyCache.Unlock()
+	f.caching.Delete("group-keys")
+	f.caching.Delete("shared-keys")
+}
+
+// groupOfKey returns the group that a public key is a group key of. The groups are
+// looked up by key once, and kept until a group changes, since every post that is
+// shown asks for the group of each of its recipients.
+func (f *Feed) groupOfKey(public string) (g Group, ok bool) {
+	f.keyCache.Lock()
+	defer f.keyCache.Unlock()
+	var keys map[string]Group
+	if cached, found := f.caching.Get("group-keys"); found {
+		keys = cached.(map[string]Group)
+	} else {
+		keys = make(map[string]Group)
+		for _, g := range f.GetGroups() {
+			for _, key := range g.Keys {
+				keys[key] = g
+			}
+		}
+		f.caching.Set("group-keys", keys, -1)
+	}
+	g, ok = keys[public]
+	return
+}
+
+// RecipientOf returns the recipient that a letter was sealed for the way that it is
+// given when writing one, so that your own friends keys are "friends" and group keys
+// are "group:<id>", which are sealed with the latest key when writing again.
+func (f *Feed) RecipientOf(public string) (recipient string) {
+	if g, ok := f.groupOfKey(public); ok {
+		return groupPrefix + g.ID
+	}
+	keys, err := f.db.GetKeysFromSender(f.PersonalKey.Public)
+	if err != nil {
+		f.logger.Log.Warn(err)
+		return public
+	}
+	for _, key := range keys {
+		if key.Public == public {
+			return "friends"
+		}
+	}
+	return public
+}
+
+// groupConversation returns the posts that were sent to a group, the latest first.
+func (f *Feed) groupConversation(id string) (envelopes []letter.Envelope, err error) {
+	g, err := f.GetGroup(id)
+	if err != nil {
+		return
+	}
+	keys := make(map[string]struct{})
+	for _, key := range g.Keys {
+		keys[key] = struct{}{}
+	}
+	posts, err := f.db.GetBasicPosts()
+	if err != nil {
+		return
+	}
+	envelopes = []letter.Envelope{}
+	for _, e := range posts {
+		for _, to := range e.Letter.To {
+			if _, ok := keys[to]; ok {
+				envelopes = append(envelopes, e)
+				break
+			}
+		}
+	}
+	return
+}
@@ -0,0 +1,82 @@
+package feed
+
+import (
+	"testing"
+
+	"github.com/schollz/kiki/src/letter"
+	"github.com/schollz/kiki/src/purpose"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGroups(t *testing.T) {
+	alice, bob, carol := newTestFeed(t, "alice"), newTestFeed(t, "bob"), newTestFeed(t, "carol")
+	post := func(g *Feed, to, content string) string {
+		e, err := g.ProcessLetter(letter.Letter{To: []string{to}, Purpose: purpose.ShareText, Content: content})
+		assert.Nil(t, err)
+		return e.ID
+	}
+	friendsKey, err := alice.db.GetLatestKeyForFriends(alice.PersonalKey.Public)
+	assert.Nil(t, err)
+
+	_, err = alice.NewGroup(" ", nil)
+	assert.NotNil(t, err)
+	_, err = alice.NewGroup("book club", []string{"not a key"})
+	assert.NotNil(t, err)
+	_, err = alice.ProcessLetter(letter.Letter{To: []string{"group:nothing"}, Purpose: purpose.ShareText, Content: "hello"})
+	assert.NotNil(t, err)
+
+	// the members get the group key and can write to the group
+	g, err := alice.NewGroup("book club", []string{bob.PersonalKey.Public, carol.PersonalKey.Public})
+	assert.Nil(t, err)
+	assert.Equal(t, alice.PersonalKey.Public, g.Owner)
+	assert.Equal(t, 1, len(g.Keys))
+	first := post(alice, "group:"+g.ID, "welcome")
+	shareAll(t, alice, bob, carol)
+	assert.True(t, canOpen(bob, first))
+	assert.True(t, canOpen(carol, first))
+	bobsGroup, err := bob.GetGroup(g.ID)
+	assert.Nil(t, err)
+	assert.Equal(t, g, bobsGroup)
+	reply := post(bob, "group:"+g.ID, "thanks")
+	shareAll(t, alice, bob, carol)
+	assert.True(t, canOpen(alice, reply))
+	assert.True(t, canOpen(carol, reply))
+
+	// the group has a conversation of its own, and its posts are shown as sent to it
+	post(alice, "public", "not for the group")
+	posts, err := carol.ShowFeed(ShowFeedParameters{Group: g.ID})
+	assert.Nil(t, err)
+	assert.Equal(t, 2, len(posts))
+	assert.Equal(t, "book club", posts[0].Post.Recipients)
+
+	// only the owner can change the members, and keys from others are not taken
+	_, err = bob.SetGroupMembers(g.ID, nil)
+	assert.NotNil(t, err)
+	_, err = bob.shareGroupKey(bobsGroup, []string{carol.PersonalKey.Public})
+	assert.NotNil(t, err)
+
+	// once bob leaves, he can not open new letters for the group
+	_, ok := alice.groupOfKey(g.Key)
+	assert.True(t, ok)
+	g, err = alice.SetGroupMembers(g.ID, []string{carol.PersonalKey.Public})
+	assert.Nil(t, err)
+	assert.Equal(t, 2, len(g.Keys))
+	// the new key is known right away, even though the keys of the groups are cached
+	keyGroup, ok := alice.groupOfKey(g.Key)
+	assert.True(t, ok)
+	assert.Equal(t, g.ID, keyGroup.ID)
+	// replies to letters sealed with older keys are sealed with the latest ones
+	assert.Equal(t, "group:"+g.ID, alice.RecipientOf(g.Keys[0]))
+	assert.Equal(t, "friends", alice.RecipientOf(friendsKey.Public))
+	assert.Equal(t, friendsKey.Public, bob.RecipientOf(friendsKey.Public))
+	after := post(alice, "group:"+g.ID, "bob has left")
+	shareAll(t, alice, bob, carol)
+	assert.False(t, canOpen(bob, after))
+	assert.True(t, canOpen(carol, after))
+	assert.True(t, canOpen(bob, first))
+
+	// group keys are not friends keys
+	key, err := alice.db.GetLatestKeyForFriends(alice.PersonalKey.Public)
+	assert.Nil(t, err)
+	assert.Equal(t, friendsKey, key)
+}
@@ -0,0 +1,109 @@
+package feed
+
+import (
+	"encoding/base64"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/schollz/kiki/src/letter"
+	"github.com/schollz/kiki/src/purpose"
+)
+
+// BlobPrefix starts the content of an opened image letter, followed by the hash of
+// the image in the blob store.
+const BlobPrefix = "blob:"
+
+// blobGracePeriod is how long a blob is kept before it can be collected, so that an
+// image that is being stored is not collected before its envelope is.
+const blobGracePeriod = 1 * time.Hour
+
+func isImage(p string) bool {
+	return p == purpose.SharePNG || p == purpose.ShareJPG
+}
+
+// storeImage moves the image of an opened envelope into the blob store and leaves a
+// reference to it in the letter.
+func (f *Feed) storeImage(e letter.Envelope) (letter.Envelope, error) {
+	if !e.Opened || !isImage(e.Letter.Purpose) || strings.HasPrefix(e.Letter.Content, BlobPrefix) {
+		return e, nil
+	}
+	data, err := base64.StdEncoding.DecodeString(e.Letter.Content)
+	if err != nil {
+		return e, errors.Wrap(err, "storeImage")
+	}
+	hash, err := f.blobs.Put(data)
+	if err != nil {
+		return e, errors.Wrap(err, "storeImage")
+	}
+	e.Letter.Content = BlobPrefix + hash
+	return e, nil
+}
+
+// OpenImage opens the image of an envelope, and returns the hash of the image.
+func (f *Feed) OpenImage(e letter.Envelope) (file *os.File, hash string, err error) {
+	if !isImage(e.Letter.Purpose) || !strings.HasPrefix(e.Letter.Content, BlobPrefix) {
+		err = errors.New("not an image")
+		return
+	}
+	hash = strings.TrimPrefix(e.Letter.Content, BlobPrefix)
+	file, err = f.blobs.Open(hash)
+	return
+}
+
+// moveImagesToBlobs moves the images that were kept in the database from before
+// there was a blob store.
+func (f *Feed) moveImagesToBlobs() (err error) {
+	var moved bool
+	if f.db.Get("blobs", "moved", &moved) == nil && moved {
+		return
+	}
+	envelopes, err := f.db.GetAllEnvelopes(true)
+	if err != nil {
+		return
+	}
+	for _, e := range envelopes {
+		if !isImage(e.Letter.Purpose) || strings.HasPrefix(e.Letter.Content, BlobPrefix) {
+			continue
+		}
+		e, err = f.storeImage(e)
+		if err != nil {
+			return
+		}
+		err = f.db.UpdateEnvelope(e)
+		if err != nil {
+			return
+		}
+	}
+	return f.db.Set("blobs", "moved", true)
+}
+
+// CollectBlobs removes the images that no envelope refers to anymore.
+func (f *Feed) CollectBlobs() (err error) {
+	hashes, err := f.blobs.List(time.Now().Add(-blobGracePeriod))
+	if err != nil || len(hashes) == 0 {
+		return
+	}
+	envelopes, err := f.db.GetAllEnvelopes(true)
+	if err != nil {
+		return
+	}
+	used := make(map[string]struct{})
+	for _, e := range envelopes {
+		if isImage(e.Letter.Purpose) && strings.HasPrefix(e.Letter.Content, BlobPrefix) {
+			used[strings.TrimPrefix(e.Letter.Content, BlobPrefix)] = struct{}{}
+		}
+	}
+	for _, hash := range hashes {
+		if _, ok := used[hash]; ok {
+			continue
+		}
+		f.logger.Log.Debugf("removing unused blob %s", hash)
+		err = f.blobs.Remove(hash)
+		if err != nil {
+			return
+		}
+	}
+	return
+}
@@ -0,0 +1,93 @@
+package feed
+
+import (
+	"bytes"
+	"encoding/base64"
+	"image"
+	"image/png"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/schollz/kiki/src/keypair"
+	"github.com/schollz/kiki/src/letter"
+	"github.com/schollz/kiki/src/purpose"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestImages(t *testing.T) {
+	var buf bytes.Buffer
+	assert.Nil(t, png.Encode(&buf, image.NewRGBA(image.Rect(0, 0, 2, 2))))
+	data := base64.StdEncoding.EncodeToString(buf.Bytes())
+
+	// the same image posted to different people is kept once
+	for _, to := range [][]string{{"public"}, {}} {
+		_, err := f.ProcessLetter(letter.Letter{
+			To:      to,
+			Purpose: purpose.ShareText,
+			Content: "an image ![image](data:image/png;base64," + data + ")",
+		})
+		assert.Nil(t, err)
+	}
+	assert.Nil(t, f.UnsealLetters())
+	var images []letter.Envelope
+	envelopes, err := f.db.GetAllEnvelopes(true)
+	assert.Nil(t, err)
+	for _, e := range envelopes {
+		if e.Letter.Purpose == purpose.SharePNG {
+			images = append(images, e)
+		}
+	}
+	assert.Equal(t, 2, len(images))
+	assert.True(t, strings.HasPrefix(images[0].Letter.Content, BlobPrefix))
+	assert.Equal(t, images[0].Letter.Content, images[1].Letter.Content)
+	hashes, err := f.blobs.List(time.Now().Add(1 * time.Minute))
+	assert.Nil(t, err)
+	assert.Equal(t, 1, len(hashes))
+
+	file, hash, err := f.OpenImage(images[0])
+	assert.Nil(t, err)
+	b, err := ioutil.ReadAll(file)
+	file.Close()
+	assert.Nil(t, err)
+	assert.Equal(t, hashes[0], hash)
+	_, err = png.Decode(bytes.NewReader(b))
+	assert.Nil(t, err)
+
+	// images that are no longer used are collected once they are old enough
+	assert.Nil(t, f.db.RemoveLetters([]string{images[0].ID, images[1].ID}))
+	assert.Nil(t, f.CollectBlobs())
+	_, _, err = f.OpenImage(images[0])
+	assert.Nil(t, err)
+	old := time.Now().Add(-2 * blobGracePeriod)
+	assert.Nil(t, os.Chtimes(filepath.Join(f.locationToKikiBlobs, hash[:2], hash), old, old))
+	assert.Nil(t, f.CollectBlobs())
+	_, _, err = f.OpenImage(images[0])
+	assert.NotNil(t, err)
+}
+
+func TestMoveImagesToBlobs(t *testing.T) {
+	e, err := letter.Letter{
+		To:      []string{f.RegionKey.Public},
+		Purpose: purpose.ShareJPG,
+		Content: base64.StdEncoding.EncodeToString([]byte("an old image")),
+	}.Seal(f.PersonalKey, f.RegionKey)
+	assert.Nil(t, err)
+	e, err = e.Unseal([]keypair.KeyPair{f.PersonalKey}, f.RegionKey)
+	assert.Nil(t, err)
+	assert.Nil(t, f.db.AddEnvelope(e))
+
+	assert.Nil(t, f.db.Set("blobs", "moved", false))
+	assert.Nil(t, f.moveImagesToBlobs())
+	e, err = f.db.GetEnvelopeFromID(e.ID)
+	assert.Nil(t, err)
+	file, _, err := f.OpenImage(e)
+	assert.Nil(t, err)
+	b, _ := ioutil.ReadAll(file)
+	file.Close()
+	assert.Equal(t, "an old image", string(b))
+	assert.Nil(t, f.db.RemoveLetters([]string{e.ID}))
+}
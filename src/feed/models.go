@@ -7,6 +7,7 @@ import (
 
 	"github.com/cihub/seelog"
 	cache "github.com/robfig/go-cache"
+	"github.com/schollz/kiki/src/blob"
 	"github.com/schollz/kiki/src/database"
 	"github.com/schollz/kiki/src/keypair"
 	"github.com/schollz/kiki/src/letter"
@@ -17,7 +18,12 @@ type Response struct {
 	PersonalSignature string          `json:"personal_signature"`
 	PersonalPublicKey string          `json:"personal_key"`
 	IDs               []string        `json:"ids"`
+	Cursor            int64           `json:"cursor"`
+	Incremental       bool            `json:"incremental"`
 	Envelope          letter.Envelope `json:"envelope"`
+	Results           []BatchResult   `json:"results"`
+	Nonce             string          `json:"nonce"`
+	ServerTime        time.Time       `json:"server_time"`
 	Error             string          `json:"error"`
 	Message           string          `json:"message"`
 	Status            string          `json:"status"`
@@ -33,37 +39,63 @@ type Feed struct {
 	locationToKikiDB       string
 	locationToKikiSearch   string
 	locationToKikiSettings string
-	db                     database.DatabaseAPI
+	locationToKikiBlobs    string
+	db                     database.Store
+	blobs                  blob.Store
 	log                    seelog.LoggerInterface
 	logger                 logging.SeelogWrapper
 	caching                *cache.Cache
 	servers                connections
+	usage                  storageUsage
+	events                 events
+	updates                chan struct{}
+	webhookLog             sync.Mutex
+	keyCache               sync.Mutex
 }
 
 type connections struct {
 	connected    map[string]User
 	blockedUsers map[string]struct{}
-	syncingCount int
 	updating     bool
 	sync.RWMutex
 }
 
 type Settings struct {
-	StoragePerPublicPerson int64    `json:"storage_per_person"`  // maximum size in bytes to store of public messages. Once exceeded, old messages are purged
-	StoragePerFriend       int64    `json:"storage_per_friend"`  // maximum size in bytes to store of friend messages. Once exceeded, old messages are purged
-	FriendsOfFriends       bool     `json:"friends_of_friends"`  // whether you want to share your friends friend keys with new friends, effectively making a new friend friends with all your friends. This also means that when you make a new friend, that friends key is emitted to all your current friends. (default: true)
-	BlockPublicPhotos      bool     `json:"block_public_photos"` // if true, block the transfer of any public photos to your computer
-	AvailableServers       []string `json:"available_servers"`
+	StoragePerPublicPerson   int64     `json:"storage_per_person"`  // maximum size in bytes to store of public messages. Once exceeded, old messages are purged
+	StoragePerFriend         int64     `json:"storage_per_friend"`  // maximum size in bytes to store of friend messages. Once exceeded, old messages are purged
+	FriendsOfFriends         bool      `json:"friends_of_friends"`  // whether you want to share your friends friend keys with new friends, effectively making a new friend friends with all your friends. This also means that when you make a new friend, that friends key is emitted to all your current friends. (default: true)
+	BlockPublicPhotos        bool      `json:"block_public_photos"` // if true, block the transfer of any public photos to your computer
+	AvailableServers         []string  `json:"available_servers"`
+	AllowLegacyAuth          bool      `json:"allow_legacy_auth"`           // if true, servers can still list IDs with a signature that is not bound to a nonce, which can be replayed (default: false)
+	MaxClockSkew             int64     `json:"max_clock_skew"`              // maximum number of seconds that an envelope can be timestamped in the future, otherwise it is quarantined. 0 is no limit (default: 600)
+	MaxEnvelopeAge           int64     `json:"max_envelope_age"`            // maximum number of seconds that an envelope can be timestamped in the past, otherwise it is quarantined. 0 is no limit (default: 0)
+	StampDifficulty          int       `json:"stamp_difficulty"`            // number of leading zero bits that the proof-of-work stamp of an envelope needs to have, which should be the same throughout the region. Envelopes are also minted with it. 0 is no stamps (default: 0)
+	ActionStampDifficulty    int       `json:"action_stamp_difficulty"`     // the same as stamp_difficulty for envelopes of actions, which can be lower since actions are small (default: 0)
+	PeerRequestsPerSecond    float64   `json:"peer_requests_per_second"`    // number of requests per second that a peer can make to the public server, beyond which it is answered with 429. 0 is no limit (default: 20)
+	PeerBurst                int       `json:"peer_burst"`                  // number of requests that a peer can make at once before it is limited, like when syncing (default: 200)
+	SenderEnvelopesPerSecond float64   `json:"sender_envelopes_per_second"` // number of envelopes per second that are taken in from one sender, from any peer. 0 is no limit (default: 20)
+	SenderBurst              int       `json:"sender_burst"`                // number of envelopes that are taken in from one sender at once before it is limited (default: 1000)
+	TrustedProxies           []string  `json:"trusted_proxies"`             // addresses or CIDR ranges of reverse proxies in front of the public server, whose X-Forwarded-For header tells who the peer is. Otherwise the peer is who connected (default: none)
+	AllowedOrigins           []string  `json:"allowed_origins"`             // origins of other websites, like "http://localhost:3000", that can use the private server from the browser. They still need the API token or the CSRF token (default: none)
+	Webhooks                 []Webhook `json:"webhooks"`                    // URLs that are posted to when someone mentions you, replies to your post, follows you, sends you a message or uses a hashtag, see Webhook (default: none)
 }
 
 // GenerateSettings create new instance of Something
 func GenerateSettings() Settings {
 	return Settings{
-		StoragePerPublicPerson: 5000000 / 4,  // 5 MB
-		StoragePerFriend:       50000000 / 4, // 50 MB
-		FriendsOfFriends:       true,
-		BlockPublicPhotos:      true,
-		AvailableServers:       []string{},
+		StoragePerPublicPerson:   5000000 / 4,  // 5 MB
+		StoragePerFriend:         50000000 / 4, // 50 MB
+		FriendsOfFriends:         true,
+		BlockPublicPhotos:        true,
+		AvailableServers:         []string{},
+		MaxClockSkew:             600, // 10 minutes
+		PeerRequestsPerSecond:    20,
+		PeerBurst:                200,
+		SenderEnvelopesPerSecond: 20,
+		SenderBurst:              1000,
+		TrustedProxies:           []string{},
+		AllowedOrigins:           []string{},
+		Webhooks:                 []Webhook{},
 	}
 }
 
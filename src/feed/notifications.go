@@ -0,0 +1,205 @@
+package feed
+
+import (
+	"sort"
+	"strings"
+	"time"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/pkg/errors"
+	strip "github.com/schollz/html-strip-tags-go"
+	"github.com/schollz/kiki/src/letter"
+	"github.com/schollz/kiki/src/purpose"
+)
+
+// The types of notifications
+const (
+	NotificationReply   = "reply"   // someone commented on your post or comment
+	NotificationLike    = "like"    // someone liked your post or comment
+	NotificationFollow  = "follow"  // someone followed you
+	NotificationMention = "mention" // someone wrote @ and your name
+)
+
+// notificationPreview is how many characters of a post are kept in its notification
+const notificationPreview = 140
+
+// Notification tells about a letter of someone else that concerns you.
+type Notification struct {
+	// ID is the ID of the letter
+	ID   string `json:"id"`
+	Type string `json:"type"`
+	// User is who sent the letter, and Name is their name
+	User string `json:"user"`
+	Name string `json:"name"`
+	// PostID is the post that the notification is about, which is yours for a reply
+	// or a like
+	PostID string `json:"post_id,omitempty"`
+	// Content is the start of the text of a reply or a mention
+	Content string    `json:"content,omitempty"`
+	Time    time.Time `json:"time"`
+	Read    bool      `json:"read"`
+}
+
+// isMine tells whether you sent the post with the ID.
+func (f *Feed) isMine(id string) bool {
+	e, err := f.db.GetEnvelopeFromID(id)
+	return err == nil && e.Sender.Public == f.PersonalKey.Public
+}
+
+// repliesToMe tells whether the letter is a comment on one of your posts or comments.
+func (f *Feed) repliesToMe(e letter.Envelope) bool {
+	return e.Letter.Purpose == purpose.ShareText && e.Letter.ReplyTo != "" && f.isMine(e.Letter.ReplyTo)
+}
+
+// mentionsMe tells whether the letter is a post that has @ and your name.
+func (f *Feed) mentionsMe(e letter.Envelope) bool {
+	if e.Letter.Purpose != purpose.ShareText {
+		return false
+	}
+	name := strings.ToLower(strip.StripTags(f.db.GetName(f.PersonalKey.Public)))
+	return name != "" && mentions(strings.ToLower(e.Letter.Content), name)
+}
+
+// mentions tells whether the content has @ and the name as a word of its own, so
+// that @bob is not found in @bobby or in bob@example.com.
+func mentions(content, name string) bool {
+	at := "@" + name
+	for i := strings.Index(content, at); i >= 0; {
+		before, _ := utf8.DecodeLastRuneInString(content[:i])
+		after, _ := utf8.DecodeRuneInString(content[i+len(at):])
+		if !isWordRune(before) && !isWordRune(after) {
+			return true
+		}
+		next := strings.Index(content[i+1:], at)
+		if next < 0 {
+			break
+		}
+		i += 1 + next
+	}
+	return false
+}
+
+// isWordRune tells whether the rune can be part of a name.
+func isWordRune(r rune) bool {
+	return r == '_' || unicode.IsLetter(r) || unicode.IsDigit(r)
+}
+
+// isEdit tells whether the letter is a new version of an earlier one. Actions that
+// are sent again, like following after unfollowing, continue from the letter that
+// they repeat but are not edits.
+func isEdit(e letter.Envelope) bool {
+	return e.Letter.FirstID != e.ID && !purpose.IsAction(e.Letter.Purpose)
+}
+
+// makeNotification returns the notification of a letter that was opened, if it
+// concerns you. Edits of posts do not make new notifications.
+func (f *Feed) makeNotification(e letter.Envelope) (n Notification, ok bool) {
+	if e.Sender.Public == f.PersonalKey.Public || isEdit(e) {
+		return
+	}
+	n = Notification{ID: e.ID, User: e.Sender.Public, Time: e.Timestamp}
+	switch {
+	case e.Letter.Purpose == purpose.ActionLike && f.isMine(e.Letter.Content):
+		n.Type, n.PostID = NotificationLike, e.Letter.Content
+	case e.Letter.Purpose == purpose.ActionFollow && e.Letter.Content == f.PersonalKey.Public:
+		n.Type = NotificationFollow
+	case f.repliesToMe(e):
+		n.Type, n.PostID = NotificationReply, e.Letter.ReplyTo
+	case f.mentionsMe(e):
+		n.Type, n.PostID = NotificationMention, e.ID
+	default:
+		return
+	}
+	if n.Type == NotificationReply || n.Type == NotificationMention {
+		preview := []rune(strings.TrimSpace(strip.StripTags(e.Letter.Content)))
+		if len(preview) > notificationPreview {
+			preview = preview[:notificationPreview]
+		}
+		n.Content = string(preview)
+	}
+	return n, true
+}
+
+// notify keeps the notifications of the letters that were opened, and emits them.
+func (f *Feed) notify(opened []letter.Envelope) {
+	for _, e := range opened {
+		n, ok := f.makeNotification(e)
+		if !ok {
+			continue
+		}
+		// letters that were opened before keep their notification as it is
+		var known Notification
+		if f.db.Get("notifications", n.ID, &known) == nil {
+			continue
+		}
+		err := f.db.Set("notifications", n.ID, n)
+		if err != nil {
+			f.logger.Log.Warn(errors.Wrap(err, "notify"))
+			continue
+		}
+		f.emit(Event{Type: EventNotification, User: n.User, PostID: n.PostID, Notification: n.Type})
+	}
+}
+
+// GetNotifications returns the notifications, or only the unread ones, the latest
+// first, along with how many are unread. Notifications of letters that are gone,
+// like those of people that you blocked, are left out.
+func (f *Feed) GetNotifications(unreadOnly bool) (ns []Notification, unread int, err error) {
+	ids, err := f.db.Keys("notifications")
+	if err != nil {
+		return
+	}
+	ns = []Notification{}
+	for _, id := range ids {
+		var n Notification
+		err = f.db.Get("notifications", id, &n)
+		if err != nil {
+			return
+		}
+		if _, err2 := f.db.GetEnvelopeFromID(id); err2 != nil {
+			continue
+		}
+		if !n.Read {
+			unread++
+		} else if unreadOnly {
+			continue
+		}
+		n.Name = strip.StripTags(f.db.GetName(n.User))
+		if n.Name == "" {
+			n.Name = n.User
+		}
+		ns = append(ns, n)
+	}
+	sort.Slice(ns, func(i, j int) bool {
+		return ns[i].Time.After(ns[j].Time)
+	})
+	return
+}
+
+// MarkNotificationsRead marks the notifications with the IDs as read, or every
+// notification if no IDs are given.
+func (f *Feed) MarkNotificationsRead(ids ...string) (err error) {
+	if len(ids) == 0 {
+		ids, err = f.db.Keys("notifications")
+		if err != nil {
+			return
+		}
+	}
+	for _, id := range ids {
+		var n Notification
+		err = f.db.Get("notifications", id, &n)
+		if err != nil {
+			return errors.Wrap(err, "no such notification")
+		}
+		if n.Read {
+			continue
+		}
+		n.Read = true
+		err = f.db.Set("notifications", id, n)
+		if err != nil {
+			return
+		}
+	}
+	return
+}
@@ -0,0 +1,119 @@
+package feed
+
+import (
+	"testing"
+
+	"github.com/schollz/kiki/src/letter"
+	"github.com/schollz/kiki/src/purpose"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNotifications(t *testing.T) {
+	alice, bob := newTestFeed(t, "alice"), newTestFeed(t, "bob")
+	_, err := bob.ProcessLetter(letter.Letter{To: []string{"public"}, Purpose: purpose.ActionName, Content: "Bob"})
+	assert.Nil(t, err)
+	post, err := bob.ProcessLetter(letter.Letter{To: []string{"public"}, Purpose: purpose.ShareText, Content: "hello"})
+	assert.Nil(t, err)
+	assert.Nil(t, bob.UnsealLetters())
+	syncFeeds(t, bob, alice)
+
+	_, err = alice.ProcessLetter(letter.Letter{Purpose: purpose.ActionLike, Content: post.ID})
+	assert.Nil(t, err)
+	comment, err := alice.ProcessLetter(letter.Letter{To: []string{"public"}, Purpose: purpose.ShareText, Content: "nice", ReplyTo: post.ID})
+	assert.Nil(t, err)
+	mention, err := alice.ProcessLetter(letter.Letter{To: []string{"public"}, Purpose: purpose.ShareText, Content: "hi @bob"})
+	assert.Nil(t, err)
+	_, err = alice.ProcessLetter(letter.Letter{Purpose: purpose.ActionFollow, Content: bob.PersonalKey.Public})
+	assert.Nil(t, err)
+	// letters that are not about bob, and edits, do not notify
+	_, err = alice.ProcessLetter(letter.Letter{To: []string{"public"}, Purpose: purpose.ShareText, Content: "hi everyone"})
+	assert.Nil(t, err)
+	_, err = alice.ProcessLetter(letter.Letter{To: []string{"public"}, Purpose: purpose.ShareText, Content: "hi @bobby"})
+	assert.Nil(t, err)
+	_, err = alice.ProcessLetter(letter.Letter{To: []string{"public"}, Purpose: purpose.ShareText, Content: "very nice", FirstID: comment.ID})
+	assert.Nil(t, err)
+
+	_, live, cancel := bob.Subscribe(0)
+	defer cancel()
+	syncFeeds(t, alice, bob)
+	notified := 0
+	for len(live) > 0 {
+		if e := <-live; e.Type == EventNotification {
+			notified++
+		}
+	}
+	assert.Equal(t, 4, notified)
+
+	ns, unread, err := bob.GetNotifications(false)
+	assert.Nil(t, err)
+	assert.Equal(t, 4, unread)
+	types := map[string]Notification{}
+	for _, n := range ns {
+		assert.Equal(t, alice.PersonalKey.Public, n.User)
+		assert.False(t, n.Read)
+		types[n.Type] = n
+	}
+	assert.Equal(t, post.ID, types[NotificationLike].PostID)
+	assert.Equal(t, comment.ID, types[NotificationReply].ID)
+	assert.Equal(t, post.ID, types[NotificationReply].PostID)
+	assert.Equal(t, "nice", types[NotificationReply].Content)
+	assert.Equal(t, mention.ID, types[NotificationMention].PostID)
+	assert.Equal(t, "hi @bob", types[NotificationMention].Content)
+	assert.Empty(t, types[NotificationFollow].PostID)
+
+	// opening the letters again does not notify again
+	syncFeeds(t, alice, bob)
+	_, unread, _ = bob.GetNotifications(false)
+	assert.Equal(t, 4, unread)
+
+	assert.Nil(t, bob.MarkNotificationsRead(comment.ID))
+	ns, unread, err = bob.GetNotifications(true)
+	assert.Nil(t, err)
+	assert.Equal(t, 3, unread)
+	assert.Equal(t, 3, len(ns))
+	assert.NotNil(t, bob.MarkNotificationsRead("nothing"))
+
+	assert.Nil(t, bob.MarkNotificationsRead())
+	ns, unread, _ = bob.GetNotifications(false)
+	assert.Equal(t, 0, unread)
+	assert.Equal(t, 4, len(ns))
+	ns, _, _ = bob.GetNotifications(true)
+	assert.Empty(t, ns)
+
+	// following again after unfollowing notifies again
+	_, err = alice.ProcessLetter(letter.Letter{Purpose: purpose.ActionUnfollow, Content: bob.PersonalKey.Public})
+	assert.Nil(t, err)
+	follow, err := alice.ProcessLetter(letter.Letter{Purpose: purpose.ActionFollow, Content: bob.PersonalKey.Public})
+	assert.Nil(t, err)
+	assert.NotEqual(t, follow.ID, follow.Letter.FirstID)
+	syncFeeds(t, alice, bob)
+	ns, unread, _ = bob.GetNotifications(true)
+	assert.Equal(t, 1, unread)
+	if assert.Equal(t, 1, len(ns)) {
+		assert.Equal(t, NotificationFollow, ns[0].Type)
+		assert.Equal(t, follow.ID, ns[0].ID)
+	}
+	assert.Nil(t, bob.MarkNotificationsRead())
+
+	// notifications go along with their letters
+	assert.Nil(t, bob.db.RemoveLetters([]string{comment.ID}))
+	ids, err := bob.db.Keys("notifications")
+	assert.Nil(t, err)
+	assert.Equal(t, 4, len(ids))
+	assert.NotContains(t, ids, comment.ID)
+}
+
+func TestMentions(t *testing.T) {
+	for content, mentioned := range map[string]bool{
+		"@bob":                     true,
+		"hi @bob, how are you":     true,
+		"<p>hi @bob</p>":           true,
+		"@bobby":                   false,
+		"@bob_":                    false,
+		"write to bob@example.com": false,
+		"@bobby and @bob":          true,
+		"bob":                      false,
+	} {
+		assert.Equal(t, mentioned, mentions(content, "bob"), content)
+	}
+}
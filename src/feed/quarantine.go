@@ -0,0 +1,76 @@
+package feed
+
+import (
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/schollz/kiki/src/letter"
+)
+
+// QuarantinedEnvelope is an envelope that was removed after it failed a check, along
+// with the peer that it came from.
+type QuarantinedEnvelope struct {
+	ID       string          `json:"id"`
+	Sender   string          `json:"sender"`
+	Peer     string          `json:"peer"`
+	Reason   string          `json:"reason"`
+	Time     time.Time       `json:"time"`
+	Envelope letter.Envelope `json:"envelope"`
+}
+
+// recordOrigin keeps track of which peer an envelope came from. The origin is
+// deleted along with the envelope.
+func (f *Feed) recordOrigin(id, peer string) (err error) {
+	return f.db.Set("origins", id, peer)
+}
+
+// quarantine removes the envelope from the letters and records why, so that it is
+// not downloaded again.
+func (f *Feed) quarantine(e letter.Envelope, reason string) (err error) {
+	var peer string
+	f.db.Get("origins", e.ID, &peer)
+	f.logger.Log.Warnf("quarantining %s from %s (sender %s): %s", e.ID, peer, e.Sender.Public, reason)
+	e.Close()
+	err = f.db.Set("quarantine", e.ID, QuarantinedEnvelope{
+		ID:       e.ID,
+		Sender:   e.Sender.Public,
+		Peer:     peer,
+		Reason:   reason,
+		Time:     time.Now().UTC(),
+		Envelope: e,
+	})
+	if err != nil {
+		return errors.Wrap(err, "quarantine")
+	}
+	// the peer is kept with the quarantined envelope instead, which might never
+	// have been added to the letters
+	err = f.db.Delete("origins", e.ID)
+	if err != nil {
+		return errors.Wrap(err, "quarantine")
+	}
+	return f.db.RemoveLetters([]string{e.ID})
+}
+
+// isQuarantined returns whether the envelope ID has been quarantined
+func (f *Feed) isQuarantined(id string) bool {
+	var q QuarantinedEnvelope
+	return f.db.Get("quarantine", id, &q) == nil
+}
+
+// GetQuarantined returns all the envelopes in quarantine
+func (f *Feed) GetQuarantined() (qs []QuarantinedEnvelope, err error) {
+	ids, err := f.db.Keys("quarantine")
+	if err != nil {
+		return
+	}
+	qs = make([]QuarantinedEnvelope, 0, len(ids))
+	for _, id := range ids {
+		var q QuarantinedEnvelope
+		err = f.db.Get("quarantine", id, &q)
+		if err != nil {
+			return
+		}
+		qs = append(qs, q)
+	}
+	return
+}
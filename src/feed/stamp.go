@@ -0,0 +1,37 @@
+package feed
+
+import (
+	"github.com/pkg/errors"
+	"github.com/schollz/kiki/src/letter"
+)
+
+// SetStampDifficulty sets the number of bits that the proof-of-work stamps of
+// envelopes need to have, for envelopes of actions and for all other envelopes.
+func (f *Feed) SetStampDifficulty(difficulty, actionDifficulty int) (err error) {
+	for _, d := range []int{difficulty, actionDifficulty} {
+		if d < 0 || d > letter.MaxStampDifficulty {
+			return errors.Errorf("stamp difficulty must be between 0 and %d", letter.MaxStampDifficulty)
+		}
+	}
+	f.Settings.StampDifficulty = difficulty
+	f.Settings.ActionStampDifficulty = actionDifficulty
+	return
+}
+
+// checkStamp checks that the envelope has a stamp with enough work for it. Whether
+// the envelope is an action is only found out when the stamp is enough for one kind
+// of envelope but not the other.
+func (f *Feed) checkStamp(e letter.Envelope) (err error) {
+	bits := e.StampBits()
+	required := f.Settings.StampDifficulty
+	if bits >= required && bits >= f.Settings.ActionStampDifficulty {
+		return
+	}
+	if f.isAction(e) {
+		required = f.Settings.ActionStampDifficulty
+	}
+	if bits < required {
+		return errors.Errorf("stamp has %d bits of work, needs %d", bits, required)
+	}
+	return
+}
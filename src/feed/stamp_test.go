@@ -0,0 +1,43 @@
+package feed
+
+import (
+	"testing"
+
+	"github.com/schollz/kiki/src/letter"
+	"github.com/schollz/kiki/src/purpose"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestStamps(t *testing.T) {
+	alice, bob := newTestFeed(t, "alice"), newTestFeed(t, "bob")
+	send := func(from, to *Feed, l letter.Letter) error {
+		e, err := from.ProcessLetter(l)
+		assert.Nil(t, err)
+		sealed, err := from.db.GetEnvelopeFromID(e.ID)
+		assert.Nil(t, err)
+		return to.ProcessEnvelope(sealed, "test")
+	}
+	post := letter.Letter{To: []string{"public"}, Purpose: purpose.ShareText, Content: "hello"}
+	follow := letter.Letter{Purpose: purpose.ActionFollow, Content: bob.PersonalKey.Public}
+
+	assert.NotNil(t, bob.SetStampDifficulty(-1, 0))
+	assert.NotNil(t, bob.SetStampDifficulty(0, letter.MaxStampDifficulty+1))
+	assert.Nil(t, bob.SetStampDifficulty(12, 4))
+
+	// without stamps nothing is taken in
+	assert.NotNil(t, send(alice, bob, post))
+	assert.NotNil(t, send(alice, bob, follow))
+
+	// actions need less work than the rest
+	assert.Nil(t, alice.SetStampDifficulty(0, 4))
+	post.Content = "hello again"
+	assert.NotNil(t, send(alice, bob, post))
+	assert.Nil(t, send(alice, bob, follow))
+
+	assert.Nil(t, alice.SetStampDifficulty(12, 4))
+	post.Content = "hello with a stamp"
+	assert.Nil(t, send(alice, bob, post))
+	e, err := alice.ProcessLetter(letter.Letter{To: []string{"public"}, Purpose: purpose.ShareText, Content: "a stamp"})
+	assert.Nil(t, err)
+	assert.True(t, e.StampBits() >= 12)
+}
@@ -0,0 +1,188 @@
+package feed
+
+import (
+	"encoding/json"
+	"fmt"
+	"sync"
+
+	"github.com/schollz/kiki/src/keypair"
+	"github.com/schollz/kiki/src/letter"
+	"github.com/schollz/kiki/src/purpose"
+)
+
+// StorageLimitError is returned when an envelope is not taken in because its sender
+// has used all the storage that they are given.
+type StorageLimitError struct {
+	Sender string `json:"sender"`
+	Usage  int64  `json:"usage"`
+	Size   int64  `json:"size"`
+	Limit  int64  `json:"limit"`
+}
+
+func (e StorageLimitError) Error() string {
+	return fmt.Sprintf("storage limit reached for %s, %d bytes are used and %d more do not fit in %d", e.Sender, e.Usage, e.Size, e.Limit)
+}
+
+// storageUsage caches the bytes that each sender uses, so that the database does not
+// have to be scanned for each envelope that comes in.
+type storageUsage struct {
+	bytes map[string]int64
+	sync.Mutex
+}
+
+// envelopeSize is the size of the envelope as counted by DiskSpaceForUser.
+func envelopeSize(e letter.Envelope) int64 {
+	b, _ := json.Marshal(e.SealedRecipients)
+	return int64(len(e.SealedLetter) + len(b))
+}
+
+// usageOf returns the bytes used by a sender, from the cache if it is there. The
+// storage usage must be locked.
+func (f *Feed) usageOf(user string) (used int64, err error) {
+	if f.usage.bytes == nil {
+		f.usage.bytes = make(map[string]int64)
+	}
+	used, ok := f.usage.bytes[user]
+	if ok {
+		return
+	}
+	used, err = f.db.DiskSpaceForUser(user)
+	if err != nil {
+		return
+	}
+	f.usage.bytes[user] = used
+	return
+}
+
+// resetStorageUsage forgets the cached usage of the users, or of everyone if no users
+// are given, after envelopes were deleted.
+func (f *Feed) resetStorageUsage(users ...string) {
+	f.usage.Lock()
+	defer f.usage.Unlock()
+	if len(users) == 0 {
+		f.usage.bytes = make(map[string]int64)
+	}
+	for _, user := range users {
+		delete(f.usage.bytes, user)
+	}
+}
+
+// storageLimit returns how many bytes a user is given.
+func (f *Feed) storageLimit(user string) int64 {
+	_, _, friends := f.db.Friends(f.PersonalKey.Public)
+	for _, friend := range friends {
+		if friend == user {
+			return f.Settings.StoragePerFriend
+		}
+	}
+	return f.Settings.StoragePerPublicPerson
+}
+
+// isAction tells whether the envelope is an action, which can be told by anyone
+// since actions are always sealed for the region.
+func (f *Feed) isAction(e letter.Envelope) bool {
+	ue, err := e.Unseal([]keypair.KeyPair{f.RegionKey}, f.RegionKey)
+	return err == nil && purpose.IsAction(ue.Letter.Purpose)
+}
+
+// reserveStorage counts the envelope against the storage of its sender. If it does
+// not fit, older envelopes of the sender are purged to make room for it, and if
+// there is still no room a StorageLimitError is returned. Actions count like any
+// other envelope, since their content is not checked before they are opened, and
+// only your own envelopes are never limited.
+func (f *Feed) reserveStorage(e letter.Envelope) (err error) {
+	sender := e.Sender.Public
+	if sender == f.PersonalKey.Public {
+		return
+	}
+	size := envelopeSize(e)
+
+	f.usage.Lock()
+	defer f.usage.Unlock()
+	used, err := f.usageOf(sender)
+	if err != nil {
+		return
+	}
+	if used+size > f.Settings.StoragePerPublicPerson {
+		limit := f.storageLimit(sender)
+		if used+size > limit {
+			if size < limit {
+				f.logger.Log.Debugf("making room for %s from %s", e.ID, sender)
+				used, err = f.purgeUser(sender, limit-size)
+				if err != nil {
+					return
+				}
+				f.usage.bytes[sender] = used
+			}
+			if used+size > limit {
+				return StorageLimitError{Sender: sender, Usage: used, Size: size, Limit: limit}
+			}
+		}
+	}
+	f.usage.bytes[sender] = used + size
+	return
+}
+
+// releaseStorage takes back storage that was reserved for an envelope that was not
+// stored after all.
+func (f *Feed) releaseStorage(e letter.Envelope) {
+	f.usage.Lock()
+	defer f.usage.Unlock()
+	if used, ok := f.usage.bytes[e.Sender.Public]; ok {
+		f.usage.bytes[e.Sender.Public] = used - envelopeSize(e)
+	}
+}
+
+// purgeUser deletes envelopes of the user until they use less than the limit: first
+// old actions, then edits, then the oldest large posts and then the oldest posts. It
+// returns how many bytes the user uses afterwards.
+func (f *Feed) purgeUser(user string, limit int64) (currentSpace int64, err error) {
+	currentSpace, err = f.db.DiskSpaceForUser(user)
+	if err != nil {
+		return
+	}
+	f.logger.Log.Debugf("user: %s: space: %d / %d", user, currentSpace, limit)
+
+	// don't proceed if the current space does not exceed
+	if currentSpace < limit {
+		return
+	}
+
+	// first purge repeated actions (changing names multiple times)
+	err = f.db.DeleteOldActions(user)
+	if err != nil {
+		return
+	}
+
+	// then purge edits
+	err = f.db.DeleteUsersEdits(user)
+	if err != nil {
+		return
+	}
+	currentSpace, err = f.db.DiskSpaceForUser(user)
+	if err != nil {
+		return
+	}
+
+	for _, deleteOldest := range []func(string) error{f.db.DeleteUsersOldestLargestPost, f.db.DeleteUsersOldestPost} {
+		for currentSpace >= limit {
+			f.logger.Log.Debugf("user: %s: space: %d / %d", user, currentSpace, limit)
+			err2 := deleteOldest(user)
+			if err2 != nil {
+				f.logger.Log.Debug(err2)
+				break
+			}
+			space, err2 := f.db.DiskSpaceForUser(user)
+			if err2 != nil {
+				f.logger.Log.Debug(err2)
+				break
+			}
+			// nothing was deleted, so there is nothing more of this kind
+			if space == currentSpace {
+				break
+			}
+			currentSpace = space
+		}
+	}
+	return
+}
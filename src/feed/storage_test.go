@@ -0,0 +1,86 @@
+package feed
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/pkg/errors"
+	"github.com/schollz/kiki/src/keypair"
+	"github.com/schollz/kiki/src/letter"
+	"github.com/schollz/kiki/src/purpose"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestStorageLimits(t *testing.T) {
+	alice, bob := newTestFeed(t, "alice"), newTestFeed(t, "bob")
+	seal := func(l letter.Letter) letter.Envelope {
+		if l.Purpose == "" {
+			l.Purpose = purpose.ShareText
+			l.To = []string{"public"}
+		}
+		e, err := alice.ProcessLetter(l)
+		assert.Nil(t, err)
+		sealed, err := alice.db.GetEnvelopeFromID(e.ID)
+		assert.Nil(t, err)
+		sealed.Close()
+		return sealed
+	}
+	has := func(e letter.Envelope) bool {
+		_, err := bob.GetEnvelope(e.ID)
+		return err == nil
+	}
+	first, second, third := seal(letter.Letter{Content: "first"}), seal(letter.Letter{Content: "second"}), seal(letter.Letter{Content: "third"})
+	size := envelopeSize(first)
+	bob.Settings.StoragePerPublicPerson = 2*size + size/2
+	bob.Settings.StoragePerFriend = bob.Settings.StoragePerPublicPerson
+
+	// the usage is counted as envelopes come in
+	assert.Nil(t, bob.ProcessEnvelope(first, "peer"))
+	assert.Nil(t, bob.ProcessEnvelope(second, "peer"))
+	used, err := bob.db.DiskSpaceForUser(alice.PersonalKey.Public)
+	assert.Nil(t, err)
+	assert.Equal(t, used, bob.usage.bytes[alice.PersonalKey.Public])
+
+	// newer envelopes make room for themselves
+	assert.Nil(t, bob.ProcessEnvelope(third, "peer"))
+	assert.False(t, has(first))
+	assert.True(t, has(second))
+	assert.True(t, has(third))
+	used, err = bob.db.DiskSpaceForUser(alice.PersonalKey.Public)
+	assert.Nil(t, err)
+	assert.Equal(t, used, bob.usage.bytes[alice.PersonalKey.Public])
+
+	// envelopes that can never fit are rejected
+	var random []string
+	for i := 0; i < 100; i++ {
+		random = append(random, keypair.New().Public)
+	}
+	large := seal(letter.Letter{Content: strings.Join(random, " ")})
+	err = bob.ProcessEnvelope(large, "peer")
+	assert.NotNil(t, err)
+	limitErr, ok := errors.Cause(err).(StorageLimitError)
+	assert.True(t, ok)
+	assert.Equal(t, alice.PersonalKey.Public, limitErr.Sender)
+	assert.False(t, has(large))
+
+	// actions count too, so that data can not be stored without bound in them, and
+	// make room for themselves like any other envelope
+	follow := seal(letter.Letter{Purpose: purpose.ActionFollow, Content: bob.PersonalKey.Public})
+	assert.Nil(t, bob.ProcessEnvelope(follow, "peer"))
+	assert.False(t, has(second))
+	assert.True(t, has(third))
+	assert.True(t, has(follow))
+	largeAction := seal(letter.Letter{Purpose: purpose.ActionLike, Content: strings.Join(random, " ")})
+	err = bob.ProcessEnvelope(largeAction, "peer")
+	_, ok = errors.Cause(err).(StorageLimitError)
+	assert.True(t, ok)
+	assert.False(t, has(largeAction))
+
+	// purging counts again after envelopes are deleted
+	assert.Nil(t, bob.db.RemoveLetters([]string{second.ID}))
+	bob.resetStorageUsage(alice.PersonalKey.Public)
+	assert.Nil(t, bob.PurgeOverflowingStorage())
+	used, err = bob.db.DiskSpaceForUser(alice.PersonalKey.Public)
+	assert.Nil(t, err)
+	assert.Equal(t, used, bob.usage.bytes[alice.PersonalKey.Public])
+}
@@ -0,0 +1,46 @@
+package feed
+
+import (
+	crypto_rand "crypto/rand"
+	"io/ioutil"
+	"os"
+	"strings"
+
+	"github.com/mr-tron/base58/base58"
+	"github.com/pkg/errors"
+)
+
+// TokenFile returns where the API token is kept, next to the keys.
+func (f *Feed) TokenFile() string {
+	return strings.TrimSuffix(f.locationToKikiSettings, ".json") + ".token"
+}
+
+// APIToken returns the token that local clients send to use the private server. It
+// is made the first time and kept in a file that only you can read.
+func (f *Feed) APIToken() (token string, err error) {
+	b, err := ioutil.ReadFile(f.TokenFile())
+	if err == nil {
+		token = strings.TrimSpace(string(b))
+		if token == "" {
+			err = errors.Errorf("%s is empty", f.TokenFile())
+		}
+		return
+	}
+	if !os.IsNotExist(err) {
+		return
+	}
+
+	b = make([]byte, 32)
+	_, err = crypto_rand.Read(b)
+	if err != nil {
+		return
+	}
+	token = base58.FastBase58Encoding(b)
+	err = ioutil.WriteFile(f.TokenFile(), []byte(token+"\n"), 0600)
+	if err != nil {
+		err = errors.Wrap(err, "writing API token")
+		return
+	}
+	f.logger.Log.Infof("wrote API token to '%s'", f.TokenFile())
+	return
+}
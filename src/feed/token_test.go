@@ -0,0 +1,36 @@
+package feed
+
+import (
+	"io/ioutil"
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAPIToken(t *testing.T) {
+	g := newTestFeed(t, "tokens")
+	token, err := g.APIToken()
+	assert.Nil(t, err)
+	assert.True(t, len(token) > 40)
+
+	// only you can read the token
+	info, err := os.Stat(g.TokenFile())
+	assert.Nil(t, err)
+	assert.Equal(t, os.FileMode(0600), info.Mode().Perm())
+
+	// the token is kept
+	again, err := g.APIToken()
+	assert.Nil(t, err)
+	assert.Equal(t, token, again)
+
+	// a token can be set by writing the file
+	assert.Nil(t, ioutil.WriteFile(g.TokenFile(), []byte("my token\n"), 0600))
+	again, err = g.APIToken()
+	assert.Nil(t, err)
+	assert.Equal(t, "my token", again)
+
+	assert.Nil(t, ioutil.WriteFile(g.TokenFile(), []byte("\n"), 0600))
+	_, err = g.APIToken()
+	assert.NotNil(t, err)
+}
@@ -0,0 +1,270 @@
+package feed
+
+import (
+	"bytes"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/schollz/kiki/src/letter"
+	"github.com/schollz/kiki/src/purpose"
+)
+
+// The events that webhooks fire on. They are only for letters of others.
+const (
+	WebhookMention  = "mention"  // a post or comment that has @ and your name
+	WebhookReply    = "reply"    // a comment on one of your posts
+	WebhookFollower = "follower" // someone followed you
+	WebhookMessage  = "message"  // a post sent only to you and not to the public, friends or a group
+	WebhookHashtag  = "hashtag"  // a post or comment with one of the hashtags of the webhook
+)
+
+// HeaderWebhookSignature has the HMAC-SHA256 of the body of a webhook, signed with its
+// secret, as "sha256=" and the hex of it.
+const HeaderWebhookSignature = "X-Kiki-Signature"
+
+const (
+	// webhookAttempts is how many times a delivery is tried before it is given up
+	webhookAttempts = 5
+	// webhookMaxAge is how old a letter can be to fire webhooks, so that syncing
+	// the letters of months does not fire them all
+	webhookMaxAge = 24 * time.Hour
+	// webhookLogSize is how many deliveries are kept in the log
+	webhookLogSize = 200
+)
+
+var (
+	// webhookBackoff is how long to wait after the first failed attempt, which is
+	// doubled after every attempt
+	webhookBackoff = 2 * time.Second
+	webhookClient  = &http.Client{Timeout: 10 * time.Second}
+)
+
+// Webhook is a URL that is posted to when something happens that concerns you.
+type Webhook struct {
+	URL string `json:"url"`
+	// Secret signs the body, which is sent in the X-Kiki-Signature header
+	Secret string `json:"secret"`
+	// Events are the events that the webhook fires on, or all of them if none are
+	// given: mention, reply, follower, message and hashtag
+	Events []string `json:"events"`
+	// Hashtags are the hashtags, without the #, that fire the hashtag event
+	Hashtags []string `json:"hashtags"`
+}
+
+// WebhookPayload is the JSON that is posted to a webhook.
+type WebhookPayload struct {
+	// ID is the same for every attempt of a delivery
+	ID    string    `json:"id"`
+	Event string    `json:"event"`
+	Time  time.Time `json:"time"`
+	// User is who sent the letter
+	User     string   `json:"user"`
+	Name     string   `json:"name,omitempty"`
+	PostID   string   `json:"post_id,omitempty"`
+	ReplyTo  string   `json:"reply_to,omitempty"`
+	Content  string   `json:"content,omitempty"`
+	Hashtags []string `json:"hashtags,omitempty"`
+}
+
+// WebhookDelivery is how a payload was delivered to a webhook.
+type WebhookDelivery struct {
+	ID        string    `json:"id"`
+	URL       string    `json:"url"`
+	Event     string    `json:"event"`
+	Time      time.Time `json:"time"`
+	Attempts  int       `json:"attempts"`
+	Status    int       `json:"status"`
+	Error     string    `json:"error,omitempty"`
+	Delivered bool      `json:"delivered"`
+}
+
+// fires tells whether the webhook fires on the event.
+func (w Webhook) fires(event string) bool {
+	if len(w.Events) == 0 {
+		return true
+	}
+	for _, e := range w.Events {
+		if e == event {
+			return true
+		}
+	}
+	return false
+}
+
+// webhookEvents returns the events that the letter is, with the hashtags it has.
+func (f *Feed) webhookEvents(e letter.Envelope) (events []string, tags []string) {
+	if e.Sender.Public == f.PersonalKey.Public || isEdit(e) || time.Since(e.Timestamp) > webhookMaxAge {
+		return
+	}
+	switch e.Letter.Purpose {
+	case purpose.ActionFollow:
+		if e.Letter.Content == f.PersonalKey.Public {
+			events = append(events, WebhookFollower)
+		}
+	case purpose.ShareText:
+		if f.repliesToMe(e) {
+			events = append(events, WebhookReply)
+		} else if e.Letter.ReplyTo == "" && containsString(e.Letter.To, f.PersonalKey.Public) && !containsString(e.Letter.To, f.RegionKey.Public) {
+			events = append(events, WebhookMessage)
+		}
+		if f.mentionsMe(e) {
+			events = append(events, WebhookMention)
+		}
+		for _, tag := range hashtagPattern.FindAllString(e.Letter.Content, -1) {
+			tags = append(tags, strings.ToLower(tag[1:]))
+		}
+		if len(tags) > 0 {
+			events = append(events, WebhookHashtag)
+		}
+	}
+	return
+}
+
+// fireWebhooks delivers the letter to the webhooks that fire on its events.
+func (f *Feed) fireWebhooks(e letter.Envelope) {
+	if len(f.Settings.Webhooks) == 0 {
+		return
+	}
+	events, tags := f.webhookEvents(e)
+	for _, event := range events {
+		p := WebhookPayload{
+			Event:   event,
+			Time:    e.Timestamp,
+			User:    e.Sender.Public,
+			Name:    f.db.GetName(e.Sender.Public),
+			PostID:  e.Letter.FirstID,
+			ReplyTo: e.Letter.ReplyTo,
+		}
+		if event == WebhookFollower {
+			p.PostID = ""
+		} else {
+			p.Content = e.Letter.Content
+			p.Hashtags = tags
+		}
+		for i, w := range f.Settings.Webhooks {
+			if !w.fires(event) || (event == WebhookHashtag && !matchesHashtags(w.Hashtags, tags)) {
+				continue
+			}
+			p.ID = fmt.Sprintf("%s-%s-%d", e.ID, event, i)
+			go f.deliverWebhook(w, p)
+		}
+	}
+}
+
+// containsString tells whether x is in s.
+func containsString(s []string, x string) bool {
+	for _, y := range s {
+		if y == x {
+			return true
+		}
+	}
+	return false
+}
+
+// matchesHashtags tells whether one of the tags is one of the hashtags of a webhook.
+func matchesHashtags(hashtags, tags []string) bool {
+	for _, h := range hashtags {
+		if containsString(tags, strings.ToLower(strings.TrimPrefix(h, "#"))) {
+			return true
+		}
+	}
+	return false
+}
+
+// deliverWebhook posts the payload to the webhook, and tries again with backoff
+// until it is answered with 2xx or with another 4xx than 429.
+func (f *Feed) deliverWebhook(w Webhook, p WebhookPayload) {
+	d := WebhookDelivery{ID: p.ID, URL: w.URL, Event: p.Event, Time: time.Now().UTC()}
+	body, err := json.Marshal(p)
+	if err != nil {
+		d.Error = err.Error()
+		f.logWebhookDelivery(d)
+		return
+	}
+	backoff := webhookBackoff
+	for d.Attempts < webhookAttempts {
+		if d.Attempts > 0 {
+			time.Sleep(backoff)
+			backoff *= 2
+		}
+		d.Attempts++
+		d.Status, err = postWebhook(w, body)
+		if err != nil {
+			d.Error = err.Error()
+		} else {
+			d.Error = ""
+		}
+		d.Delivered = err == nil && d.Status/100 == 2
+		if d.Delivered || (err == nil && d.Status/100 == 4 && d.Status != http.StatusTooManyRequests) {
+			break
+		}
+	}
+	if !d.Delivered {
+		f.logger.Log.Warnf("webhook %s for %s was not delivered after %d attempts", d.ID, d.URL, d.Attempts)
+	}
+	f.logWebhookDelivery(d)
+}
+
+// postWebhook posts the body to the webhook, signed with its secret.
+func postWebhook(w Webhook, body []byte) (status int, err error) {
+	req, err := http.NewRequest("POST", w.URL, bytes.NewReader(body))
+	if err != nil {
+		return
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set(HeaderWebhookSignature, SignWebhook(w.Secret, body))
+	resp, err := webhookClient.Do(req)
+	if err != nil {
+		return
+	}
+	resp.Body.Close()
+	return resp.StatusCode, nil
+}
+
+// SignWebhook returns the signature of the body of a webhook, as it is in the
+// X-Kiki-Signature header.
+func SignWebhook(secret string, body []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write(body)
+	return "sha256=" + hex.EncodeToString(mac.Sum(nil))
+}
+
+// logWebhookDelivery adds the delivery to the log, which keeps the latest ones.
+func (f *Feed) logWebhookDelivery(d WebhookDelivery) {
+	f.webhookLog.Lock()
+	defer f.webhookLog.Unlock()
+	ds := []WebhookDelivery{}
+	f.db.Get("webhooks", "deliveries", &ds)
+	ds = append(ds, d)
+	if len(ds) > webhookLogSize {
+		ds = ds[len(ds)-webhookLogSize:]
+	}
+	err := f.db.Set("webhooks", "deliveries", ds)
+	if err != nil {
+		f.logger.Log.Warn(errors.Wrap(err, "logWebhookDelivery"))
+	}
+}
+
+// GetWebhookDeliveries returns the log of the latest deliveries to webhooks, the
+// latest first.
+func (f *Feed) GetWebhookDeliveries() (ds []WebhookDelivery, err error) {
+	f.webhookLog.Lock()
+	defer f.webhookLog.Unlock()
+	ds = []WebhookDelivery{}
+	err = f.db.Get("webhooks", "deliveries", &ds)
+	if err != nil {
+		// nothing was delivered yet
+		return []WebhookDelivery{}, nil
+	}
+	for i, j := 0, len(ds)-1; i < j; i, j = i+1, j-1 {
+		ds[i], ds[j] = ds[j], ds[i]
+	}
+	return
+}
@@ -0,0 +1,116 @@
+package feed
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/schollz/kiki/src/letter"
+	"github.com/schollz/kiki/src/purpose"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestWebhooks(t *testing.T) {
+	webhookBackoff = time.Millisecond
+	defer func() { webhookBackoff = 2 * time.Second }()
+
+	// the hook fails the first attempt of every delivery
+	var mu sync.Mutex
+	received := map[string]WebhookPayload{}
+	attempts := map[string]int{}
+	hook := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := ioutil.ReadAll(r.Body)
+		assert.Equal(t, SignWebhook("secret", body), r.Header.Get(HeaderWebhookSignature))
+		var p WebhookPayload
+		assert.Nil(t, json.Unmarshal(body, &p))
+		mu.Lock()
+		defer mu.Unlock()
+		attempts[p.ID]++
+		if attempts[p.ID] == 1 {
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+		received[p.Event] = p
+	}))
+	defer hook.Close()
+	gone := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusGone)
+	}))
+	defer gone.Close()
+
+	alice, bob := newTestFeed(t, "alice"), newTestFeed(t, "bob")
+	bob.Settings.Webhooks = []Webhook{
+		{URL: hook.URL, Secret: "secret", Hashtags: []string{"#Kiki"}},
+		{URL: gone.URL, Secret: "secret", Events: []string{WebhookFollower}},
+	}
+	_, err := bob.ProcessLetter(letter.Letter{To: []string{"public"}, Purpose: purpose.ActionName, Content: "Bob"})
+	assert.Nil(t, err)
+	assert.Nil(t, bob.UnsealLetters())
+	post, err := bob.ProcessLetter(letter.Letter{To: []string{"public"}, Purpose: purpose.ShareText, Content: "hello #kiki"})
+	assert.Nil(t, err)
+	syncFeeds(t, bob, alice)
+
+	message, err := alice.ProcessLetter(letter.Letter{To: []string{bob.PersonalKey.Public}, Purpose: purpose.ShareText, Content: "just for you"})
+	assert.Nil(t, err)
+	comment, err := alice.ProcessLetter(letter.Letter{To: []string{"public"}, Purpose: purpose.ShareText, Content: "nice", ReplyTo: post.ID})
+	assert.Nil(t, err)
+	_, err = alice.ProcessLetter(letter.Letter{To: []string{"public"}, Purpose: purpose.ShareText, Content: "hi @bob, #kiki is nice"})
+	assert.Nil(t, err)
+	_, err = alice.ProcessLetter(letter.Letter{To: []string{"public"}, Purpose: purpose.ShareText, Content: "#other"})
+	assert.Nil(t, err)
+	_, err = alice.ProcessLetter(letter.Letter{Purpose: purpose.ActionFollow, Content: bob.PersonalKey.Public})
+	assert.Nil(t, err)
+	syncFeeds(t, alice, bob)
+
+	// message, reply, mention, hashtag and follower, and follower for the gone hook
+	var ds []WebhookDelivery
+	for i := 0; i < 100 && len(ds) < 6; i++ {
+		time.Sleep(10 * time.Millisecond)
+		ds, err = bob.GetWebhookDeliveries()
+		assert.Nil(t, err)
+	}
+	assert.Equal(t, 6, len(ds))
+	for _, d := range ds {
+		if d.URL == gone.URL {
+			assert.False(t, d.Delivered)
+			assert.Equal(t, 1, d.Attempts)
+			assert.Equal(t, http.StatusGone, d.Status)
+		} else {
+			assert.True(t, d.Delivered)
+			assert.Equal(t, 2, d.Attempts)
+		}
+	}
+
+	mu.Lock()
+	assert.Equal(t, 5, len(received))
+	assert.Equal(t, message.ID, received[WebhookMessage].PostID)
+	assert.Equal(t, "<p>just for you</p>", received[WebhookMessage].Content)
+	assert.Equal(t, comment.ID, received[WebhookReply].PostID)
+	assert.Equal(t, post.ID, received[WebhookReply].ReplyTo)
+	assert.Equal(t, `<p>hi @bob, <a href="/?hashtag=kiki" class="hashtag">#kiki</a> is nice</p>`, received[WebhookMention].Content)
+	assert.Equal(t, []string{"kiki"}, received[WebhookHashtag].Hashtags)
+	assert.Equal(t, alice.PersonalKey.Public, received[WebhookFollower].User)
+	assert.Empty(t, received[WebhookFollower].PostID)
+	followed := received[WebhookFollower].ID
+	mu.Unlock()
+
+	// following again after unfollowing fires again
+	_, err = alice.ProcessLetter(letter.Letter{Purpose: purpose.ActionUnfollow, Content: bob.PersonalKey.Public})
+	assert.Nil(t, err)
+	_, err = alice.ProcessLetter(letter.Letter{Purpose: purpose.ActionFollow, Content: bob.PersonalKey.Public})
+	assert.Nil(t, err)
+	syncFeeds(t, alice, bob)
+	for i := 0; i < 100 && len(ds) < 8; i++ {
+		time.Sleep(10 * time.Millisecond)
+		ds, err = bob.GetWebhookDeliveries()
+		assert.Nil(t, err)
+	}
+	assert.Equal(t, 8, len(ds))
+	mu.Lock()
+	defer mu.Unlock()
+	assert.NotEqual(t, followed, received[WebhookFollower].ID)
+}
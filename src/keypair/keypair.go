@@ -3,6 +3,8 @@ package keypair
 import (
 	"bytes"
 	crypto_rand "crypto/rand"
+	"crypto/sha256"
+	"crypto/sha512"
 	"encoding/binary"
 	"encoding/json"
 	"fmt"
@@ -10,9 +12,12 @@ import (
 	"io"
 	"strings"
 
+	"filippo.io/edwards25519"
+	"filippo.io/edwards25519/field"
 	"github.com/mr-tron/base58/base58"
 	"github.com/pkg/errors"
 	"github.com/schollz/mnemonicode"
+	"golang.org/x/crypto/ed25519"
 	"golang.org/x/crypto/nacl/box"
 )
 
@@ -154,8 +159,12 @@ func keyStringToBytes(s string) (key *[32]byte, err error) {
 	if err != nil {
 		return
 	}
+	if len(keyBytes) != 32 {
+		err = errors.New("key has wrong size")
+		return
+	}
 	key = new([32]byte)
-	copy(key[:], keyBytes[:32])
+	copy(key[:], keyBytes)
 	return
 }
 
@@ -219,3 +228,149 @@ func (kp KeyPair) Validate(signature string, sender KeyPair) (err error) {
 	}
 	return
 }
+
+// Proof signs the parts (usually a server issued nonce and the request) with the
+// signing key, so that only the owner of the private key can make it and it can
+// not be replayed for anything else. The region key is signed too, so that a
+// proof for one region is not one for another.
+func (kp KeyPair) Proof(regionkey KeyPair, parts ...string) (proof string, err error) {
+	return kp.Sign(proofMessage(kp.Public, append([]string{regionkey.Public}, parts...)))
+}
+
+// ValidateProof checks that the proof was made by the sender for the region of the
+// keypair (usually should be shared region key) and the parts that the proof should
+// cover, against the signing key that belongs to the sender.
+func (kp KeyPair) ValidateProof(proof string, sender KeyPair, parts ...string) (err error) {
+	if proof == "" {
+		return errors.New("no proof to validate")
+	}
+	if sender.Public == "" {
+		return errors.New("no public key to validate")
+	}
+	signingKey, err := sender.SigningKey()
+	if err != nil {
+		return
+	}
+	err = VerifySigned(signingKey, proofMessage(sender.Public, append([]string{kp.Public}, parts...)), proof)
+	if err != nil {
+		return errors.Wrap(err, "proof does not match")
+	}
+	return
+}
+
+// proofMessage length-prefixes each part so that parts can not be shifted into each other.
+func proofMessage(public string, parts []string) []byte {
+	var buf bytes.Buffer
+	for _, part := range append([]string{"kiki-proof", public}, parts...) {
+		var size [binary.MaxVarintLen64]byte
+		buf.Write(size[:binary.PutUvarint(size[:], uint64(len(part)))])
+		buf.WriteString(part)
+	}
+	return buf.Bytes()
+}
+
+// DeriveKey derives a secret key from the private key for the purpose that is
+// named by info, so that the private key itself is never used for anything else.
+func (kp KeyPair) DeriveKey(info string) (key [32]byte, err error) {
+	if kp.private == nil {
+		err = errors.New("no private key to derive from")
+		return
+	}
+	h := sha256.New()
+	h.Write([]byte("kiki-" + info))
+	h.Write(kp.private[:])
+	copy(key[:], h.Sum(nil))
+	return
+}
+
+// SigningKey returns the base58 encoded Ed25519 public key that belongs to the
+// public key, which is the same point on the curve in its Edwards form (see
+// XEdDSA). It only needs the public key, so anyone can tell which signing key
+// belongs to a sender.
+func (kp KeyPair) SigningKey() (signingKey string, err error) {
+	if kp.public == nil {
+		err = errors.New("no public key to derive from")
+		return
+	}
+	u, err := new(field.Element).SetBytes(kp.public[:])
+	if err != nil {
+		return
+	}
+	// y = (u - 1) / (u + 1), with the sign bit of x cleared
+	one := new(field.Element).One()
+	y := new(field.Element).Multiply(
+		new(field.Element).Subtract(u, one),
+		new(field.Element).Invert(new(field.Element).Add(u, one)),
+	)
+	signingKey = base58.FastBase58Encoding(y.Bytes())
+	return
+}
+
+// Sign returns a base58 encoded XEdDSA signature of the message, made with the
+// private key, which can be checked as an Ed25519 signature against the
+// SigningKey. Only the owner of the private key can make it.
+func (kp KeyPair) Sign(msg []byte) (signature string, err error) {
+	if kp.private == nil {
+		err = errors.New("no private key to sign with")
+		return
+	}
+	k, err := new(edwards25519.Scalar).SetBytesWithClamping(kp.private[:])
+	if err != nil {
+		return
+	}
+	// the signing key always has the sign bit cleared, so the private scalar
+	// is negated when the point of the private key has it set
+	a := k
+	A := new(edwards25519.Point).ScalarBaseMult(k).Bytes()
+	if A[31]&0x80 != 0 {
+		a = new(edwards25519.Scalar).Negate(k)
+		A[31] &= 0x7f
+	}
+
+	var random [64]byte
+	if _, err = io.ReadFull(crypto_rand.Reader, random[:]); err != nil {
+		return
+	}
+	h := sha512.New()
+	h.Write([]byte{0xfe})
+	h.Write(bytes.Repeat([]byte{0xff}, 31))
+	h.Write(a.Bytes())
+	h.Write(msg)
+	h.Write(random[:])
+	r, err := new(edwards25519.Scalar).SetUniformBytes(h.Sum(nil))
+	if err != nil {
+		return
+	}
+	R := new(edwards25519.Point).ScalarBaseMult(r).Bytes()
+
+	h.Reset()
+	h.Write(R)
+	h.Write(A)
+	h.Write(msg)
+	c, err := new(edwards25519.Scalar).SetUniformBytes(h.Sum(nil))
+	if err != nil {
+		return
+	}
+	s := new(edwards25519.Scalar).MultiplyAdd(c, a, r)
+	signature = base58.FastBase58Encoding(append(R, s.Bytes()...))
+	return
+}
+
+// VerifySigned checks that the signature of the message was made by the owner of the signing key.
+func VerifySigned(signingKey string, msg []byte, signature string) (err error) {
+	public, err := base58.FastBase58Decoding(signingKey)
+	if err != nil {
+		return errors.Wrap(err, "signing key not base58")
+	}
+	if len(public) != ed25519.PublicKeySize {
+		return errors.New("signing key has wrong size")
+	}
+	sig, err := base58.FastBase58Decoding(signature)
+	if err != nil {
+		return errors.Wrap(err, "signature not base58")
+	}
+	if !ed25519.Verify(ed25519.PublicKey(public), msg, sig) {
+		return errors.New("signature does not match")
+	}
+	return
+}
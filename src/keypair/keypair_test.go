@@ -11,32 +11,33 @@ import (
 	"github.com/stretchr/testify/assert"
 )
 
-// func BenchmarkEncrypt(b *testing.B) {
-// 	bob, _ := New()
-// 	jane, _ := New()
-// 	b.ResetTimer()
-// 	for i := 0; i < b.N; i++ {
-// 		_, err := bob.Encrypt([]byte(`hello, world. this, is 32 bytes!`), jane)
-// 		if err != nil {
-// 			panic(err)
-// 		}
-// 	}
-// }
-// func BenchmarkDecrypt(b *testing.B) {
-// 	bob, _ := New()
-// 	jane, _ := New()
-// 	enc, err := bob.Encrypt([]byte(`hello, world. this, is 32 bytes!`), jane)
-// 	if err != nil {
-// 		panic(err)
-// 	}
-// 	b.ResetTimer()
-// 	for i := 0; i < b.N; i++ {
-// 		_, err := jane.Decrypt(enc, bob)
-// 		if err != nil {
-// 			panic(err)
-// 		}
-// 	}
-// }
+//	func BenchmarkEncrypt(b *testing.B) {
+//		bob, _ := New()
+//		jane, _ := New()
+//		b.ResetTimer()
+//		for i := 0; i < b.N; i++ {
+//			_, err := bob.Encrypt([]byte(`hello, world. this, is 32 bytes!`), jane)
+//			if err != nil {
+//				panic(err)
+//			}
+//		}
+//	}
+//
+//	func BenchmarkDecrypt(b *testing.B) {
+//		bob, _ := New()
+//		jane, _ := New()
+//		enc, err := bob.Encrypt([]byte(`hello, world. this, is 32 bytes!`), jane)
+//		if err != nil {
+//			panic(err)
+//		}
+//		b.ResetTimer()
+//		for i := 0; i < b.N; i++ {
+//			_, err := jane.Decrypt(enc, bob)
+//			if err != nil {
+//				panic(err)
+//			}
+//		}
+//	}
 func TestKeyPairEncryption(t *testing.T) {
 	bob := New()
 	jane := New()
@@ -110,3 +111,91 @@ func TestSigning(t *testing.T) {
 	err = shared.Validate(signature, jane)
 	assert.NotNil(t, err)
 }
+
+func TestProof(t *testing.T) {
+	shared := New()
+	bob := New()
+	jane := New()
+	proof, err := bob.Proof(shared, "nonce", "GET", "/list")
+	assert.Nil(t, err)
+
+	assert.Nil(t, shared.ValidateProof(proof, bob, "nonce", "GET", "/list"))
+	assert.NotNil(t, shared.ValidateProof(proof, jane, "nonce", "GET", "/list"))
+	assert.NotNil(t, shared.ValidateProof(proof, bob, "other nonce", "GET", "/list"))
+	assert.NotNil(t, shared.ValidateProof(proof, bob, "nonce", "GET", "/download"))
+	assert.NotNil(t, shared.ValidateProof(proof, bob, "nonceGET", "", "/list"))
+
+	// the private region key can not make a proof for someone else
+	forged, err := shared.Proof(shared, "nonce", "GET", "/list")
+	assert.Nil(t, err)
+	assert.NotNil(t, shared.ValidateProof(forged, bob, "nonce", "GET", "/list"))
+
+	// nor is a proof for one region one for another
+	assert.NotNil(t, New().ValidateProof(proof, bob, "nonce", "GET", "/list"))
+
+	// a signature is not a proof
+	signature, err := bob.Signature(shared)
+	assert.Nil(t, err)
+	assert.NotNil(t, shared.ValidateProof(signature, bob))
+}
+
+func TestSigningKey(t *testing.T) {
+	bob := New()
+	jane := New()
+	signingKey, err := bob.SigningKey()
+	assert.Nil(t, err)
+	signingKey2, err := bob.SigningKey()
+	assert.Nil(t, err)
+	assert.Equal(t, signingKey, signingKey2)
+
+	signature, err := bob.Sign([]byte("hello, world"))
+	assert.Nil(t, err)
+	assert.Nil(t, VerifySigned(signingKey, []byte("hello, world"), signature))
+	assert.NotNil(t, VerifySigned(signingKey, []byte("hello, world!"), signature))
+
+	janeSigningKey, err := jane.SigningKey()
+	assert.Nil(t, err)
+	assert.NotEqual(t, signingKey, janeSigningKey)
+	assert.NotNil(t, VerifySigned(janeSigningKey, []byte("hello, world"), signature))
+
+	// the signing key belongs to the public key, but signing needs the private key
+	bobPublic, err := FromPublic(bob.Public)
+	assert.Nil(t, err)
+	publicSigningKey, err := bobPublic.SigningKey()
+	assert.Nil(t, err)
+	assert.Equal(t, signingKey, publicSigningKey)
+	_, err = bobPublic.Sign([]byte("hello, world"))
+	assert.NotNil(t, err)
+
+	// works for every key, whichever sign its point has
+	for i := 0; i < 50; i++ {
+		kp := New()
+		signingKey, err := kp.SigningKey()
+		assert.Nil(t, err)
+		signature, err := kp.Sign([]byte("hello, world"))
+		assert.Nil(t, err)
+		assert.Nil(t, VerifySigned(signingKey, []byte("hello, world"), signature))
+	}
+}
+
+func TestFromPublicWrongSize(t *testing.T) {
+	_, err := FromPublic("bogus")
+	assert.NotNil(t, err)
+}
+
+func TestDeriveKey(t *testing.T) {
+	bob := New()
+	key, err := bob.DeriveKey("at-rest")
+	assert.Nil(t, err)
+	key2, err := bob.DeriveKey("at-rest")
+	assert.Nil(t, err)
+	assert.Equal(t, key, key2)
+	other, err := bob.DeriveKey("something else")
+	assert.Nil(t, err)
+	assert.NotEqual(t, key, other)
+
+	bobPublic, err := FromPublic(bob.Public)
+	assert.Nil(t, err)
+	_, err = bobPublic.DeriveKey("at-rest")
+	assert.NotNil(t, err)
+}
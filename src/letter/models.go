@@ -1,8 +1,10 @@
 package letter
 
 import (
+	"bytes"
 	"crypto/sha256"
 	"encoding/base64"
+	"encoding/binary"
 	"encoding/json"
 	"strings"
 	"time"
@@ -56,12 +58,36 @@ type Letter struct {
 
 	// ReplyTo is the ID of the post being responded to
 	ReplyTo string `json:"reply_to,omitempty"`
+
+	// ExpiresIn is the number of seconds after which the letter expires and is
+	// deleted by every carrier, or 0 for letters that do not expire
+	ExpiresIn int64 `json:"expires_in,omitempty"`
 }
 
+// Envelope versions. Envelopes from older carriers do not carry a version and
+// unmarshal to 0, which is treated the same as VersionLegacy.
+const (
+	// VersionLegacy envelopes are only authenticated by the Signature.
+	VersionLegacy = 1
+	// VersionSigned envelopes are also signed with an Ed25519 key of the
+	// sender, over the canonical encoding of every sealed field. The key is
+	// only vouched for by the Signature, which anyone with the private region
+	// key can make, so these are only opened for senders that do not use
+	// VersionXEdDSA yet.
+	VersionSigned = 2
+	// VersionXEdDSA envelopes are signed like VersionSigned envelopes, but with
+	// the signing key that belongs to the public key of the sender (see
+	// keypair.SigningKey), so that only the sender can sign them.
+	VersionXEdDSA = 3
+)
+
 // Envelope is the sealed letter to be transfered among carriers
 type Envelope struct {
 	// Sealed envelope information
 
+	// Version is the format of the envelope (see VersionLegacy, VersionSigned,
+	// VersionXEdDSA)
+	Version int `json:"version,omitempty"`
 	// ID is the hash of the Marshaled Letter + the Public key of Sender
 	ID string `json:"id"`
 	// Timestamp is the time at which the envelope was created
@@ -83,6 +109,22 @@ type Envelope struct {
 	// SealedLetter contains the encryoted and compressed letter, encoded as base64 string
 	SealedLetter string `json:"sealed_letter,omitempty"`
 
+	// SigningKey is the Ed25519 public key of the sender. For VersionSigned
+	// envelopes the Signature encrypts the Sender public key together with
+	// the SigningKey, so that the Sender vouches for it. For VersionXEdDSA
+	// envelopes it must be the signing key that belongs to the Sender.
+	SigningKey string `json:"signing_key,omitempty"`
+	// EnvelopeSignature is the Ed25519 signature of the canonical encoding of
+	// the envelope, which covers the Version, ID, Timestamp, Sender, SigningKey,
+	// Signature, SealedRecipients, SealedLetter and Expires.
+	EnvelopeSignature string `json:"envelope_signature,omitempty"`
+	// Expires is the time after which the envelope is deleted, so that carriers
+	// can drop it without opening it. It is zero for envelopes that do not expire.
+	Expires time.Time `json:"expires,omitempty"`
+	// Stamp is the proof-of-work stamp of the envelope (see Mint), which carriers
+	// can ask for before they take the envelope in.
+	Stamp string `json:"stamp,omitempty"`
+
 	// Unsealed envelope information
 	// When the letter is opened, this variables will be filled. When the
 	// envelope is transfered these variables should be cleared
@@ -121,17 +163,13 @@ func (l Letter) Seal(sender keypair.KeyPair, regionkey keypair.KeyPair) (e Envel
 
 	e = Envelope{}
 
+	e.Version = VersionXEdDSA
 	e.Timestamp = time.Now().UTC()
+	if l.ExpiresIn > 0 {
+		e.Expires = e.Timestamp.Add(time.Duration(l.ExpiresIn) * time.Second)
+	}
 	e.Sender = sender.PublicKey()
-	// Create blockchain ID (hash of any public key + hash of any content + replaces)
-	h := sha256.New()
-	h.Write([]byte(sender.Public))
-	h.Write([]byte(l.Purpose))
-	h.Write([]byte(l.Content))
-	h.Write([]byte(l.FirstID))
-	h.Write([]byte(l.ReplyTo))
-	h.Write([]byte(strings.Join(l.To, ",")))
-	e.ID = base58.FastBase58Encoding(h.Sum(nil))
+	e.ID = l.hashID(sender.Public)
 
 	if l.FirstID == "" {
 		l.FirstID = e.ID
@@ -160,12 +198,22 @@ func (l Letter) Seal(sender keypair.KeyPair, regionkey keypair.KeyPair) (e Envel
 	}
 
 	// sign the letter by encrypting the public key against the region key
+	e.SigningKey, err = sender.SigningKey()
+	if err != nil {
+		return
+	}
 	signatureEncrypted, err := sender.Encrypt([]byte(sender.Public), regionkey)
 	if err != nil {
 		return
 	}
 	e.Signature = base58.FastBase58Encoding(signatureEncrypted)
 
+	// sign everything that is sealed
+	e.EnvelopeSignature, err = sender.Sign(e.canonical())
+	if err != nil {
+		return
+	}
+
 	// Remove the letter information
 	e.Opened = false
 	e.Letter = Letter{}
@@ -173,6 +221,40 @@ func (l Letter) Seal(sender keypair.KeyPair, regionkey keypair.KeyPair) (e Envel
 	return
 }
 
+// Expired tells whether the envelope has an expiry that has passed.
+func (e Envelope) Expired() bool {
+	return !e.Expires.IsZero() && time.Now().After(e.Expires)
+}
+
+// hashID creates the blockchain ID of the letter (hash of any public key + hash of any content + replaces).
+// The To of the letter must already start with the sender.
+func (l Letter) hashID(sender string) string {
+	h := sha256.New()
+	h.Write([]byte(sender))
+	h.Write([]byte(l.Purpose))
+	h.Write([]byte(l.Content))
+	h.Write([]byte(l.FirstID))
+	h.Write([]byte(l.ReplyTo))
+	h.Write([]byte(strings.Join(l.To, ",")))
+	return base58.FastBase58Encoding(h.Sum(nil))
+}
+
+// VerifyID checks that the ID of an opened envelope is the hash of the letter inside it.
+func (e Envelope) VerifyID() (err error) {
+	if !e.Opened {
+		return errors.New("envelope is not opened")
+	}
+	l := e.Letter
+	// the first letter of a chain has its own ID filled in after hashing
+	if l.FirstID == e.ID {
+		l.FirstID = ""
+	}
+	if l.hashID(e.Sender.Public) != e.ID {
+		return errors.New("ID does not match the letter")
+	}
+	return
+}
+
 // Unseal will determine the content of the letter using the identities provided
 func (e Envelope) Unseal(keysToTry []keypair.KeyPair, regionKey keypair.KeyPair) (Envelope, error) {
 	e2, err := e.unseal(keysToTry, regionKey)
@@ -234,6 +316,9 @@ func (e2 Envelope) unseal(keysToTry []keypair.KeyPair, regionKey keypair.KeyPair
 	return
 }
 
+// Validate checks that the envelope was sent by the Sender in the region. For
+// VersionSigned and VersionXEdDSA envelopes it also checks the signature over all
+// the sealed fields, so that carriers can not alter any of them.
 func (e Envelope) Validate(regionKey keypair.KeyPair) (err error) {
 	if e.Sender.Public == regionKey.Public {
 		return errors.New("region cannot be sender")
@@ -246,8 +331,70 @@ func (e Envelope) Validate(regionKey keypair.KeyPair) (err error) {
 	if err != nil {
 		return
 	}
-	if string(decryptedPublicKey) != e.Sender.Public {
-		return errors.New("signature corrupted")
+	switch e.Version {
+	case 0, VersionLegacy:
+		if string(decryptedPublicKey) != e.Sender.Public {
+			return errors.New("signature corrupted")
+		}
+	case VersionSigned:
+		if string(decryptedPublicKey) != e.Sender.Public+e.SigningKey {
+			return errors.New("signature corrupted")
+		}
+		err = keypair.VerifySigned(e.SigningKey, e.canonical(), e.EnvelopeSignature)
+		if err != nil {
+			return errors.Wrap(err, "envelope signature")
+		}
+	case VersionXEdDSA:
+		if string(decryptedPublicKey) != e.Sender.Public {
+			return errors.New("signature corrupted")
+		}
+		signingKey, errKey := e.Sender.SigningKey()
+		if errKey != nil {
+			return errKey
+		}
+		if e.SigningKey != signingKey {
+			return errors.New("signing key does not belong to the sender")
+		}
+		err = keypair.VerifySigned(e.SigningKey, e.canonical(), e.EnvelopeSignature)
+		if err != nil {
+			return errors.Wrap(err, "envelope signature")
+		}
+	default:
+		return errors.Errorf("unknown envelope version %d", e.Version)
 	}
 	return
 }
+
+// canonical returns the encoding of the envelope that is signed. Each field is
+// prefixed by its length so that no two envelopes share an encoding.
+func (e Envelope) canonical() []byte {
+	var buf bytes.Buffer
+	writeField := func(b []byte) {
+		var size [binary.MaxVarintLen64]byte
+		buf.Write(size[:binary.PutUvarint(size[:], uint64(len(b)))])
+		buf.Write(b)
+	}
+	var number [8]byte
+	writeField([]byte("kiki-envelope"))
+	binary.BigEndian.PutUint64(number[:], uint64(e.Version))
+	writeField(number[:])
+	writeField([]byte(e.ID))
+	binary.BigEndian.PutUint64(number[:], uint64(e.Timestamp.UnixNano()))
+	writeField(number[:])
+	writeField([]byte(e.Sender.Public))
+	writeField([]byte(e.SigningKey))
+	writeField([]byte(e.Signature))
+	binary.BigEndian.PutUint64(number[:], uint64(len(e.SealedRecipients)))
+	writeField(number[:])
+	for _, recipient := range e.SealedRecipients {
+		writeField([]byte(recipient))
+	}
+	writeField([]byte(e.SealedLetter))
+	// the expiry is only added when there is one, so that envelopes that do not
+	// expire are signed the same as before there were expiries
+	if !e.Expires.IsZero() {
+		binary.BigEndian.PutUint64(number[:], uint64(e.Expires.UnixNano()))
+		writeField(number[:])
+	}
+	return buf.Bytes()
+}
@@ -4,7 +4,9 @@ import (
 	"encoding/json"
 	"io/ioutil"
 	"testing"
+	"time"
 
+	"github.com/mr-tron/base58/base58"
 	"github.com/schollz/kiki/src/keypair"
 	"github.com/schollz/kiki/src/purpose"
 	"github.com/stretchr/testify/assert"
@@ -56,3 +58,133 @@ func TestNew(t *testing.T) {
 	assert.NotNil(t, err)
 
 }
+
+func TestEnvelopeSignature(t *testing.T) {
+	zack := keypair.New()
+	bob := keypair.New()
+	regionKey := keypair.New()
+
+	l := Letter{
+		To:      []string{bob.Public},
+		Purpose: purpose.ShareText,
+		Content: "hello, bob",
+	}
+	e, err := l.Seal(zack, regionKey)
+	assert.Nil(t, err)
+	assert.Equal(t, VersionXEdDSA, e.Version)
+	assert.Nil(t, e.Validate(regionKey))
+
+	// survives transfer
+	eBytes, err := json.Marshal(e)
+	assert.Nil(t, err)
+	var transfered Envelope
+	assert.Nil(t, json.Unmarshal(eBytes, &transfered))
+	assert.Nil(t, transfered.Validate(regionKey))
+
+	// changing any sealed field invalidates the envelope
+	tampered := e
+	tampered.Timestamp = e.Timestamp.Add(1 * time.Hour)
+	assert.NotNil(t, tampered.Validate(regionKey))
+
+	tampered = e
+	tampered.ID = "abc"
+	assert.NotNil(t, tampered.Validate(regionKey))
+
+	other, err := Letter{To: []string{bob.Public}, Purpose: purpose.ShareText, Content: "hello again"}.Seal(zack, regionKey)
+	assert.Nil(t, err)
+	tampered = e
+	tampered.SealedLetter = other.SealedLetter
+	assert.NotNil(t, tampered.Validate(regionKey))
+
+	tampered = e
+	tampered.SealedRecipients = e.SealedRecipients[:1]
+	assert.NotNil(t, tampered.Validate(regionKey))
+
+	// can not swap in a different signing key
+	tampered = e
+	tampered.SigningKey, _ = bob.SigningKey()
+	tampered.EnvelopeSignature, _ = bob.Sign(tampered.canonical())
+	assert.NotNil(t, tampered.Validate(regionKey))
+
+	// not even with the private region key, which can make the Signature of anyone
+	forged := e
+	forgedSignature, err := regionKey.Encrypt([]byte(zack.Public), zack)
+	assert.Nil(t, err)
+	forged.Signature = base58.FastBase58Encoding(forgedSignature)
+	forged.SigningKey, _ = regionKey.SigningKey()
+	forged.EnvelopeSignature, _ = regionKey.Sign(forged.canonical())
+	assert.NotNil(t, forged.Validate(regionKey))
+	forged.SigningKey = e.SigningKey
+	forged.EnvelopeSignature, _ = regionKey.Sign(forged.canonical())
+	assert.NotNil(t, forged.Validate(regionKey))
+
+	// legacy envelopes are still accepted
+	signature, err := zack.Signature(regionKey)
+	assert.Nil(t, err)
+	legacy := e
+	legacy.Version = 0
+	legacy.SigningKey = ""
+	legacy.EnvelopeSignature = ""
+	legacy.Signature = signature
+	assert.Nil(t, legacy.Validate(regionKey))
+	_, err = legacy.Unseal([]keypair.KeyPair{bob}, regionKey)
+	assert.Nil(t, err)
+}
+
+func TestVerifyID(t *testing.T) {
+	zack := keypair.New()
+	bob := keypair.New()
+	regionKey := keypair.New()
+
+	e, err := Letter{To: []string{bob.Public}, Purpose: purpose.ShareText, Content: "hello, bob"}.Seal(zack, regionKey)
+	assert.Nil(t, err)
+	assert.NotNil(t, e.VerifyID())
+	ue, err := e.Unseal([]keypair.KeyPair{bob}, regionKey)
+	assert.Nil(t, err)
+	assert.Nil(t, ue.VerifyID())
+
+	// edits keep their first ID
+	edit, err := Letter{To: []string{bob.Public}, Purpose: purpose.ShareText, Content: "hello, bob!", FirstID: e.ID}.Seal(zack, regionKey)
+	assert.Nil(t, err)
+	ue, err = edit.Unseal([]keypair.KeyPair{bob}, regionKey)
+	assert.Nil(t, err)
+	assert.Equal(t, e.ID, ue.Letter.FirstID)
+	assert.Nil(t, ue.VerifyID())
+
+	// claiming the ID of another envelope
+	ue.ID = e.ID
+	assert.NotNil(t, ue.VerifyID())
+}
+
+func TestExpires(t *testing.T) {
+	zack := keypair.New()
+	bob := keypair.New()
+	regionKey := keypair.New()
+
+	e, err := Letter{To: []string{bob.Public}, Purpose: purpose.ShareText, Content: "hello, bob"}.Seal(zack, regionKey)
+	assert.Nil(t, err)
+	assert.True(t, e.Expires.IsZero())
+	assert.False(t, e.Expired())
+
+	e, err = Letter{To: []string{bob.Public}, Purpose: purpose.ShareText, Content: "gone soon", ExpiresIn: 60}.Seal(zack, regionKey)
+	assert.Nil(t, err)
+	assert.Equal(t, e.Timestamp.Add(60*time.Second), e.Expires)
+	assert.False(t, e.Expired())
+	assert.Nil(t, e.Validate(regionKey))
+
+	// the expiry survives transfer and is signed, so carriers can not change it
+	eBytes, err := json.Marshal(e)
+	assert.Nil(t, err)
+	var transfered Envelope
+	assert.Nil(t, json.Unmarshal(eBytes, &transfered))
+	assert.Nil(t, transfered.Validate(regionKey))
+	tampered := e
+	tampered.Expires = e.Expires.Add(1 * time.Hour)
+	assert.NotNil(t, tampered.Validate(regionKey))
+	tampered.Expires = time.Time{}
+	assert.NotNil(t, tampered.Validate(regionKey))
+
+	tampered = e
+	tampered.Expires = time.Now().Add(-1 * time.Second)
+	assert.True(t, tampered.Expired())
+}
@@ -0,0 +1,57 @@
+package letter
+
+import (
+	"crypto/sha256"
+	"math/bits"
+	"strconv"
+
+	"github.com/pkg/errors"
+)
+
+// MaxStampDifficulty is the most leading zero bits that a stamp can be asked for.
+const MaxStampDifficulty = 64
+
+// Mint adds a hashcash-style proof-of-work stamp to a sealed envelope, so that
+// the hash of the envelope and the stamp starts with at least difficulty zero bits.
+// Each added bit doubles the work to make an envelope, and verifying the stamp
+// takes one hash.
+//
+// The stamp is over the same encoding that is signed, so it can not be moved to
+// another envelope. It is not signed itself, since it is made after signing.
+func (e *Envelope) Mint(difficulty int) (err error) {
+	if difficulty < 0 || difficulty > MaxStampDifficulty {
+		return errors.Errorf("stamp difficulty must be between 0 and %d", MaxStampDifficulty)
+	}
+	e.Stamp = ""
+	if difficulty == 0 {
+		return
+	}
+	digest := sha256.Sum256(e.canonical())
+	for counter := uint64(0); ; counter++ {
+		stamp := strconv.FormatUint(counter, 36)
+		if stampBits(digest, stamp) >= difficulty {
+			e.Stamp = stamp
+			return
+		}
+	}
+}
+
+// StampBits returns the number of leading zero bits of the stamp of the envelope,
+// which is 0 for envelopes that have no stamp.
+func (e Envelope) StampBits() int {
+	if e.Stamp == "" {
+		return 0
+	}
+	return stampBits(sha256.Sum256(e.canonical()), e.Stamp)
+}
+
+func stampBits(digest [32]byte, stamp string) (zeros int) {
+	h := sha256.Sum256(append(digest[:], stamp...))
+	for _, b := range h {
+		zeros += bits.LeadingZeros8(b)
+		if b != 0 {
+			break
+		}
+	}
+	return
+}
@@ -0,0 +1,33 @@
+package letter
+
+import (
+	"testing"
+
+	"github.com/schollz/kiki/src/keypair"
+	"github.com/schollz/kiki/src/purpose"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestStamp(t *testing.T) {
+	zack := keypair.New()
+	regionKey := keypair.New()
+	e, err := Letter{To: []string{regionKey.Public}, Purpose: purpose.ShareText, Content: "hello, everyone"}.Seal(zack, regionKey)
+	assert.Nil(t, err)
+	assert.Equal(t, 0, e.StampBits())
+
+	assert.Nil(t, e.Mint(16))
+	assert.NotEmpty(t, e.Stamp)
+	assert.True(t, e.StampBits() >= 16)
+	assert.Nil(t, e.Validate(regionKey))
+
+	// the stamp belongs to the envelope that it was made for
+	other, err := Letter{To: []string{regionKey.Public}, Purpose: purpose.ShareText, Content: "hello again"}.Seal(zack, regionKey)
+	assert.Nil(t, err)
+	other.Stamp = e.Stamp
+	assert.True(t, other.StampBits() < 16)
+
+	assert.Nil(t, e.Mint(0))
+	assert.Empty(t, e.Stamp)
+	assert.NotNil(t, e.Mint(-1))
+	assert.NotNil(t, e.Mint(MaxStampDifficulty+1))
+}
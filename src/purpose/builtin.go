@@ -0,0 +1,173 @@
+package purpose
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"html/template"
+	"regexp"
+	"strings"
+
+	"github.com/microcosm-cc/bluemonday"
+	"github.com/pkg/errors"
+	strip "github.com/schollz/html-strip-tags-go"
+	"github.com/schollz/kiki/src/keypair"
+	"github.com/schollz/kiki/src/web"
+	blackfriday "gopkg.in/russross/blackfriday.v2"
+)
+
+func init() {
+	for _, p := range []Purpose{
+		{Name: ShareText, Storage: StoragePost, Transform: shareText, Render: sanitizedHTML},
+		{Name: SharePNG, Storage: StoragePost, Validate: isBase64},
+		{Name: ShareJPG, Storage: StoragePost, Validate: isBase64},
+		{Name: ShareKey, Validate: isKeyPair},
+		{Name: ActionFollow, Action: true, Validate: isPublicKeyOrEmpty},
+		{Name: ActionUnfollow, Action: true, Validate: isPublicKey},
+		{Name: ActionLike, Action: true, Validate: isNotEmpty},
+		{Name: ActionUnlike, Action: true, Validate: isNotEmpty},
+		{Name: ActionName, Action: true, Storage: StorageLatest, Transform: stripTags},
+		{Name: ActionProfile, Action: true, Storage: StorageLatest, Transform: profile, Render: sanitizedHTML},
+		{Name: ActionImage, Action: true, Storage: StorageLatest, Transform: profileImage},
+		{Name: ActionBlock, Action: true, Validate: isPublicKeyOrEmpty},
+		{Name: ActionUnblock, Action: true, Validate: isPublicKey},
+		{Name: ActionErase, Action: true},
+	} {
+		if err := Register(p); err != nil {
+			panic(err)
+		}
+	}
+}
+
+func isBase64(content string) (err error) {
+	_, err = base64.StdEncoding.DecodeString(content)
+	return
+}
+
+func isKeyPair(content string) (err error) {
+	var kp keypair.KeyPair
+	err = json.Unmarshal([]byte(content), &kp)
+	if err == nil && kp.Public == "" {
+		err = errors.New("keypair has no public key")
+	}
+	return
+}
+
+func isPublicKey(content string) (err error) {
+	_, err = keypair.FromPublic(content)
+	return
+}
+
+func isPublicKeyOrEmpty(content string) (err error) {
+	if content == "" {
+		return
+	}
+	return isPublicKey(content)
+}
+
+func isNotEmpty(content string) (err error) {
+	if content == "" {
+		err = errors.New("content is empty")
+	}
+	return
+}
+
+// sanitizedHTML renders content as HTML once it is sanitized again, since only the
+// node of the sender sanitizes it before it is sent, and any peer can seal a letter.
+func sanitizedHTML(content string) template.HTML {
+	return template.HTML(sanitize(content))
+}
+
+func stripTags(content string, images ImageSplitter) (string, error) {
+	return strip.StripTags(strings.TrimSpace(content)), nil
+}
+
+func shareText(content string, images ImageSplitter) (html string, err error) {
+	html, _, err = markdown(content, images)
+	if err != nil {
+		return
+	}
+	return linkHashtags(sanitize(html)), nil
+}
+
+func profile(content string, images ImageSplitter) (html string, err error) {
+	html, _, err = markdown(content, images)
+	if err != nil {
+		return
+	}
+	return sanitize(html), nil
+}
+
+// profileImage sends the image in the content and refers to it by its ID. If there
+// is no image, the content is kept as it is.
+func profileImage(content string, images ImageSplitter) (string, error) {
+	_, ids, err := markdown(content, images)
+	if err != nil || len(ids) == 0 {
+		return content, err
+	}
+	return ids[0], nil
+}
+
+// markdown converts the markdown to HTML, and splits the images in it out into
+// letters of their own which it refers to by their IDs.
+func markdown(content string, images ImageSplitter) (html string, ids []string, err error) {
+	html = string(blackfriday.Run([]byte(content)))
+	for _, capture := range []func(string) (string, map[string][]byte, error){web.CaptureBase64Images, web.CaptureBase64ImagesFromMarkdown} {
+		newHTML, captured, err2 := capture(html)
+		if err2 != nil {
+			err = err2
+			return
+		}
+		if len(captured) == 0 {
+			continue
+		}
+		for name, data := range captured {
+			p := SharePNG
+			if strings.Contains(name, ".jpg") {
+				p = ShareJPG
+			}
+			id, err2 := images(p, data)
+			if err2 != nil {
+				err = err2
+				return
+			}
+			ids = append(ids, id)
+			newHTML = strings.Replace(newHTML, name, id, 1)
+		}
+		html = newHTML
+	}
+	return
+}
+
+// policy is what HTML is kept in posts and profiles.
+var policy = func() *bluemonday.Policy {
+	p := bluemonday.UGCPolicy()
+	p.AllowRelativeURLs(true)
+	p.AddTargetBlankToFullyQualifiedLinks(true)
+	p.AllowElements("i")
+	p.AllowAttrs("class").OnElements("i")
+	p.AllowElements("img")
+	p.AllowAttrs("class").OnElements("img")
+	p.AllowElements("div")
+	p.AllowAttrs("class").OnElements("div")
+	// the links of hashtags
+	p.AllowAttrs("class").Matching(regexp.MustCompile(`^hashtag$`)).OnElements("a")
+	return p
+}()
+
+func sanitize(html string) string {
+	return policy.Sanitize(html)
+}
+
+// linkHashtags replaces hashtags with links to the posts with the hashtag.
+func linkHashtags(html string) string {
+	r := regexp.MustCompile(`(\#[a-z-A-Z]+\b)`)
+	tags := make(map[string]struct{})
+	for _, tag := range r.FindAllString(html, -1) {
+		tags[tag] = struct{}{}
+	}
+	for tag := range tags {
+		html = strings.Replace(html, tag, fmt.Sprintf(`<a href="/?hashtag=%s" class="hashtag">%s</a>`, tag[1:], tag), -1)
+	}
+	return html
+}
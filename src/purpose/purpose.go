@@ -1,5 +1,16 @@
 package purpose
 
+import (
+	"html/template"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/pkg/errors"
+)
+
+const actionPrefix = "action-"
+
 // There are the available purposes for a letter
 var (
 	// Share text will share a text post
@@ -24,10 +35,18 @@ var (
 	// Content: Public key of the person being followed
 	ActionFollow = "action-follow"
 
+	// ActionUnfollow will stop following someone
+	// Content: Public key of the person being unfollowed
+	ActionUnfollow = "action-unfollow"
+
 	// ActionLike will give a person a like
 	// Content: ID of the post being liked
 	ActionLike = "action-like"
 
+	// ActionUnlike will take back a like
+	// Content: ID of the post that was liked
+	ActionUnlike = "action-unlike"
+
 	// ActionName will assign a person a name
 	// Content: Text of name
 	ActionName = "action-assign/name"
@@ -44,16 +63,134 @@ var (
 	// Content: Public key of the person to block
 	ActionBlock = "action-block"
 
+	// ActionUnblock will stop blocking a person
+	// Content: Public key of the person to unblock
+	ActionUnblock = "action-unblock"
+
 	// ActionErase will erase a persons profile from every carrier
 	// Content: Empty
 	ActionErase = "action-erase"
 )
 
+// Storage is how letters of a purpose are treated when a person uses more than their
+// share of storage.
+type Storage int
+
+const (
+	// StorageKeep letters are never removed to free storage
+	StorageKeep Storage = iota
+	// StoragePost letters are removed oldest first
+	StoragePost
+	// StorageLatest letters are replaced by newer ones, so only the latest is kept
+	StorageLatest
+)
+
+// ImageSplitter keeps an image that was in the content of a letter in a letter of
+// its own with the image purpose, and returns the ID of that letter.
+type ImageSplitter func(purpose string, data []byte) (id string, err error)
+
+// Purpose declares what letters with the purpose are and how they are handled.
+type Purpose struct {
+	Name string
+	// Action purposes are always public. Their names start with "action-".
+	Action bool
+	// Storage is how the letters are purged
+	Storage Storage
+	// Validate checks the content of letters that are sent and letters that are
+	// opened, if it is set
+	Validate func(content string) error
+	// Transform changes the content of a letter before it is sealed, if it is set
+	Transform func(content string, images ImageSplitter) (string, error)
+	// Render shows the content as HTML. The content is escaped if it is not set.
+	Render func(content string) template.HTML
+}
+
+var registry = struct {
+	purposes map[string]Purpose
+	sync.RWMutex
+}{purposes: make(map[string]Purpose)}
+
+// Register adds a purpose, so that letters can be sent with it.
+func Register(p Purpose) (err error) {
+	if p.Name == "" {
+		return errors.New("purpose has no name")
+	}
+	if p.Action != strings.HasPrefix(p.Name, actionPrefix) {
+		return errors.Errorf("purpose '%s' must be an action if and only if it starts with '%s'", p.Name, actionPrefix)
+	}
+	registry.Lock()
+	defer registry.Unlock()
+	if _, ok := registry.purposes[p.Name]; ok {
+		return errors.Errorf("purpose '%s' is already registered", p.Name)
+	}
+	registry.purposes[p.Name] = p
+	return
+}
+
+// Get returns the registered purpose.
+func Get(name string) (p Purpose, ok bool) {
+	registry.RLock()
+	defer registry.RUnlock()
+	p, ok = registry.purposes[name]
+	return
+}
+
+// Valid tells whether the purpose is registered.
 func Valid(purpose string) bool {
-	for _, p := range []string{ShareJPG, SharePNG, ShareText, ShareKey, ActionFollow, ActionName, ActionBlock, ActionProfile, ActionLike, ActionImage, ActionErase} {
-		if purpose == p {
-			return true
+	_, ok := Get(purpose)
+	return ok
+}
+
+// IsAction tells whether letters with the purpose are actions, which are public.
+// Purposes that are not registered here, but might be elsewhere, are actions if
+// their name says so.
+func IsAction(purpose string) bool {
+	return strings.HasPrefix(purpose, actionPrefix)
+}
+
+// WithStorage returns the names of the registered purposes that are purged the way
+// the storage says, in order.
+func WithStorage(storage Storage) (names []string) {
+	registry.RLock()
+	defer registry.RUnlock()
+	names = []string{}
+	for name, p := range registry.purposes {
+		if p.Storage == storage {
+			names = append(names, name)
 		}
 	}
-	return false
+	sort.Strings(names)
+	return
+}
+
+// Validate checks the content of a letter with the purpose. Purposes that are not
+// registered can not be checked, so any content is valid.
+func Validate(purpose, content string) (err error) {
+	p, ok := Get(purpose)
+	if !ok || p.Validate == nil {
+		return
+	}
+	err = p.Validate(content)
+	if err != nil {
+		err = errors.Wrap(err, purpose)
+	}
+	return
+}
+
+// Transform prepares the content of a letter with the purpose to be sealed.
+func Transform(purpose, content string, images ImageSplitter) (string, error) {
+	p, ok := Get(purpose)
+	if !ok || p.Transform == nil {
+		return content, nil
+	}
+	return p.Transform(content, images)
+}
+
+// Render shows the content of a letter with the purpose as HTML.
+func Render(purpose, content string) template.HTML {
+	p, ok := Get(purpose)
+	if !ok || p.Render == nil {
+		return template.HTML(template.HTMLEscapeString(content))
+	}
+	return p.Render(content)
 }
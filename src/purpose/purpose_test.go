@@ -0,0 +1,70 @@
+package purpose
+
+import (
+	"html/template"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRegister(t *testing.T) {
+	assert.True(t, Valid(ShareText))
+	assert.False(t, Valid("share-poll"))
+	assert.NotNil(t, Register(Purpose{Name: ShareText}))
+	assert.NotNil(t, Register(Purpose{Name: "share-poll", Action: true}))
+	assert.NotNil(t, Register(Purpose{Name: "action-vote"}))
+
+	assert.Nil(t, Register(Purpose{
+		Name:    "share-poll",
+		Storage: StoragePost,
+		Validate: func(content string) error {
+			return isNotEmpty(content)
+		},
+		Transform: func(content string, images ImageSplitter) (string, error) {
+			return strings.ToUpper(content), nil
+		},
+	}))
+	assert.True(t, Valid("share-poll"))
+	assert.Contains(t, WithStorage(StoragePost), "share-poll")
+	assert.NotNil(t, Validate("share-poll", ""))
+	content, err := Transform("share-poll", "yes or no?", nil)
+	assert.Nil(t, err)
+	assert.Equal(t, "YES OR NO?", content)
+	assert.Equal(t, template.HTML("a &lt;b&gt;"), Render("share-poll", "a <b>"))
+}
+
+func TestBuiltin(t *testing.T) {
+	assert.Equal(t, []string{ActionImage, ActionName, ActionProfile}, WithStorage(StorageLatest))
+	assert.True(t, IsAction(ActionFollow))
+	assert.False(t, IsAction(ShareKey))
+	assert.NotNil(t, Validate(ActionFollow, "not a key"))
+	assert.Nil(t, Validate(ActionFollow, "4NfD9kWESGycUdbhbrFygNDjFun6NPk6utpkviyE1Ai6"))
+	assert.NotNil(t, Validate(ShareKey, "{}"))
+
+	content, err := Transform(ShareText, "**hi** #kiki <script>alert(1)</script>", nil)
+	assert.Nil(t, err)
+	assert.Contains(t, content, "<strong>hi</strong>")
+	assert.Contains(t, content, `<a href="/?hashtag=kiki" class="hashtag">#kiki</a>`)
+	assert.NotContains(t, content, "script")
+	// letters from others are sanitized again when they are shown
+	rendered := string(Render(ShareText, content))
+	assert.Contains(t, rendered, "<strong>hi</strong>")
+	assert.Contains(t, rendered, `class="hashtag"`)
+	assert.NotContains(t, Render(ShareText, "<p>hi</p><script>alert(1)</script>"), "script")
+	assert.NotContains(t, Render(ActionProfile, `<img src="x" onerror="alert(1)">`), "onerror")
+
+	content, err = Transform(ActionName, " <b>zack</b> ", nil)
+	assert.Nil(t, err)
+	assert.Equal(t, "zack", content)
+
+	// the image of a profile image is sent in its own letter
+	var split []string
+	content, err = Transform(ActionImage, "![image](data:image/png;base64,iVBORw0KGgoAAAANSUhEUgAAAAEAAAABCAYAAAAfFcSJAAAADUlEQVR42mNk+M9QDwADhgGAWjR9awAAAABJRU5ErkJggg==)", func(p string, data []byte) (string, error) {
+		split = append(split, p)
+		return "id", nil
+	})
+	assert.Nil(t, err)
+	assert.Equal(t, "id", content)
+	assert.Equal(t, []string{SharePNG}, split)
+}
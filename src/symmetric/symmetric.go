@@ -7,6 +7,7 @@ import (
 
 	"github.com/schollz/kiki/src/utils"
 	"golang.org/x/crypto/nacl/secretbox"
+	"golang.org/x/crypto/scrypt"
 )
 
 func CompressAndEncryptWithRandomSecret(msg []byte) (encrypted []byte, secretKey [32]byte, err error) {
@@ -17,7 +18,12 @@ func EncryptWithRandomSecret(msg []byte) (encrypted []byte, secretKey [32]byte,
 	if _, err = io.ReadFull(crypto_rand.Reader, secretKey[:]); err != nil {
 		return
 	}
+	encrypted, err = Encrypt(msg, secretKey)
+	return
+}
 
+// Encrypt encrypts the message with the secret key and a random nonce.
+func Encrypt(msg []byte, secretKey [32]byte) (encrypted []byte, err error) {
 	// You must use a different nonce for each message you encrypt with the
 	// same key. Since the nonce here is 192 bits long, a random value
 	// provides a sufficiently small probability of repeats.
@@ -36,6 +42,10 @@ func Decrypt(encrypted []byte, secretKey [32]byte) (decrypted []byte, err error)
 	// encrypt the message. One way to achieve this is to store the nonce
 	// alongside the encrypted message. Above, we stored the nonce in the first
 	// 24 bytes of the encrypted text.
+	if len(encrypted) < 24 {
+		err = errors.New("decryption failed")
+		return
+	}
 	var decryptNonce [24]byte
 	copy(decryptNonce[:], encrypted[:24])
 	decrypted, ok := secretbox.Open(nil, encrypted[24:], &decryptNonce, &secretKey)
@@ -45,6 +55,17 @@ func Decrypt(encrypted []byte, secretKey [32]byte) (decrypted []byte, err error)
 	return
 }
 
+// KeyFromPassphrase derives a secret key from a passphrase. The salt should be
+// different for every user of the passphrase.
+func KeyFromPassphrase(passphrase string, salt []byte) (secretKey [32]byte, err error) {
+	key, err := scrypt.Key([]byte(passphrase), salt, 32768, 8, 1, 32)
+	if err != nil {
+		return
+	}
+	copy(secretKey[:], key)
+	return
+}
+
 func DecryptAndDecompress(encrypted []byte, secretKey [32]byte) (decrypted []byte, err error) {
 	decrypted, err = Decrypt(encrypted, secretKey)
 	decrypted = utils.DecompressByte(decrypted)
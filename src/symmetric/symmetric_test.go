@@ -63,3 +63,20 @@ func TestCompress(t *testing.T) {
 	assert.Nil(t, err)
 	assert.Equal(t, b, dec)
 }
+
+func TestPassphrase(t *testing.T) {
+	key, err := KeyFromPassphrase("correct horse", []byte("salt"))
+	assert.Nil(t, err)
+	same, _ := KeyFromPassphrase("correct horse", []byte("salt"))
+	assert.Equal(t, key, same)
+	salted, _ := KeyFromPassphrase("correct horse", []byte("pepper"))
+	assert.NotEqual(t, key, salted)
+	wrong, _ := KeyFromPassphrase("wrong horse", []byte("salt"))
+
+	enc, err := Encrypt([]byte("hello world"), key)
+	assert.Nil(t, err)
+	_, err = Decrypt(enc, wrong)
+	assert.NotNil(t, err)
+	_, err = Decrypt(enc[:10], key)
+	assert.NotNil(t, err)
+}
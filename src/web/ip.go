@@ -6,6 +6,7 @@ import (
 	"net"
 	"net/http"
 	"net/url"
+	"strings"
 
 	"github.com/schollz/kiki/src/logging"
 )
@@ -14,6 +15,44 @@ var (
 	log = logging.Log
 )
 
+// PeerIP returns the address of the peer that made the request. The X-Forwarded-For
+// header is only believed when the request comes from one of the trusted proxies,
+// given as addresses or CIDR ranges, since anyone else can put anything in it.
+func PeerIP(req *http.Request, trustedProxies []string) string {
+	ip, err := getClientIPByRequestRemoteAddr(req)
+	if err != nil {
+		return req.RemoteAddr
+	}
+	if !isTrustedProxy(ip, trustedProxies) {
+		return ip
+	}
+	// each proxy adds the address that it got the request from, so the first one from
+	// the right that is not a trusted proxy is the peer
+	hops := strings.Split(req.Header.Get("X-Forwarded-For"), ",")
+	for i := len(hops) - 1; i >= 0; i-- {
+		hop := strings.TrimSpace(hops[i])
+		if hop != "" && !isTrustedProxy(hop, trustedProxies) {
+			return hop
+		}
+	}
+	return ip
+}
+
+// isTrustedProxy tells whether the address is one of the trusted proxies.
+func isTrustedProxy(ip string, trustedProxies []string) bool {
+	parsed := net.ParseIP(ip)
+	for _, proxy := range trustedProxies {
+		if _, network, err := net.ParseCIDR(proxy); err == nil {
+			if parsed != nil && network.Contains(parsed) {
+				return true
+			}
+		} else if proxyIP := net.ParseIP(proxy); proxyIP != nil && parsed != nil && proxyIP.Equal(parsed) {
+			return true
+		}
+	}
+	return false
+}
+
 // GetClientIPHelper gets the client IP using a mixture of techniques.
 // This is how it is with golang at the moment.
 func GetClientIPHelper(req *http.Request) (ipResult string, errResult error) {
@@ -64,8 +103,8 @@ func getClientIPByRequestRemoteAddr(req *http.Request) (ip string, err error) {
 	userIP := net.ParseIP(ip)
 	if userIP == nil {
 		message := fmt.Sprintf("debug: Parsing IP from Request.RemoteAddr got nothing.")
-		log.Debugf(message)
-		return "", fmt.Errorf(message)
+		log.Debug(message)
+		return "", errors.New(message)
 
 	}
 	log.Debugf("debug: Found IP: %v", userIP)
@@ -0,0 +1,30 @@
+package web
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPeerIP(t *testing.T) {
+	request := func(remote, forwarded string) *http.Request {
+		req, _ := http.NewRequest("GET", "/", nil)
+		req.RemoteAddr = remote
+		if forwarded != "" {
+			req.Header.Set("X-Forwarded-For", forwarded)
+		}
+		return req
+	}
+
+	// forwarding headers of anyone else are not believed
+	assert.Equal(t, "1.2.3.4", PeerIP(request("1.2.3.4:5000", ""), nil))
+	assert.Equal(t, "1.2.3.4", PeerIP(request("1.2.3.4:5000", "5.6.7.8"), nil))
+	assert.Equal(t, "1.2.3.4", PeerIP(request("1.2.3.4:5000", "5.6.7.8"), []string{"10.0.0.1"}))
+
+	// the peer is the first address from the right that is not a trusted proxy
+	proxies := []string{"10.0.0.1", "192.168.0.0/16"}
+	assert.Equal(t, "5.6.7.8", PeerIP(request("10.0.0.1:5000", "5.6.7.8"), proxies))
+	assert.Equal(t, "5.6.7.8", PeerIP(request("192.168.1.2:5000", "9.9.9.9, 5.6.7.8, 10.0.0.1"), proxies))
+	assert.Equal(t, "10.0.0.1", PeerIP(request("10.0.0.1:5000", ""), proxies))
+}
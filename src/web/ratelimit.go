@@ -0,0 +1,140 @@
+package web
+
+import (
+	"sort"
+	"sync"
+	"time"
+)
+
+// RateLimiter limits how often each key, like a peer or a sender, can do something.
+// Each key has a token bucket that holds up to burst tokens and is refilled with
+// rate tokens per second, and each request takes a token.
+type RateLimiter struct {
+	rate    float64
+	burst   float64
+	buckets map[string]*bucket
+	allowed int64
+	limited int64
+	calls   int
+	now     func() time.Time
+	sync.Mutex
+}
+
+type bucket struct {
+	tokens  float64
+	updated time.Time
+	allowed int64
+	limited int64
+}
+
+// RateCounters tell how many requests a rate limiter allowed and how many it limited.
+type RateCounters struct {
+	Rate    float64 `json:"rate"`
+	Burst   int     `json:"burst"`
+	Allowed int64   `json:"allowed"`
+	Limited int64   `json:"limited"`
+	// Keys is the number of keys that are being limited
+	Keys int `json:"keys"`
+	// TopLimited are the keys that were limited the most, the most first
+	TopLimited []KeyCounters `json:"top_limited"`
+}
+
+// KeyCounters tell how many requests of a key were allowed and how many were limited.
+type KeyCounters struct {
+	Key     string `json:"key"`
+	Allowed int64  `json:"allowed"`
+	Limited int64  `json:"limited"`
+}
+
+// sweepEvery is how many calls there are between removing the buckets that are
+// full again, so that keys that are no longer seen are forgotten.
+const sweepEvery = 1000
+
+// NewRateLimiter returns a rate limiter for rate requests per second, with bursts of
+// up to burst requests. A rate of 0 or less does not limit anything.
+func NewRateLimiter(rate float64, burst int) *RateLimiter {
+	if burst < 1 {
+		burst = 1
+	}
+	return &RateLimiter{
+		rate:    rate,
+		burst:   float64(burst),
+		buckets: make(map[string]*bucket),
+		now:     time.Now,
+	}
+}
+
+// Allow takes a token for the key and tells whether there was one.
+func (l *RateLimiter) Allow(key string) bool {
+	l.Lock()
+	defer l.Unlock()
+	if l.rate <= 0 {
+		l.allowed++
+		return true
+	}
+	now := l.now()
+
+	l.calls++
+	if l.calls%sweepEvery == 0 {
+		l.sweep(now)
+	}
+
+	b, ok := l.buckets[key]
+	if !ok {
+		b = &bucket{tokens: l.burst, updated: now}
+		l.buckets[key] = b
+	}
+	b.tokens += now.Sub(b.updated).Seconds() * l.rate
+	if b.tokens > l.burst {
+		b.tokens = l.burst
+	}
+	b.updated = now
+	if b.tokens < 1 {
+		b.limited++
+		l.limited++
+		return false
+	}
+	b.tokens--
+	b.allowed++
+	l.allowed++
+	return true
+}
+
+// sweep removes the buckets that would be full by now, which are the same as new ones.
+func (l *RateLimiter) sweep(now time.Time) {
+	for key, b := range l.buckets {
+		if b.tokens+now.Sub(b.updated).Seconds()*l.rate >= l.burst {
+			delete(l.buckets, key)
+		}
+	}
+}
+
+// Counters returns the counters of the rate limiter, with up to top keys that were
+// limited the most. Keys that were swept are only counted in the totals.
+func (l *RateLimiter) Counters(top int) (c RateCounters) {
+	l.Lock()
+	defer l.Unlock()
+	c = RateCounters{
+		Rate:       l.rate,
+		Burst:      int(l.burst),
+		Allowed:    l.allowed,
+		Limited:    l.limited,
+		Keys:       len(l.buckets),
+		TopLimited: []KeyCounters{},
+	}
+	for key, b := range l.buckets {
+		if b.limited > 0 {
+			c.TopLimited = append(c.TopLimited, KeyCounters{Key: key, Allowed: b.allowed, Limited: b.limited})
+		}
+	}
+	sort.Slice(c.TopLimited, func(i, j int) bool {
+		if c.TopLimited[i].Limited == c.TopLimited[j].Limited {
+			return c.TopLimited[i].Key < c.TopLimited[j].Key
+		}
+		return c.TopLimited[i].Limited > c.TopLimited[j].Limited
+	})
+	if len(c.TopLimited) > top {
+		c.TopLimited = c.TopLimited[:top]
+	}
+	return
+}
@@ -0,0 +1,57 @@
+package web
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRateLimiter(t *testing.T) {
+	now := time.Date(2018, 1, 1, 0, 0, 0, 0, time.UTC)
+	l := NewRateLimiter(2, 3)
+	l.now = func() time.Time { return now }
+
+	// the burst is allowed at once, then the key is limited
+	for i := 0; i < 3; i++ {
+		assert.True(t, l.Allow("a"))
+	}
+	assert.False(t, l.Allow("a"))
+	assert.False(t, l.Allow("a"))
+
+	// other keys have their own bucket
+	assert.True(t, l.Allow("b"))
+
+	// two tokens come back each second
+	now = now.Add(time.Second)
+	assert.True(t, l.Allow("a"))
+	assert.True(t, l.Allow("a"))
+	assert.False(t, l.Allow("a"))
+
+	// the bucket never holds more than the burst
+	now = now.Add(time.Hour)
+	for i := 0; i < 3; i++ {
+		assert.True(t, l.Allow("a"))
+	}
+	assert.False(t, l.Allow("a"))
+
+	c := l.Counters(10)
+	assert.Equal(t, int64(9), c.Allowed)
+	assert.Equal(t, int64(4), c.Limited)
+	assert.Equal(t, 2, c.Keys)
+	assert.Equal(t, []KeyCounters{{Key: "a", Allowed: 8, Limited: 4}}, c.TopLimited)
+	assert.Empty(t, l.Counters(0).TopLimited)
+
+	// full buckets are swept
+	now = now.Add(time.Hour)
+	l.sweep(now)
+	assert.Equal(t, 0, l.Counters(10).Keys)
+	assert.Equal(t, int64(4), l.Counters(10).Limited)
+
+	// no rate is no limit
+	l = NewRateLimiter(0, 0)
+	for i := 0; i < 100; i++ {
+		assert.True(t, l.Allow("a"))
+	}
+	assert.Equal(t, int64(100), l.Counters(10).Allowed)
+}
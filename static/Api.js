@@ -42,27 +42,82 @@ KiKiApi.prototype.submitLetter = function(letter, callback) {
     });
 }
 
+KiKiApi.prototype.send = function(method, url, data, toast_msg, callback) {
+    var self = this;
+    $.ajax({
+        url: url,
+        method: method,
+        data: data && JSON.stringify(data),
+        contentType: "application/json",
+        error: self.onError(toast_msg, callback),
+        success: self.onSuccess(toast_msg, callback)
+    });
+}
+
+KiKiApi.prototype.createPost = function(post, callback) {
+    this.send("POST", "/api/v1/posts", post, "Posting to KiKi", callback);
+}
+
+KiKiApi.prototype.editPost = function(post_id, post, callback) {
+    this.send("PUT", "/api/v1/post/"+post_id, post, "Editing post", callback);
+}
+
+KiKiApi.prototype.deletePost = function(post_id, callback) {
+    this.send("DELETE", "/api/v1/post/"+post_id, null, "Deleting post", callback);
+}
+
+KiKiApi.prototype.createComment = function(post_id, comment, callback) {
+    this.send("POST", "/api/v1/post/"+post_id+"/comments", comment, "Replying", callback);
+}
+
 KiKiApi.prototype.likePost = function(post_id, callback) {
-    this.submitLetter({
-        "purpose": "action-like",
-        "to": ["public"],
-        "content": post_id,
-    }, callback);
+    this.send("POST", "/api/v1/post/"+post_id+"/like", null, null, callback);
+}
+
+KiKiApi.prototype.unlikePost = function(post_id, callback) {
+    this.send("DELETE", "/api/v1/post/"+post_id+"/like", null, null, callback);
+}
+
+KiKiApi.prototype.updateUser = function(user, callback) {
+    this.send("PUT", "/api/v1/user", user, "Updating profile", callback);
 }
 
 KiKiApi.prototype.changeName = function(name, callback) {
-    this.submitLetter({
-        "purpose": "action-assign/name",
-        "to": ["public"],
-        "content": name
-    }, callback);
-}
-
-KiKiApi.prototype.followUser = function(user_id) {
-    this.submitLetter({
-        "purpose": "action-follow",
-        "to": ["public"],
-        "content": user_id,
+    this.updateUser({"name": name}, callback);
+}
+
+KiKiApi.prototype.followUser = function(user_id, callback) {
+    this.send("POST", "/api/v1/user/"+user_id+"/follow", null, null, callback);
+}
+
+KiKiApi.prototype.unfollowUser = function(user_id, callback) {
+    this.send("DELETE", "/api/v1/user/"+user_id+"/follow", null, null, callback);
+}
+
+KiKiApi.prototype.blockUser = function(user_id, callback) {
+    this.send("POST", "/api/v1/user/"+user_id+"/block", null, null, callback);
+}
+
+KiKiApi.prototype.unblockUser = function(user_id, callback) {
+    this.send("DELETE", "/api/v1/user/"+user_id+"/block", null, null, callback);
+}
+
+// uploadImage sends a File of a PNG or JPEG, and calls back with the ID of the image
+KiKiApi.prototype.uploadImage = function(file, recipients, callback) {
+    var self = this;
+    var form = new FormData();
+    form.append("image", file);
+    if (recipients) {
+        form.append("recipients", recipients.join(","));
+    }
+    $.ajax({
+        url: "/api/v1/images",
+        method: "POST",
+        data: form,
+        processData: false,
+        contentType: false,
+        error: self.onError("Uploading image", callback),
+        success: self.onSuccess("Uploading image", callback)
     });
 }
 
@@ -96,6 +151,52 @@ KiKiApi.prototype.fetchPostComments = function(post_id, callback) {
     });
 }
 
+KiKiApi.prototype.fetchConversations = function(callback) {
+    var self = this;
+    $.ajax({
+        url: "/api/v1/conversations",
+        method: "GET",
+        error: self.onError(null, callback),
+        success: self.onSuccess(null, callback)
+    });
+}
+
+KiKiApi.prototype.fetchConversationMessages = function(conversation_id, callback) {
+    var self = this;
+    $.ajax({
+        url: "/api/v1/conversations/"+conversation_id+"/messages",
+        method: "GET",
+        error: self.onError(null, callback),
+        success: self.onSuccess(null, callback)
+    });
+}
+
+// readConversation marks the messages of the conversation as read
+KiKiApi.prototype.readConversation = function(conversation_id, callback) {
+    this.send("POST", "/api/v1/conversations/"+conversation_id+"/read", null, null, callback);
+}
+
+// fetchNotifications calls back with your notifications, or only the unread ones,
+// and how many are unread
+KiKiApi.prototype.fetchNotifications = function(unread, callback) {
+    var self = this;
+    $.ajax({
+        url: "/api/v1/notifications" + (unread ? "?unread=1" : ""),
+        method: "GET",
+        error: self.onError(null, callback),
+        success: self.onSuccess(null, callback)
+    });
+}
+
+// readNotifications marks the notification with the ID as read, or all of them
+KiKiApi.prototype.readNotifications = function(notification_id, callback) {
+    var url = "/api/v1/notifications/read";
+    if (notification_id) {
+        url = "/api/v1/notification/"+notification_id+"/read";
+    }
+    this.send("POST", url, null, null, callback);
+}
+
 KiKiApi.prototype.fetchUser = function(user_id, callback) {
     var self = this;
     var url = "/api/v1/user"
@@ -109,3 +210,15 @@ KiKiApi.prototype.fetchUser = function(user_id, callback) {
         success: self.onSuccess(null, callback)
     });
 }
+
+// subscribe calls back with each event of the feed as it happens, and returns the
+// EventSource, which resumes by itself when it reconnects
+KiKiApi.prototype.subscribe = function(callback) {
+    var source = new EventSource("/api/v1/events");
+    ["post", "edit", "comment", "like", "follow", "peer", "synced", "notification", "reset"].forEach(function(type) {
+        source.addEventListener(type, function(e) {
+            callback(JSON.parse(e.data));
+        });
+    });
+    return source;
+}